@@ -3,7 +3,9 @@ package rewards
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,373 +16,3492 @@ import (
 	sync "sync"
 )
 
-var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
 
-func init() {
-	file_zenoda_rewards_genesis_proto_init()
-	md_GenesisState = File_zenoda_rewards_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+type _GenesisState_3_list struct {
+	list *[]*QueuedParamChange
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedParamChange)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_zenoda_rewards_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedParamChange)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(QueuedParamChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(QueuedParamChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*VotingDelegation
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingDelegation)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingDelegation)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(VotingDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "zenoda.rewards.GenesisState.params":
-		return x.Params != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
-		}
-		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "zenoda.rewards.GenesisState.params":
-		x.Params = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
-		}
-		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(VotingDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Candidate
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "zenoda.rewards.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
-		}
-		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Candidate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Candidate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Candidate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "zenoda.rewards.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
-		}
-		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Candidate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*Council
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "zenoda.rewards.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
-		}
-		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Council)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Council)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(Council)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "zenoda.rewards.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
-		}
-		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(Council)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*RewardWithdrawAddress
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in zenoda.rewards.GenesisState", d.FullName()))
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardWithdrawAddress)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardWithdrawAddress)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(RewardWithdrawAddress)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(RewardWithdrawAddress)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*VestingPosition
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingPosition)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingPosition)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(VestingPosition)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(VestingPosition)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*Campaign
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Campaign)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Campaign)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(Campaign)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(Campaign)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*CampaignContribution
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CampaignContribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CampaignContribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(CampaignContribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(CampaignContribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*Participation
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Participation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Participation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(Participation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(Participation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*EpochParticipation
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochParticipation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochParticipation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(EpochParticipation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(EpochParticipation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*ProposalVote
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalVote)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ProposalVote)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(ProposalVote)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(ProposalVote)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*EGVLock
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EGVLock)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EGVLock)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(EGVLock)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(EGVLock)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*EGVUnlock
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EGVUnlock)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EGVUnlock)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(EGVUnlock)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(EGVUnlock)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*TransactionCount
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransactionCount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TransactionCount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(TransactionCount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(TransactionCount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*EpochTransactionCount
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochTransactionCount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochTransactionCount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(EpochTransactionCount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(EpochTransactionCount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]*EpochContribution
+}
+
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochContribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochContribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	v := new(EpochContribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := new(EpochContribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_23_list)(nil)
+
+type _GenesisState_23_list struct {
+	list *[]*AddressContributionScore
+}
+
+func (x *_GenesisState_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AddressContributionScore)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AddressContributionScore)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_23_list) AppendMutable() protoreflect.Value {
+	v := new(AddressContributionScore)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_23_list) NewElement() protoreflect.Value {
+	v := new(AddressContributionScore)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_23_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_25_list)(nil)
+
+type _GenesisState_25_list struct {
+	list *[]*EpochReward
+}
+
+func (x *_GenesisState_25_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_25_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_25_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_25_list) AppendMutable() protoreflect.Value {
+	v := new(EpochReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_25_list) NewElement() protoreflect.Value {
+	v := new(EpochReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_25_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_26_list)(nil)
+
+type _GenesisState_26_list struct {
+	list *[]*AddressReward
+}
+
+func (x *_GenesisState_26_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_26_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AddressReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_26_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AddressReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_26_list) AppendMutable() protoreflect.Value {
+	v := new(AddressReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_26_list) NewElement() protoreflect.Value {
+	v := new(AddressReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_26_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                           protoreflect.MessageDescriptor
+	fd_GenesisState_params                    protoreflect.FieldDescriptor
+	fd_GenesisState_pending_inflation_change  protoreflect.FieldDescriptor
+	fd_GenesisState_queued_param_changes      protoreflect.FieldDescriptor
+	fd_GenesisState_voting_delegations        protoreflect.FieldDescriptor
+	fd_GenesisState_candidates                protoreflect.FieldDescriptor
+	fd_GenesisState_councils                  protoreflect.FieldDescriptor
+	fd_GenesisState_reward_withdraw_addresses protoreflect.FieldDescriptor
+	fd_GenesisState_vesting_positions         protoreflect.FieldDescriptor
+	fd_GenesisState_campaigns                 protoreflect.FieldDescriptor
+	fd_GenesisState_campaign_contributions    protoreflect.FieldDescriptor
+	fd_GenesisState_burned_supply             protoreflect.FieldDescriptor
+	fd_GenesisState_denom_metadata            protoreflect.FieldDescriptor
+	fd_GenesisState_participations            protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_participations      protoreflect.FieldDescriptor
+	fd_GenesisState_proposal_votes            protoreflect.FieldDescriptor
+	fd_GenesisState_locks                     protoreflect.FieldDescriptor
+	fd_GenesisState_unlocks                   protoreflect.FieldDescriptor
+	fd_GenesisState_governance_set_removals   protoreflect.FieldDescriptor
+	fd_GenesisState_transaction_counts        protoreflect.FieldDescriptor
+	fd_GenesisState_total_transactions        protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_transaction_counts  protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_total_transactions  protoreflect.FieldDescriptor
+	fd_GenesisState_contribution_scores       protoreflect.FieldDescriptor
+	fd_GenesisState_total_contribution_score  protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_rewards             protoreflect.FieldDescriptor
+	fd_GenesisState_address_rewards           protoreflect.FieldDescriptor
+	fd_GenesisState_lock_bonus                protoreflect.FieldDescriptor
+	fd_GenesisState_lock_score_bonus          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zenoda_rewards_genesis_proto_init()
+	md_GenesisState = File_zenoda_rewards_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_pending_inflation_change = md_GenesisState.Fields().ByName("pending_inflation_change")
+	fd_GenesisState_queued_param_changes = md_GenesisState.Fields().ByName("queued_param_changes")
+	fd_GenesisState_voting_delegations = md_GenesisState.Fields().ByName("voting_delegations")
+	fd_GenesisState_candidates = md_GenesisState.Fields().ByName("candidates")
+	fd_GenesisState_councils = md_GenesisState.Fields().ByName("councils")
+	fd_GenesisState_reward_withdraw_addresses = md_GenesisState.Fields().ByName("reward_withdraw_addresses")
+	fd_GenesisState_vesting_positions = md_GenesisState.Fields().ByName("vesting_positions")
+	fd_GenesisState_campaigns = md_GenesisState.Fields().ByName("campaigns")
+	fd_GenesisState_campaign_contributions = md_GenesisState.Fields().ByName("campaign_contributions")
+	fd_GenesisState_burned_supply = md_GenesisState.Fields().ByName("burned_supply")
+	fd_GenesisState_denom_metadata = md_GenesisState.Fields().ByName("denom_metadata")
+	fd_GenesisState_participations = md_GenesisState.Fields().ByName("participations")
+	fd_GenesisState_epoch_participations = md_GenesisState.Fields().ByName("epoch_participations")
+	fd_GenesisState_proposal_votes = md_GenesisState.Fields().ByName("proposal_votes")
+	fd_GenesisState_locks = md_GenesisState.Fields().ByName("locks")
+	fd_GenesisState_unlocks = md_GenesisState.Fields().ByName("unlocks")
+	fd_GenesisState_governance_set_removals = md_GenesisState.Fields().ByName("governance_set_removals")
+	fd_GenesisState_transaction_counts = md_GenesisState.Fields().ByName("transaction_counts")
+	fd_GenesisState_total_transactions = md_GenesisState.Fields().ByName("total_transactions")
+	fd_GenesisState_epoch_transaction_counts = md_GenesisState.Fields().ByName("epoch_transaction_counts")
+	fd_GenesisState_epoch_total_transactions = md_GenesisState.Fields().ByName("epoch_total_transactions")
+	fd_GenesisState_contribution_scores = md_GenesisState.Fields().ByName("contribution_scores")
+	fd_GenesisState_total_contribution_score = md_GenesisState.Fields().ByName("total_contribution_score")
+	fd_GenesisState_epoch_rewards = md_GenesisState.Fields().ByName("epoch_rewards")
+	fd_GenesisState_address_rewards = md_GenesisState.Fields().ByName("address_rewards")
+	fd_GenesisState_lock_bonus = md_GenesisState.Fields().ByName("lock_bonus")
+	fd_GenesisState_lock_score_bonus = md_GenesisState.Fields().ByName("lock_score_bonus")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_zenoda_rewards_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if x.PendingInflationChange != nil {
+		value := protoreflect.ValueOfMessage(x.PendingInflationChange.ProtoReflect())
+		if !f(fd_GenesisState_pending_inflation_change, value) {
+			return
+		}
+	}
+	if len(x.QueuedParamChanges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.QueuedParamChanges})
+		if !f(fd_GenesisState_queued_param_changes, value) {
+			return
+		}
+	}
+	if len(x.VotingDelegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.VotingDelegations})
+		if !f(fd_GenesisState_voting_delegations, value) {
+			return
+		}
+	}
+	if len(x.Candidates) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Candidates})
+		if !f(fd_GenesisState_candidates, value) {
+			return
+		}
+	}
+	if len(x.Councils) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Councils})
+		if !f(fd_GenesisState_councils, value) {
+			return
+		}
+	}
+	if len(x.RewardWithdrawAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.RewardWithdrawAddresses})
+		if !f(fd_GenesisState_reward_withdraw_addresses, value) {
+			return
+		}
+	}
+	if len(x.VestingPositions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.VestingPositions})
+		if !f(fd_GenesisState_vesting_positions, value) {
+			return
+		}
+	}
+	if len(x.Campaigns) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.Campaigns})
+		if !f(fd_GenesisState_campaigns, value) {
+			return
+		}
+	}
+	if len(x.CampaignContributions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.CampaignContributions})
+		if !f(fd_GenesisState_campaign_contributions, value) {
+			return
+		}
+	}
+	if x.BurnedSupply != "" {
+		value := protoreflect.ValueOfString(x.BurnedSupply)
+		if !f(fd_GenesisState_burned_supply, value) {
+			return
+		}
+	}
+	if x.DenomMetadata != nil {
+		value := protoreflect.ValueOfMessage(x.DenomMetadata.ProtoReflect())
+		if !f(fd_GenesisState_denom_metadata, value) {
+			return
+		}
+	}
+	if len(x.Participations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.Participations})
+		if !f(fd_GenesisState_participations, value) {
+			return
+		}
+	}
+	if len(x.EpochParticipations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.EpochParticipations})
+		if !f(fd_GenesisState_epoch_participations, value) {
+			return
+		}
+	}
+	if len(x.ProposalVotes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.ProposalVotes})
+		if !f(fd_GenesisState_proposal_votes, value) {
+			return
+		}
+	}
+	if len(x.Locks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.Locks})
+		if !f(fd_GenesisState_locks, value) {
+			return
+		}
+	}
+	if len(x.Unlocks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.Unlocks})
+		if !f(fd_GenesisState_unlocks, value) {
+			return
+		}
+	}
+	if x.GovernanceSetRemovals != nil {
+		value := protoreflect.ValueOfMessage(x.GovernanceSetRemovals.ProtoReflect())
+		if !f(fd_GenesisState_governance_set_removals, value) {
+			return
+		}
+	}
+	if len(x.TransactionCounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.TransactionCounts})
+		if !f(fd_GenesisState_transaction_counts, value) {
+			return
+		}
+	}
+	if x.TotalTransactions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalTransactions)
+		if !f(fd_GenesisState_total_transactions, value) {
+			return
+		}
+	}
+	if len(x.EpochTransactionCounts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.EpochTransactionCounts})
+		if !f(fd_GenesisState_epoch_transaction_counts, value) {
+			return
+		}
+	}
+	if len(x.EpochTotalTransactions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.EpochTotalTransactions})
+		if !f(fd_GenesisState_epoch_total_transactions, value) {
+			return
+		}
+	}
+	if len(x.ContributionScores) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_23_list{list: &x.ContributionScores})
+		if !f(fd_GenesisState_contribution_scores, value) {
+			return
+		}
+	}
+	if x.TotalContributionScore != nil {
+		value := protoreflect.ValueOfMessage(x.TotalContributionScore.ProtoReflect())
+		if !f(fd_GenesisState_total_contribution_score, value) {
+			return
+		}
+	}
+	if len(x.EpochRewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_25_list{list: &x.EpochRewards})
+		if !f(fd_GenesisState_epoch_rewards, value) {
+			return
+		}
+	}
+	if len(x.AddressRewards) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_26_list{list: &x.AddressRewards})
+		if !f(fd_GenesisState_address_rewards, value) {
+			return
+		}
+	}
+	if x.LockBonus != "" {
+		value := protoreflect.ValueOfString(x.LockBonus)
+		if !f(fd_GenesisState_lock_bonus, value) {
+			return
+		}
+	}
+	if x.LockScoreBonus != nil {
+		value := protoreflect.ValueOfMessage(x.LockScoreBonus.ProtoReflect())
+		if !f(fd_GenesisState_lock_score_bonus, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zenoda.rewards.GenesisState.params":
+		return x.Params != nil
+	case "zenoda.rewards.GenesisState.pending_inflation_change":
+		return x.PendingInflationChange != nil
+	case "zenoda.rewards.GenesisState.queued_param_changes":
+		return len(x.QueuedParamChanges) != 0
+	case "zenoda.rewards.GenesisState.voting_delegations":
+		return len(x.VotingDelegations) != 0
+	case "zenoda.rewards.GenesisState.candidates":
+		return len(x.Candidates) != 0
+	case "zenoda.rewards.GenesisState.councils":
+		return len(x.Councils) != 0
+	case "zenoda.rewards.GenesisState.reward_withdraw_addresses":
+		return len(x.RewardWithdrawAddresses) != 0
+	case "zenoda.rewards.GenesisState.vesting_positions":
+		return len(x.VestingPositions) != 0
+	case "zenoda.rewards.GenesisState.campaigns":
+		return len(x.Campaigns) != 0
+	case "zenoda.rewards.GenesisState.campaign_contributions":
+		return len(x.CampaignContributions) != 0
+	case "zenoda.rewards.GenesisState.burned_supply":
+		return x.BurnedSupply != ""
+	case "zenoda.rewards.GenesisState.denom_metadata":
+		return x.DenomMetadata != nil
+	case "zenoda.rewards.GenesisState.participations":
+		return len(x.Participations) != 0
+	case "zenoda.rewards.GenesisState.epoch_participations":
+		return len(x.EpochParticipations) != 0
+	case "zenoda.rewards.GenesisState.proposal_votes":
+		return len(x.ProposalVotes) != 0
+	case "zenoda.rewards.GenesisState.locks":
+		return len(x.Locks) != 0
+	case "zenoda.rewards.GenesisState.unlocks":
+		return len(x.Unlocks) != 0
+	case "zenoda.rewards.GenesisState.governance_set_removals":
+		return x.GovernanceSetRemovals != nil
+	case "zenoda.rewards.GenesisState.transaction_counts":
+		return len(x.TransactionCounts) != 0
+	case "zenoda.rewards.GenesisState.total_transactions":
+		return x.TotalTransactions != uint64(0)
+	case "zenoda.rewards.GenesisState.epoch_transaction_counts":
+		return len(x.EpochTransactionCounts) != 0
+	case "zenoda.rewards.GenesisState.epoch_total_transactions":
+		return len(x.EpochTotalTransactions) != 0
+	case "zenoda.rewards.GenesisState.contribution_scores":
+		return len(x.ContributionScores) != 0
+	case "zenoda.rewards.GenesisState.total_contribution_score":
+		return x.TotalContributionScore != nil
+	case "zenoda.rewards.GenesisState.epoch_rewards":
+		return len(x.EpochRewards) != 0
+	case "zenoda.rewards.GenesisState.address_rewards":
+		return len(x.AddressRewards) != 0
+	case "zenoda.rewards.GenesisState.lock_bonus":
+		return x.LockBonus != ""
+	case "zenoda.rewards.GenesisState.lock_score_bonus":
+		return x.LockScoreBonus != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zenoda.rewards.GenesisState.params":
+		x.Params = nil
+	case "zenoda.rewards.GenesisState.pending_inflation_change":
+		x.PendingInflationChange = nil
+	case "zenoda.rewards.GenesisState.queued_param_changes":
+		x.QueuedParamChanges = nil
+	case "zenoda.rewards.GenesisState.voting_delegations":
+		x.VotingDelegations = nil
+	case "zenoda.rewards.GenesisState.candidates":
+		x.Candidates = nil
+	case "zenoda.rewards.GenesisState.councils":
+		x.Councils = nil
+	case "zenoda.rewards.GenesisState.reward_withdraw_addresses":
+		x.RewardWithdrawAddresses = nil
+	case "zenoda.rewards.GenesisState.vesting_positions":
+		x.VestingPositions = nil
+	case "zenoda.rewards.GenesisState.campaigns":
+		x.Campaigns = nil
+	case "zenoda.rewards.GenesisState.campaign_contributions":
+		x.CampaignContributions = nil
+	case "zenoda.rewards.GenesisState.burned_supply":
+		x.BurnedSupply = ""
+	case "zenoda.rewards.GenesisState.denom_metadata":
+		x.DenomMetadata = nil
+	case "zenoda.rewards.GenesisState.participations":
+		x.Participations = nil
+	case "zenoda.rewards.GenesisState.epoch_participations":
+		x.EpochParticipations = nil
+	case "zenoda.rewards.GenesisState.proposal_votes":
+		x.ProposalVotes = nil
+	case "zenoda.rewards.GenesisState.locks":
+		x.Locks = nil
+	case "zenoda.rewards.GenesisState.unlocks":
+		x.Unlocks = nil
+	case "zenoda.rewards.GenesisState.governance_set_removals":
+		x.GovernanceSetRemovals = nil
+	case "zenoda.rewards.GenesisState.transaction_counts":
+		x.TransactionCounts = nil
+	case "zenoda.rewards.GenesisState.total_transactions":
+		x.TotalTransactions = uint64(0)
+	case "zenoda.rewards.GenesisState.epoch_transaction_counts":
+		x.EpochTransactionCounts = nil
+	case "zenoda.rewards.GenesisState.epoch_total_transactions":
+		x.EpochTotalTransactions = nil
+	case "zenoda.rewards.GenesisState.contribution_scores":
+		x.ContributionScores = nil
+	case "zenoda.rewards.GenesisState.total_contribution_score":
+		x.TotalContributionScore = nil
+	case "zenoda.rewards.GenesisState.epoch_rewards":
+		x.EpochRewards = nil
+	case "zenoda.rewards.GenesisState.address_rewards":
+		x.AddressRewards = nil
+	case "zenoda.rewards.GenesisState.lock_bonus":
+		x.LockBonus = ""
+	case "zenoda.rewards.GenesisState.lock_score_bonus":
+		x.LockScoreBonus = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zenoda.rewards.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zenoda.rewards.GenesisState.pending_inflation_change":
+		value := x.PendingInflationChange
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zenoda.rewards.GenesisState.queued_param_changes":
+		if len(x.QueuedParamChanges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.QueuedParamChanges}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.voting_delegations":
+		if len(x.VotingDelegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.VotingDelegations}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.candidates":
+		if len(x.Candidates) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Candidates}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.councils":
+		if len(x.Councils) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Councils}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.reward_withdraw_addresses":
+		if len(x.RewardWithdrawAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.RewardWithdrawAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.vesting_positions":
+		if len(x.VestingPositions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.VestingPositions}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.campaigns":
+		if len(x.Campaigns) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.Campaigns}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.campaign_contributions":
+		if len(x.CampaignContributions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.CampaignContributions}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.burned_supply":
+		value := x.BurnedSupply
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.GenesisState.denom_metadata":
+		value := x.DenomMetadata
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zenoda.rewards.GenesisState.participations":
+		if len(x.Participations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.Participations}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.epoch_participations":
+		if len(x.EpochParticipations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.EpochParticipations}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.proposal_votes":
+		if len(x.ProposalVotes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.ProposalVotes}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.locks":
+		if len(x.Locks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.Locks}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.unlocks":
+		if len(x.Unlocks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.Unlocks}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.governance_set_removals":
+		value := x.GovernanceSetRemovals
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zenoda.rewards.GenesisState.transaction_counts":
+		if len(x.TransactionCounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.TransactionCounts}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.total_transactions":
+		value := x.TotalTransactions
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.GenesisState.epoch_transaction_counts":
+		if len(x.EpochTransactionCounts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.EpochTransactionCounts}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.epoch_total_transactions":
+		if len(x.EpochTotalTransactions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.EpochTotalTransactions}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.contribution_scores":
+		if len(x.ContributionScores) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_23_list{})
+		}
+		listValue := &_GenesisState_23_list{list: &x.ContributionScores}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.total_contribution_score":
+		value := x.TotalContributionScore
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zenoda.rewards.GenesisState.epoch_rewards":
+		if len(x.EpochRewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_25_list{})
+		}
+		listValue := &_GenesisState_25_list{list: &x.EpochRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.address_rewards":
+		if len(x.AddressRewards) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_26_list{})
+		}
+		listValue := &_GenesisState_26_list{list: &x.AddressRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.GenesisState.lock_bonus":
+		value := x.LockBonus
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.GenesisState.lock_score_bonus":
+		value := x.LockScoreBonus
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zenoda.rewards.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "zenoda.rewards.GenesisState.pending_inflation_change":
+		x.PendingInflationChange = value.Message().Interface().(*PendingInflationChange)
+	case "zenoda.rewards.GenesisState.queued_param_changes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.QueuedParamChanges = *clv.list
+	case "zenoda.rewards.GenesisState.voting_delegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.VotingDelegations = *clv.list
+	case "zenoda.rewards.GenesisState.candidates":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Candidates = *clv.list
+	case "zenoda.rewards.GenesisState.councils":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Councils = *clv.list
+	case "zenoda.rewards.GenesisState.reward_withdraw_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.RewardWithdrawAddresses = *clv.list
+	case "zenoda.rewards.GenesisState.vesting_positions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.VestingPositions = *clv.list
+	case "zenoda.rewards.GenesisState.campaigns":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.Campaigns = *clv.list
+	case "zenoda.rewards.GenesisState.campaign_contributions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.CampaignContributions = *clv.list
+	case "zenoda.rewards.GenesisState.burned_supply":
+		x.BurnedSupply = value.Interface().(string)
+	case "zenoda.rewards.GenesisState.denom_metadata":
+		x.DenomMetadata = value.Message().Interface().(*v1beta1.Metadata)
+	case "zenoda.rewards.GenesisState.participations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.Participations = *clv.list
+	case "zenoda.rewards.GenesisState.epoch_participations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.EpochParticipations = *clv.list
+	case "zenoda.rewards.GenesisState.proposal_votes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.ProposalVotes = *clv.list
+	case "zenoda.rewards.GenesisState.locks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.Locks = *clv.list
+	case "zenoda.rewards.GenesisState.unlocks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.Unlocks = *clv.list
+	case "zenoda.rewards.GenesisState.governance_set_removals":
+		x.GovernanceSetRemovals = value.Message().Interface().(*GovernanceSetRemovals)
+	case "zenoda.rewards.GenesisState.transaction_counts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.TransactionCounts = *clv.list
+	case "zenoda.rewards.GenesisState.total_transactions":
+		x.TotalTransactions = value.Uint()
+	case "zenoda.rewards.GenesisState.epoch_transaction_counts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.EpochTransactionCounts = *clv.list
+	case "zenoda.rewards.GenesisState.epoch_total_transactions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.EpochTotalTransactions = *clv.list
+	case "zenoda.rewards.GenesisState.contribution_scores":
+		lv := value.List()
+		clv := lv.(*_GenesisState_23_list)
+		x.ContributionScores = *clv.list
+	case "zenoda.rewards.GenesisState.total_contribution_score":
+		x.TotalContributionScore = value.Message().Interface().(*ContributionScore)
+	case "zenoda.rewards.GenesisState.epoch_rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_25_list)
+		x.EpochRewards = *clv.list
+	case "zenoda.rewards.GenesisState.address_rewards":
+		lv := value.List()
+		clv := lv.(*_GenesisState_26_list)
+		x.AddressRewards = *clv.list
+	case "zenoda.rewards.GenesisState.lock_bonus":
+		x.LockBonus = value.Interface().(string)
+	case "zenoda.rewards.GenesisState.lock_score_bonus":
+		x.LockScoreBonus = value.Message().Interface().(*ContributionScore)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.rewards.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "zenoda.rewards.GenesisState.pending_inflation_change":
+		if x.PendingInflationChange == nil {
+			x.PendingInflationChange = new(PendingInflationChange)
+		}
+		return protoreflect.ValueOfMessage(x.PendingInflationChange.ProtoReflect())
+	case "zenoda.rewards.GenesisState.queued_param_changes":
+		if x.QueuedParamChanges == nil {
+			x.QueuedParamChanges = []*QueuedParamChange{}
+		}
+		value := &_GenesisState_3_list{list: &x.QueuedParamChanges}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.voting_delegations":
+		if x.VotingDelegations == nil {
+			x.VotingDelegations = []*VotingDelegation{}
+		}
+		value := &_GenesisState_4_list{list: &x.VotingDelegations}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.candidates":
+		if x.Candidates == nil {
+			x.Candidates = []*Candidate{}
+		}
+		value := &_GenesisState_5_list{list: &x.Candidates}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.councils":
+		if x.Councils == nil {
+			x.Councils = []*Council{}
+		}
+		value := &_GenesisState_6_list{list: &x.Councils}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.reward_withdraw_addresses":
+		if x.RewardWithdrawAddresses == nil {
+			x.RewardWithdrawAddresses = []*RewardWithdrawAddress{}
+		}
+		value := &_GenesisState_7_list{list: &x.RewardWithdrawAddresses}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.vesting_positions":
+		if x.VestingPositions == nil {
+			x.VestingPositions = []*VestingPosition{}
+		}
+		value := &_GenesisState_8_list{list: &x.VestingPositions}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.campaigns":
+		if x.Campaigns == nil {
+			x.Campaigns = []*Campaign{}
+		}
+		value := &_GenesisState_9_list{list: &x.Campaigns}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.campaign_contributions":
+		if x.CampaignContributions == nil {
+			x.CampaignContributions = []*CampaignContribution{}
+		}
+		value := &_GenesisState_10_list{list: &x.CampaignContributions}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.denom_metadata":
+		if x.DenomMetadata == nil {
+			x.DenomMetadata = new(v1beta1.Metadata)
+		}
+		return protoreflect.ValueOfMessage(x.DenomMetadata.ProtoReflect())
+	case "zenoda.rewards.GenesisState.participations":
+		if x.Participations == nil {
+			x.Participations = []*Participation{}
+		}
+		value := &_GenesisState_13_list{list: &x.Participations}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.epoch_participations":
+		if x.EpochParticipations == nil {
+			x.EpochParticipations = []*EpochParticipation{}
+		}
+		value := &_GenesisState_14_list{list: &x.EpochParticipations}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.proposal_votes":
+		if x.ProposalVotes == nil {
+			x.ProposalVotes = []*ProposalVote{}
+		}
+		value := &_GenesisState_15_list{list: &x.ProposalVotes}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.locks":
+		if x.Locks == nil {
+			x.Locks = []*EGVLock{}
+		}
+		value := &_GenesisState_16_list{list: &x.Locks}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.unlocks":
+		if x.Unlocks == nil {
+			x.Unlocks = []*EGVUnlock{}
+		}
+		value := &_GenesisState_17_list{list: &x.Unlocks}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.governance_set_removals":
+		if x.GovernanceSetRemovals == nil {
+			x.GovernanceSetRemovals = new(GovernanceSetRemovals)
+		}
+		return protoreflect.ValueOfMessage(x.GovernanceSetRemovals.ProtoReflect())
+	case "zenoda.rewards.GenesisState.transaction_counts":
+		if x.TransactionCounts == nil {
+			x.TransactionCounts = []*TransactionCount{}
+		}
+		value := &_GenesisState_19_list{list: &x.TransactionCounts}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.epoch_transaction_counts":
+		if x.EpochTransactionCounts == nil {
+			x.EpochTransactionCounts = []*EpochTransactionCount{}
+		}
+		value := &_GenesisState_21_list{list: &x.EpochTransactionCounts}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.epoch_total_transactions":
+		if x.EpochTotalTransactions == nil {
+			x.EpochTotalTransactions = []*EpochContribution{}
+		}
+		value := &_GenesisState_22_list{list: &x.EpochTotalTransactions}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.contribution_scores":
+		if x.ContributionScores == nil {
+			x.ContributionScores = []*AddressContributionScore{}
+		}
+		value := &_GenesisState_23_list{list: &x.ContributionScores}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.total_contribution_score":
+		if x.TotalContributionScore == nil {
+			x.TotalContributionScore = new(ContributionScore)
+		}
+		return protoreflect.ValueOfMessage(x.TotalContributionScore.ProtoReflect())
+	case "zenoda.rewards.GenesisState.epoch_rewards":
+		if x.EpochRewards == nil {
+			x.EpochRewards = []*EpochReward{}
+		}
+		value := &_GenesisState_25_list{list: &x.EpochRewards}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.address_rewards":
+		if x.AddressRewards == nil {
+			x.AddressRewards = []*AddressReward{}
+		}
+		value := &_GenesisState_26_list{list: &x.AddressRewards}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.GenesisState.lock_score_bonus":
+		if x.LockScoreBonus == nil {
+			x.LockScoreBonus = new(ContributionScore)
+		}
+		return protoreflect.ValueOfMessage(x.LockScoreBonus.ProtoReflect())
+	case "zenoda.rewards.GenesisState.burned_supply":
+		panic(fmt.Errorf("field burned_supply of message zenoda.rewards.GenesisState is not mutable"))
+	case "zenoda.rewards.GenesisState.total_transactions":
+		panic(fmt.Errorf("field total_transactions of message zenoda.rewards.GenesisState is not mutable"))
+	case "zenoda.rewards.GenesisState.lock_bonus":
+		panic(fmt.Errorf("field lock_bonus of message zenoda.rewards.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.rewards.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zenoda.rewards.GenesisState.pending_inflation_change":
+		m := new(PendingInflationChange)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zenoda.rewards.GenesisState.queued_param_changes":
+		list := []*QueuedParamChange{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "zenoda.rewards.GenesisState.voting_delegations":
+		list := []*VotingDelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "zenoda.rewards.GenesisState.candidates":
+		list := []*Candidate{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "zenoda.rewards.GenesisState.councils":
+		list := []*Council{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "zenoda.rewards.GenesisState.reward_withdraw_addresses":
+		list := []*RewardWithdrawAddress{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "zenoda.rewards.GenesisState.vesting_positions":
+		list := []*VestingPosition{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "zenoda.rewards.GenesisState.campaigns":
+		list := []*Campaign{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "zenoda.rewards.GenesisState.campaign_contributions":
+		list := []*CampaignContribution{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "zenoda.rewards.GenesisState.burned_supply":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.GenesisState.denom_metadata":
+		m := new(v1beta1.Metadata)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zenoda.rewards.GenesisState.participations":
+		list := []*Participation{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "zenoda.rewards.GenesisState.epoch_participations":
+		list := []*EpochParticipation{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "zenoda.rewards.GenesisState.proposal_votes":
+		list := []*ProposalVote{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "zenoda.rewards.GenesisState.locks":
+		list := []*EGVLock{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "zenoda.rewards.GenesisState.unlocks":
+		list := []*EGVUnlock{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "zenoda.rewards.GenesisState.governance_set_removals":
+		m := new(GovernanceSetRemovals)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zenoda.rewards.GenesisState.transaction_counts":
+		list := []*TransactionCount{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "zenoda.rewards.GenesisState.total_transactions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.GenesisState.epoch_transaction_counts":
+		list := []*EpochTransactionCount{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "zenoda.rewards.GenesisState.epoch_total_transactions":
+		list := []*EpochContribution{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	case "zenoda.rewards.GenesisState.contribution_scores":
+		list := []*AddressContributionScore{}
+		return protoreflect.ValueOfList(&_GenesisState_23_list{list: &list})
+	case "zenoda.rewards.GenesisState.total_contribution_score":
+		m := new(ContributionScore)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zenoda.rewards.GenesisState.epoch_rewards":
+		list := []*EpochReward{}
+		return protoreflect.ValueOfList(&_GenesisState_25_list{list: &list})
+	case "zenoda.rewards.GenesisState.address_rewards":
+		list := []*AddressReward{}
+		return protoreflect.ValueOfList(&_GenesisState_26_list{list: &list})
+	case "zenoda.rewards.GenesisState.lock_bonus":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.GenesisState.lock_score_bonus":
+		m := new(ContributionScore)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.GenesisState"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zenoda.rewards.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PendingInflationChange != nil {
+			l = options.Size(x.PendingInflationChange)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.QueuedParamChanges) > 0 {
+			for _, e := range x.QueuedParamChanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VotingDelegations) > 0 {
+			for _, e := range x.VotingDelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Candidates) > 0 {
+			for _, e := range x.Candidates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Councils) > 0 {
+			for _, e := range x.Councils {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardWithdrawAddresses) > 0 {
+			for _, e := range x.RewardWithdrawAddresses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VestingPositions) > 0 {
+			for _, e := range x.VestingPositions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Campaigns) > 0 {
+			for _, e := range x.Campaigns {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CampaignContributions) > 0 {
+			for _, e := range x.CampaignContributions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.BurnedSupply)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DenomMetadata != nil {
+			l = options.Size(x.DenomMetadata)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Participations) > 0 {
+			for _, e := range x.Participations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EpochParticipations) > 0 {
+			for _, e := range x.EpochParticipations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ProposalVotes) > 0 {
+			for _, e := range x.ProposalVotes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Locks) > 0 {
+			for _, e := range x.Locks {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Unlocks) > 0 {
+			for _, e := range x.Unlocks {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.GovernanceSetRemovals != nil {
+			l = options.Size(x.GovernanceSetRemovals)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TransactionCounts) > 0 {
+			for _, e := range x.TransactionCounts {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalTransactions != 0 {
+			n += 2 + runtime.Sov(uint64(x.TotalTransactions))
+		}
+		if len(x.EpochTransactionCounts) > 0 {
+			for _, e := range x.EpochTransactionCounts {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EpochTotalTransactions) > 0 {
+			for _, e := range x.EpochTotalTransactions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ContributionScores) > 0 {
+			for _, e := range x.ContributionScores {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalContributionScore != nil {
+			l = options.Size(x.TotalContributionScore)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EpochRewards) > 0 {
+			for _, e := range x.EpochRewards {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AddressRewards) > 0 {
+			for _, e := range x.AddressRewards {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.LockBonus)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockScoreBonus != nil {
+			l = options.Size(x.LockScoreBonus)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LockScoreBonus != nil {
+			encoded, err := options.Marshal(x.LockScoreBonus)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+		if len(x.LockBonus) > 0 {
+			i -= len(x.LockBonus)
+			copy(dAtA[i:], x.LockBonus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockBonus)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if len(x.AddressRewards) > 0 {
+			for iNdEx := len(x.AddressRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AddressRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xd2
+			}
+		}
+		if len(x.EpochRewards) > 0 {
+			for iNdEx := len(x.EpochRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xca
+			}
+		}
+		if x.TotalContributionScore != nil {
+			encoded, err := options.Marshal(x.TotalContributionScore)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if len(x.ContributionScores) > 0 {
+			for iNdEx := len(x.ContributionScores) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ContributionScores[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.EpochTotalTransactions) > 0 {
+			for iNdEx := len(x.EpochTotalTransactions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochTotalTransactions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.EpochTransactionCounts) > 0 {
+			for iNdEx := len(x.EpochTransactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochTransactionCounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if x.TotalTransactions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalTransactions))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.TransactionCounts) > 0 {
+			for iNdEx := len(x.TransactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TransactionCounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if x.GovernanceSetRemovals != nil {
+			encoded, err := options.Marshal(x.GovernanceSetRemovals)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.Unlocks) > 0 {
+			for iNdEx := len(x.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Unlocks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.Locks) > 0 {
+			for iNdEx := len(x.Locks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Locks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.ProposalVotes) > 0 {
+			for iNdEx := len(x.ProposalVotes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ProposalVotes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.EpochParticipations) > 0 {
+			for iNdEx := len(x.EpochParticipations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochParticipations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.Participations) > 0 {
+			for iNdEx := len(x.Participations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Participations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.DenomMetadata != nil {
+			encoded, err := options.Marshal(x.DenomMetadata)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.BurnedSupply) > 0 {
+			i -= len(x.BurnedSupply)
+			copy(dAtA[i:], x.BurnedSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnedSupply)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.CampaignContributions) > 0 {
+			for iNdEx := len(x.CampaignContributions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CampaignContributions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Campaigns) > 0 {
+			for iNdEx := len(x.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Campaigns[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.VestingPositions) > 0 {
+			for iNdEx := len(x.VestingPositions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VestingPositions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.RewardWithdrawAddresses) > 0 {
+			for iNdEx := len(x.RewardWithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardWithdrawAddresses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Councils) > 0 {
+			for iNdEx := len(x.Councils) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Councils[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Candidates) > 0 {
+			for iNdEx := len(x.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Candidates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.VotingDelegations) > 0 {
+			for iNdEx := len(x.VotingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingDelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.QueuedParamChanges) > 0 {
+			for iNdEx := len(x.QueuedParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueuedParamChanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.PendingInflationChange != nil {
+			encoded, err := options.Marshal(x.PendingInflationChange)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingInflationChange", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PendingInflationChange == nil {
+					x.PendingInflationChange = &PendingInflationChange{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingInflationChange); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedParamChanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueuedParamChanges = append(x.QueuedParamChanges, &QueuedParamChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedParamChanges[len(x.QueuedParamChanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingDelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingDelegations = append(x.VotingDelegations, &VotingDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingDelegations[len(x.VotingDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Candidates = append(x.Candidates, &Candidate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Candidates[len(x.Candidates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Councils", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Councils = append(x.Councils, &Council{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Councils[len(x.Councils)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardWithdrawAddresses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardWithdrawAddresses = append(x.RewardWithdrawAddresses, &RewardWithdrawAddress{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardWithdrawAddresses[len(x.RewardWithdrawAddresses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingPositions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingPositions = append(x.VestingPositions, &VestingPosition{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VestingPositions[len(x.VestingPositions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Campaigns = append(x.Campaigns, &Campaign{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Campaigns[len(x.Campaigns)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CampaignContributions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CampaignContributions = append(x.CampaignContributions, &CampaignContribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CampaignContributions[len(x.CampaignContributions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnedSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DenomMetadata == nil {
+					x.DenomMetadata = &v1beta1.Metadata{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomMetadata); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Participations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Participations = append(x.Participations, &Participation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Participations[len(x.Participations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochParticipations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochParticipations = append(x.EpochParticipations, &EpochParticipation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochParticipations[len(x.EpochParticipations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalVotes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalVotes = append(x.ProposalVotes, &ProposalVote{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ProposalVotes[len(x.ProposalVotes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Locks = append(x.Locks, &EGVLock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Locks[len(x.Locks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Unlocks = append(x.Unlocks, &EGVUnlock{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Unlocks[len(x.Unlocks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovernanceSetRemovals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GovernanceSetRemovals == nil {
+					x.GovernanceSetRemovals = &GovernanceSetRemovals{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GovernanceSetRemovals); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransactionCounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransactionCounts = append(x.TransactionCounts, &TransactionCount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TransactionCounts[len(x.TransactionCounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalTransactions", wireType)
+				}
+				x.TotalTransactions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalTransactions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochTransactionCounts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochTransactionCounts = append(x.EpochTransactionCounts, &EpochTransactionCount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochTransactionCounts[len(x.EpochTransactionCounts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochTotalTransactions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EpochTotalTransactions = append(x.EpochTotalTransactions, &EpochContribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochTotalTransactions[len(x.EpochTotalTransactions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContributionScores", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContributionScores = append(x.ContributionScores, &AddressContributionScore{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContributionScores[len(x.ContributionScores)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalContributionScore", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if x.TotalContributionScore == nil {
+					x.TotalContributionScore = &ContributionScore{}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalContributionScore); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochRewards", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -407,10 +3528,110 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.EpochRewards = append(x.EpochRewards, &EpochReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochRewards[len(x.EpochRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddressRewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AddressRewards = append(x.AddressRewards, &AddressReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AddressRewards[len(x.AddressRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockBonus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockBonus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockScoreBonus", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockScoreBonus == nil {
+					x.LockScoreBonus = &ContributionScore{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockScoreBonus); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -470,6 +3691,68 @@ type GenesisState struct {
 
 	// params defines all the parameters of the module.
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// pending_inflation_change is an inflation rate change that has been
+	// accepted by governance but not yet activated.
+	PendingInflationChange *PendingInflationChange `protobuf:"bytes,2,opt,name=pending_inflation_change,json=pendingInflationChange,proto3" json:"pending_inflation_change,omitempty"`
+	// queued_param_changes are the params updates waiting in the timelock queue.
+	QueuedParamChanges []*QueuedParamChange `protobuf:"bytes,3,rep,name=queued_param_changes,json=queuedParamChanges,proto3" json:"queued_param_changes,omitempty"`
+	// voting_delegations are the voting power delegations between addresses.
+	VotingDelegations []*VotingDelegation `protobuf:"bytes,4,rep,name=voting_delegations,json=votingDelegations,proto3" json:"voting_delegations,omitempty"`
+	// candidates are the addresses registered for governance wallet elections.
+	Candidates []*Candidate `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// councils is the history of elected governance wallet sets.
+	Councils []*Council `protobuf:"bytes,6,rep,name=councils,proto3" json:"councils,omitempty"`
+	// reward_withdraw_addresses are the configured reward payout addresses.
+	RewardWithdrawAddresses []*RewardWithdrawAddress `protobuf:"bytes,7,rep,name=reward_withdraw_addresses,json=rewardWithdrawAddresses,proto3" json:"reward_withdraw_addresses,omitempty"`
+	// vesting_positions are the reward vesting positions held by the module.
+	VestingPositions []*VestingPosition `protobuf:"bytes,8,rep,name=vesting_positions,json=vestingPositions,proto3" json:"vesting_positions,omitempty"`
+	// campaigns are the sponsored incentive campaigns.
+	Campaigns []*Campaign `protobuf:"bytes,9,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	// campaign_contributions are the matching messages counted for the
+	// campaigns not settled yet.
+	CampaignContributions []*CampaignContribution `protobuf:"bytes,10,rep,name=campaign_contributions,json=campaignContributions,proto3" json:"campaign_contributions,omitempty"`
+	// burned_supply is the EGV burned from reward distributions so far.
+	BurnedSupply string `protobuf:"bytes,11,opt,name=burned_supply,json=burnedSupply,proto3" json:"burned_supply,omitempty"`
+	// denom_metadata is the EGV denom metadata registered with x/bank.
+	DenomMetadata *v1beta1.Metadata `protobuf:"bytes,12,opt,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata,omitempty"`
+	// participations are the governance participation records of the
+	// governance wallets.
+	Participations []*Participation `protobuf:"bytes,13,rep,name=participations,proto3" json:"participations,omitempty"`
+	// epoch_participations are the participation counts of the retained
+	// epochs.
+	EpochParticipations []*EpochParticipation `protobuf:"bytes,14,rep,name=epoch_participations,json=epochParticipations,proto3" json:"epoch_participations,omitempty"`
+	// proposal_votes are the votes cast on x/gov proposals still in their
+	// voting period.
+	ProposalVotes []*ProposalVote `protobuf:"bytes,15,rep,name=proposal_votes,json=proposalVotes,proto3" json:"proposal_votes,omitempty"`
+	// locks is the EGV locked per address.
+	Locks []*EGVLock `protobuf:"bytes,16,rep,name=locks,proto3" json:"locks,omitempty"`
+	// unlocks is the locked EGV waiting out the unbonding period.
+	Unlocks []*EGVUnlock `protobuf:"bytes,17,rep,name=unlocks,proto3" json:"unlocks,omitempty"`
+	// governance_set_removals are the governance wallets removed for
+	// inactivity in the current epoch.
+	GovernanceSetRemovals *GovernanceSetRemovals `protobuf:"bytes,18,opt,name=governance_set_removals,json=governanceSetRemovals,proto3" json:"governance_set_removals,omitempty"`
+	// transaction_counts are the lifetime transaction counts per address.
+	TransactionCounts []*TransactionCount `protobuf:"bytes,19,rep,name=transaction_counts,json=transactionCounts,proto3" json:"transaction_counts,omitempty"`
+	// total_transactions is the lifetime transaction count of the network.
+	TotalTransactions uint64 `protobuf:"varint,20,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	// epoch_transaction_counts are the transaction counts per address of the
+	// retained epochs.
+	EpochTransactionCounts []*EpochTransactionCount `protobuf:"bytes,21,rep,name=epoch_transaction_counts,json=epochTransactionCounts,proto3" json:"epoch_transaction_counts,omitempty"`
+	// epoch_total_transactions are the network transaction counts of the
+	// retained epochs.
+	EpochTotalTransactions []*EpochContribution `protobuf:"bytes,22,rep,name=epoch_total_transactions,json=epochTotalTransactions,proto3" json:"epoch_total_transactions,omitempty"`
+	// contribution_scores are the stored decayed scores per address.
+	ContributionScores []*AddressContributionScore `protobuf:"bytes,23,rep,name=contribution_scores,json=contributionScores,proto3" json:"contribution_scores,omitempty"`
+	// total_contribution_score is the stored decayed score of the network.
+	TotalContributionScore *ContributionScore `protobuf:"bytes,24,opt,name=total_contribution_score,json=totalContributionScore,proto3" json:"total_contribution_score,omitempty"`
+	// epoch_rewards are the rewards distributed in the retained epochs.
+	EpochRewards []*EpochReward `protobuf:"bytes,25,rep,name=epoch_rewards,json=epochRewards,proto3" json:"epoch_rewards,omitempty"`
+	// address_rewards are the lifetime rewards paid out per address.
+	AddressRewards []*AddressReward `protobuf:"bytes,26,rep,name=address_rewards,json=addressRewards,proto3" json:"address_rewards,omitempty"`
+	// lock_bonus is the running lock bonus on lifetime transaction counts.
+	LockBonus string `protobuf:"bytes,27,opt,name=lock_bonus,json=lockBonus,proto3" json:"lock_bonus,omitempty"`
+	// lock_score_bonus is the running lock bonus on decayed scores.
+	LockScoreBonus *ContributionScore `protobuf:"bytes,28,opt,name=lock_score_bonus,json=lockScoreBonus,proto3" json:"lock_score_bonus,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +3782,195 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetPendingInflationChange() *PendingInflationChange {
+	if x != nil {
+		return x.PendingInflationChange
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQueuedParamChanges() []*QueuedParamChange {
+	if x != nil {
+		return x.QueuedParamChanges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVotingDelegations() []*VotingDelegation {
+	if x != nil {
+		return x.VotingDelegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCandidates() []*Candidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCouncils() []*Council {
+	if x != nil {
+		return x.Councils
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardWithdrawAddresses() []*RewardWithdrawAddress {
+	if x != nil {
+		return x.RewardWithdrawAddresses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVestingPositions() []*VestingPosition {
+	if x != nil {
+		return x.VestingPositions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCampaigns() []*Campaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCampaignContributions() []*CampaignContribution {
+	if x != nil {
+		return x.CampaignContributions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBurnedSupply() string {
+	if x != nil {
+		return x.BurnedSupply
+	}
+	return ""
+}
+
+func (x *GenesisState) GetDenomMetadata() *v1beta1.Metadata {
+	if x != nil {
+		return x.DenomMetadata
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParticipations() []*Participation {
+	if x != nil {
+		return x.Participations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEpochParticipations() []*EpochParticipation {
+	if x != nil {
+		return x.EpochParticipations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProposalVotes() []*ProposalVote {
+	if x != nil {
+		return x.ProposalVotes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLocks() []*EGVLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetUnlocks() []*EGVUnlock {
+	if x != nil {
+		return x.Unlocks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGovernanceSetRemovals() *GovernanceSetRemovals {
+	if x != nil {
+		return x.GovernanceSetRemovals
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTransactionCounts() []*TransactionCount {
+	if x != nil {
+		return x.TransactionCounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalTransactions() uint64 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *GenesisState) GetEpochTransactionCounts() []*EpochTransactionCount {
+	if x != nil {
+		return x.EpochTransactionCounts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEpochTotalTransactions() []*EpochContribution {
+	if x != nil {
+		return x.EpochTotalTransactions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetContributionScores() []*AddressContributionScore {
+	if x != nil {
+		return x.ContributionScores
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTotalContributionScore() *ContributionScore {
+	if x != nil {
+		return x.TotalContributionScore
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEpochRewards() []*EpochReward {
+	if x != nil {
+		return x.EpochRewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAddressRewards() []*AddressReward {
+	if x != nil {
+		return x.AddressRewards
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLockBonus() string {
+	if x != nil {
+		return x.LockBonus
+	}
+	return ""
+}
+
+func (x *GenesisState) GetLockScoreBonus() *ContributionScore {
+	if x != nil {
+		return x.LockScoreBonus
+	}
+	return nil
+}
+
 var File_zenoda_rewards_genesis_proto protoreflect.FileDescriptor
 
 var file_zenoda_rewards_genesis_proto_rawDesc = []byte{
@@ -509,21 +3981,182 @@ var file_zenoda_rewards_genesis_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f,
 	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
-	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
-	0x96, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x19, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0xa2, 0x02, 0x03, 0x5a, 0x52, 0x58, 0xaa, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61,
-	0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0xca, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64,
-	0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0xe2, 0x02, 0x1a, 0x5a, 0x65, 0x6e, 0x6f,
-	0x64, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x3a,
-	0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1d, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x2f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1d, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x7a, 0x65,
+	0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x6e, 0x6b, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x62, 0x61, 0x6e, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x7a, 0x65, 0x6e, 0x6f,
+	0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x12, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x7a, 0x65, 0x6e, 0x6f,
+	0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x60, 0x0a, 0x18, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x16,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x5e, 0x0a, 0x14, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x12, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x12, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x44, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x63, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e,
+	0x63, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x65, 0x6e,
+	0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x63, 0x69, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x6e, 0x63, 0x69, 0x6c, 0x73, 0x12, 0x6c, 0x0a, 0x19, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x65,
+	0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x11, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x41, 0x0a, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67,
+	0x6e, 0x73, 0x12, 0x66, 0x0a, 0x16, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x2e, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x15, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x62, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x4f, 0x0a, 0x0e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x6e, 0x6b, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0d, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x50, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x60, 0x0a, 0x14, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x13, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x5f, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x7a,
+	0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56, 0x6f, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x10, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x2e, 0x45, 0x47, 0x56, 0x4c, 0x6f, 0x63, 0x6b, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x3e,
+	0x0a, 0x07, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x45, 0x47, 0x56, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x75, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x5d,
+	0x0a, 0x17, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x2e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x15, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x5a, 0x0a,
+	0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x7a, 0x65, 0x6e, 0x6f,
+	0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6a, 0x0a, 0x18, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x7a, 0x65, 0x6e,
+	0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x18, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x13,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x7a, 0x65, 0x6e, 0x6f,
+	0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x12, 0x5b, 0x0a, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x4b, 0x0a, 0x0d, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x51, 0x0a, 0x0f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x2d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x12, 0x4b,
+	0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x6f, 0x6e,
+	0x75, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x6e, 0x75, 0x73, 0x42, 0x96, 0x01, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x19, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a,
+	0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0xa2, 0x02, 0x03,
+	0x5a, 0x52, 0x58, 0xaa, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0xca, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0xe2, 0x02, 0x1a, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x0f, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x3a, 0x3a, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -540,16 +4173,63 @@ func file_zenoda_rewards_genesis_proto_rawDescGZIP() []byte {
 
 var file_zenoda_rewards_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_zenoda_rewards_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: zenoda.rewards.GenesisState
-	(*Params)(nil),       // 1: zenoda.rewards.Params
+	(*GenesisState)(nil),             // 0: zenoda.rewards.GenesisState
+	(*Params)(nil),                   // 1: zenoda.rewards.Params
+	(*PendingInflationChange)(nil),   // 2: zenoda.rewards.PendingInflationChange
+	(*QueuedParamChange)(nil),        // 3: zenoda.rewards.QueuedParamChange
+	(*VotingDelegation)(nil),         // 4: zenoda.rewards.VotingDelegation
+	(*Candidate)(nil),                // 5: zenoda.rewards.Candidate
+	(*Council)(nil),                  // 6: zenoda.rewards.Council
+	(*RewardWithdrawAddress)(nil),    // 7: zenoda.rewards.RewardWithdrawAddress
+	(*VestingPosition)(nil),          // 8: zenoda.rewards.VestingPosition
+	(*Campaign)(nil),                 // 9: zenoda.rewards.Campaign
+	(*CampaignContribution)(nil),     // 10: zenoda.rewards.CampaignContribution
+	(*v1beta1.Metadata)(nil),         // 11: cosmos.bank.v1beta1.Metadata
+	(*Participation)(nil),            // 12: zenoda.rewards.Participation
+	(*EpochParticipation)(nil),       // 13: zenoda.rewards.EpochParticipation
+	(*ProposalVote)(nil),             // 14: zenoda.rewards.ProposalVote
+	(*EGVLock)(nil),                  // 15: zenoda.rewards.EGVLock
+	(*EGVUnlock)(nil),                // 16: zenoda.rewards.EGVUnlock
+	(*GovernanceSetRemovals)(nil),    // 17: zenoda.rewards.GovernanceSetRemovals
+	(*TransactionCount)(nil),         // 18: zenoda.rewards.TransactionCount
+	(*EpochTransactionCount)(nil),    // 19: zenoda.rewards.EpochTransactionCount
+	(*EpochContribution)(nil),        // 20: zenoda.rewards.EpochContribution
+	(*AddressContributionScore)(nil), // 21: zenoda.rewards.AddressContributionScore
+	(*ContributionScore)(nil),        // 22: zenoda.rewards.ContributionScore
+	(*EpochReward)(nil),              // 23: zenoda.rewards.EpochReward
+	(*AddressReward)(nil),            // 24: zenoda.rewards.AddressReward
 }
 var file_zenoda_rewards_genesis_proto_depIdxs = []int32{
-	1, // 0: zenoda.rewards.GenesisState.params:type_name -> zenoda.rewards.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: zenoda.rewards.GenesisState.params:type_name -> zenoda.rewards.Params
+	2,  // 1: zenoda.rewards.GenesisState.pending_inflation_change:type_name -> zenoda.rewards.PendingInflationChange
+	3,  // 2: zenoda.rewards.GenesisState.queued_param_changes:type_name -> zenoda.rewards.QueuedParamChange
+	4,  // 3: zenoda.rewards.GenesisState.voting_delegations:type_name -> zenoda.rewards.VotingDelegation
+	5,  // 4: zenoda.rewards.GenesisState.candidates:type_name -> zenoda.rewards.Candidate
+	6,  // 5: zenoda.rewards.GenesisState.councils:type_name -> zenoda.rewards.Council
+	7,  // 6: zenoda.rewards.GenesisState.reward_withdraw_addresses:type_name -> zenoda.rewards.RewardWithdrawAddress
+	8,  // 7: zenoda.rewards.GenesisState.vesting_positions:type_name -> zenoda.rewards.VestingPosition
+	9,  // 8: zenoda.rewards.GenesisState.campaigns:type_name -> zenoda.rewards.Campaign
+	10, // 9: zenoda.rewards.GenesisState.campaign_contributions:type_name -> zenoda.rewards.CampaignContribution
+	11, // 10: zenoda.rewards.GenesisState.denom_metadata:type_name -> cosmos.bank.v1beta1.Metadata
+	12, // 11: zenoda.rewards.GenesisState.participations:type_name -> zenoda.rewards.Participation
+	13, // 12: zenoda.rewards.GenesisState.epoch_participations:type_name -> zenoda.rewards.EpochParticipation
+	14, // 13: zenoda.rewards.GenesisState.proposal_votes:type_name -> zenoda.rewards.ProposalVote
+	15, // 14: zenoda.rewards.GenesisState.locks:type_name -> zenoda.rewards.EGVLock
+	16, // 15: zenoda.rewards.GenesisState.unlocks:type_name -> zenoda.rewards.EGVUnlock
+	17, // 16: zenoda.rewards.GenesisState.governance_set_removals:type_name -> zenoda.rewards.GovernanceSetRemovals
+	18, // 17: zenoda.rewards.GenesisState.transaction_counts:type_name -> zenoda.rewards.TransactionCount
+	19, // 18: zenoda.rewards.GenesisState.epoch_transaction_counts:type_name -> zenoda.rewards.EpochTransactionCount
+	20, // 19: zenoda.rewards.GenesisState.epoch_total_transactions:type_name -> zenoda.rewards.EpochContribution
+	21, // 20: zenoda.rewards.GenesisState.contribution_scores:type_name -> zenoda.rewards.AddressContributionScore
+	22, // 21: zenoda.rewards.GenesisState.total_contribution_score:type_name -> zenoda.rewards.ContributionScore
+	23, // 22: zenoda.rewards.GenesisState.epoch_rewards:type_name -> zenoda.rewards.EpochReward
+	24, // 23: zenoda.rewards.GenesisState.address_rewards:type_name -> zenoda.rewards.AddressReward
+	22, // 24: zenoda.rewards.GenesisState.lock_score_bonus:type_name -> zenoda.rewards.ContributionScore
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_zenoda_rewards_genesis_proto_init() }
@@ -558,6 +4238,15 @@ func file_zenoda_rewards_genesis_proto_init() {
 		return
 	}
 	file_zenoda_rewards_params_proto_init()
+	file_zenoda_rewards_timelock_proto_init()
+	file_zenoda_rewards_voting_proto_init()
+	file_zenoda_rewards_election_proto_init()
+	file_zenoda_rewards_withdraw_proto_init()
+	file_zenoda_rewards_vesting_proto_init()
+	file_zenoda_rewards_campaign_proto_init()
+	file_zenoda_rewards_participation_proto_init()
+	file_zenoda_rewards_lock_proto_init()
+	file_zenoda_rewards_contribution_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_zenoda_rewards_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -60,10 +60,148 @@ func (x *_Params_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_28_list)(nil)
+
+type _Params_28_list struct {
+	list *[]*InflationStep
+}
+
+func (x *_Params_28_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_28_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_28_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationStep)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_28_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationStep)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_28_list) AppendMutable() protoreflect.Value {
+	v := new(InflationStep)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_28_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_28_list) NewElement() protoreflect.Value {
+	v := new(InflationStep)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_28_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_33_list)(nil)
+
+type _Params_33_list struct {
+	list *[]string
+}
+
+func (x *_Params_33_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_33_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_33_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_33_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_33_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field EgvTransferAllowlist as it is not of Message kind"))
+}
+
+func (x *_Params_33_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_33_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_33_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_inflation_rate     protoreflect.FieldDescriptor
-	fd_Params_predefined_wallets protoreflect.FieldDescriptor
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_inflation_rate                protoreflect.FieldDescriptor
+	fd_Params_predefined_wallets            protoreflect.FieldDescriptor
+	fd_Params_min_inflation_rate            protoreflect.FieldDescriptor
+	fd_Params_max_inflation_rate            protoreflect.FieldDescriptor
+	fd_Params_max_inflation_rate_change     protoreflect.FieldDescriptor
+	fd_Params_epoch_length                  protoreflect.FieldDescriptor
+	fd_Params_param_change_delay            protoreflect.FieldDescriptor
+	fd_Params_veto_threshold                protoreflect.FieldDescriptor
+	fd_Params_voting_weight_function        protoreflect.FieldDescriptor
+	fd_Params_voting_weight_cap             protoreflect.FieldDescriptor
+	fd_Params_election_interval             protoreflect.FieldDescriptor
+	fd_Params_council_size                  protoreflect.FieldDescriptor
+	fd_Params_min_candidate_balance         protoreflect.FieldDescriptor
+	fd_Params_max_consecutive_terms         protoreflect.FieldDescriptor
+	fd_Params_min_governance_set_size       protoreflect.FieldDescriptor
+	fd_Params_max_governance_set_size       protoreflect.FieldDescriptor
+	fd_Params_max_governance_set_change     protoreflect.FieldDescriptor
+	fd_Params_contribution_retention_epochs protoreflect.FieldDescriptor
+	fd_Params_contribution_score_mode       protoreflect.FieldDescriptor
+	fd_Params_score_half_life_epochs        protoreflect.FieldDescriptor
+	fd_Params_max_share_per_address         protoreflect.FieldDescriptor
+	fd_Params_reward_vesting_fraction       protoreflect.FieldDescriptor
+	fd_Params_reward_vesting_duration       protoreflect.FieldDescriptor
+	fd_Params_fee_share                     protoreflect.FieldDescriptor
+	fd_Params_max_supply                    protoreflect.FieldDescriptor
+	fd_Params_burn_fraction                 protoreflect.FieldDescriptor
+	fd_Params_inflation_schedule            protoreflect.FieldDescriptor
+	fd_Params_inflation_steps               protoreflect.FieldDescriptor
+	fd_Params_inflation_halving_epochs      protoreflect.FieldDescriptor
+	fd_Params_inflation_decay_rate          protoreflect.FieldDescriptor
+	fd_Params_inflation_floor               protoreflect.FieldDescriptor
+	fd_Params_soulbound_egv                 protoreflect.FieldDescriptor
+	fd_Params_egv_transfer_allowlist        protoreflect.FieldDescriptor
+	fd_Params_reward_denom                  protoreflect.FieldDescriptor
+	fd_Params_max_missed_governance_actions protoreflect.FieldDescriptor
+	fd_Params_inactivity_penalty            protoreflect.FieldDescriptor
+	fd_Params_inactivity_reward_reduction   protoreflect.FieldDescriptor
+	fd_Params_inactivity_penalty_epochs     protoreflect.FieldDescriptor
+	fd_Params_lock_unbonding_period         protoreflect.FieldDescriptor
+	fd_Params_lock_boost_curve              protoreflect.FieldDescriptor
+	fd_Params_lock_boost_max                protoreflect.FieldDescriptor
+	fd_Params_lock_boost_saturation         protoreflect.FieldDescriptor
+	fd_Params_min_lock_amount               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -71,6 +209,47 @@ func init() {
 	md_Params = File_zenoda_rewards_params_proto.Messages().ByName("Params")
 	fd_Params_inflation_rate = md_Params.Fields().ByName("inflation_rate")
 	fd_Params_predefined_wallets = md_Params.Fields().ByName("predefined_wallets")
+	fd_Params_min_inflation_rate = md_Params.Fields().ByName("min_inflation_rate")
+	fd_Params_max_inflation_rate = md_Params.Fields().ByName("max_inflation_rate")
+	fd_Params_max_inflation_rate_change = md_Params.Fields().ByName("max_inflation_rate_change")
+	fd_Params_epoch_length = md_Params.Fields().ByName("epoch_length")
+	fd_Params_param_change_delay = md_Params.Fields().ByName("param_change_delay")
+	fd_Params_veto_threshold = md_Params.Fields().ByName("veto_threshold")
+	fd_Params_voting_weight_function = md_Params.Fields().ByName("voting_weight_function")
+	fd_Params_voting_weight_cap = md_Params.Fields().ByName("voting_weight_cap")
+	fd_Params_election_interval = md_Params.Fields().ByName("election_interval")
+	fd_Params_council_size = md_Params.Fields().ByName("council_size")
+	fd_Params_min_candidate_balance = md_Params.Fields().ByName("min_candidate_balance")
+	fd_Params_max_consecutive_terms = md_Params.Fields().ByName("max_consecutive_terms")
+	fd_Params_min_governance_set_size = md_Params.Fields().ByName("min_governance_set_size")
+	fd_Params_max_governance_set_size = md_Params.Fields().ByName("max_governance_set_size")
+	fd_Params_max_governance_set_change = md_Params.Fields().ByName("max_governance_set_change")
+	fd_Params_contribution_retention_epochs = md_Params.Fields().ByName("contribution_retention_epochs")
+	fd_Params_contribution_score_mode = md_Params.Fields().ByName("contribution_score_mode")
+	fd_Params_score_half_life_epochs = md_Params.Fields().ByName("score_half_life_epochs")
+	fd_Params_max_share_per_address = md_Params.Fields().ByName("max_share_per_address")
+	fd_Params_reward_vesting_fraction = md_Params.Fields().ByName("reward_vesting_fraction")
+	fd_Params_reward_vesting_duration = md_Params.Fields().ByName("reward_vesting_duration")
+	fd_Params_fee_share = md_Params.Fields().ByName("fee_share")
+	fd_Params_max_supply = md_Params.Fields().ByName("max_supply")
+	fd_Params_burn_fraction = md_Params.Fields().ByName("burn_fraction")
+	fd_Params_inflation_schedule = md_Params.Fields().ByName("inflation_schedule")
+	fd_Params_inflation_steps = md_Params.Fields().ByName("inflation_steps")
+	fd_Params_inflation_halving_epochs = md_Params.Fields().ByName("inflation_halving_epochs")
+	fd_Params_inflation_decay_rate = md_Params.Fields().ByName("inflation_decay_rate")
+	fd_Params_inflation_floor = md_Params.Fields().ByName("inflation_floor")
+	fd_Params_soulbound_egv = md_Params.Fields().ByName("soulbound_egv")
+	fd_Params_egv_transfer_allowlist = md_Params.Fields().ByName("egv_transfer_allowlist")
+	fd_Params_reward_denom = md_Params.Fields().ByName("reward_denom")
+	fd_Params_max_missed_governance_actions = md_Params.Fields().ByName("max_missed_governance_actions")
+	fd_Params_inactivity_penalty = md_Params.Fields().ByName("inactivity_penalty")
+	fd_Params_inactivity_reward_reduction = md_Params.Fields().ByName("inactivity_reward_reduction")
+	fd_Params_inactivity_penalty_epochs = md_Params.Fields().ByName("inactivity_penalty_epochs")
+	fd_Params_lock_unbonding_period = md_Params.Fields().ByName("lock_unbonding_period")
+	fd_Params_lock_boost_curve = md_Params.Fields().ByName("lock_boost_curve")
+	fd_Params_lock_boost_max = md_Params.Fields().ByName("lock_boost_max")
+	fd_Params_lock_boost_saturation = md_Params.Fields().ByName("lock_boost_saturation")
+	fd_Params_min_lock_amount = md_Params.Fields().ByName("min_lock_amount")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -150,6 +329,252 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MinInflationRate != "" {
+		value := protoreflect.ValueOfString(x.MinInflationRate)
+		if !f(fd_Params_min_inflation_rate, value) {
+			return
+		}
+	}
+	if x.MaxInflationRate != "" {
+		value := protoreflect.ValueOfString(x.MaxInflationRate)
+		if !f(fd_Params_max_inflation_rate, value) {
+			return
+		}
+	}
+	if x.MaxInflationRateChange != "" {
+		value := protoreflect.ValueOfString(x.MaxInflationRateChange)
+		if !f(fd_Params_max_inflation_rate_change, value) {
+			return
+		}
+	}
+	if x.EpochLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochLength)
+		if !f(fd_Params_epoch_length, value) {
+			return
+		}
+	}
+	if x.ParamChangeDelay != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ParamChangeDelay)
+		if !f(fd_Params_param_change_delay, value) {
+			return
+		}
+	}
+	if x.VetoThreshold != "" {
+		value := protoreflect.ValueOfString(x.VetoThreshold)
+		if !f(fd_Params_veto_threshold, value) {
+			return
+		}
+	}
+	if x.VotingWeightFunction != "" {
+		value := protoreflect.ValueOfString(x.VotingWeightFunction)
+		if !f(fd_Params_voting_weight_function, value) {
+			return
+		}
+	}
+	if x.VotingWeightCap != "" {
+		value := protoreflect.ValueOfString(x.VotingWeightCap)
+		if !f(fd_Params_voting_weight_cap, value) {
+			return
+		}
+	}
+	if x.ElectionInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ElectionInterval)
+		if !f(fd_Params_election_interval, value) {
+			return
+		}
+	}
+	if x.CouncilSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CouncilSize)
+		if !f(fd_Params_council_size, value) {
+			return
+		}
+	}
+	if x.MinCandidateBalance != "" {
+		value := protoreflect.ValueOfString(x.MinCandidateBalance)
+		if !f(fd_Params_min_candidate_balance, value) {
+			return
+		}
+	}
+	if x.MaxConsecutiveTerms != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxConsecutiveTerms)
+		if !f(fd_Params_max_consecutive_terms, value) {
+			return
+		}
+	}
+	if x.MinGovernanceSetSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinGovernanceSetSize)
+		if !f(fd_Params_min_governance_set_size, value) {
+			return
+		}
+	}
+	if x.MaxGovernanceSetSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxGovernanceSetSize)
+		if !f(fd_Params_max_governance_set_size, value) {
+			return
+		}
+	}
+	if x.MaxGovernanceSetChange != "" {
+		value := protoreflect.ValueOfString(x.MaxGovernanceSetChange)
+		if !f(fd_Params_max_governance_set_change, value) {
+			return
+		}
+	}
+	if x.ContributionRetentionEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ContributionRetentionEpochs)
+		if !f(fd_Params_contribution_retention_epochs, value) {
+			return
+		}
+	}
+	if x.ContributionScoreMode != "" {
+		value := protoreflect.ValueOfString(x.ContributionScoreMode)
+		if !f(fd_Params_contribution_score_mode, value) {
+			return
+		}
+	}
+	if x.ScoreHalfLifeEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ScoreHalfLifeEpochs)
+		if !f(fd_Params_score_half_life_epochs, value) {
+			return
+		}
+	}
+	if x.MaxSharePerAddress != "" {
+		value := protoreflect.ValueOfString(x.MaxSharePerAddress)
+		if !f(fd_Params_max_share_per_address, value) {
+			return
+		}
+	}
+	if x.RewardVestingFraction != "" {
+		value := protoreflect.ValueOfString(x.RewardVestingFraction)
+		if !f(fd_Params_reward_vesting_fraction, value) {
+			return
+		}
+	}
+	if x.RewardVestingDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RewardVestingDuration)
+		if !f(fd_Params_reward_vesting_duration, value) {
+			return
+		}
+	}
+	if x.FeeShare != "" {
+		value := protoreflect.ValueOfString(x.FeeShare)
+		if !f(fd_Params_fee_share, value) {
+			return
+		}
+	}
+	if x.MaxSupply != "" {
+		value := protoreflect.ValueOfString(x.MaxSupply)
+		if !f(fd_Params_max_supply, value) {
+			return
+		}
+	}
+	if x.BurnFraction != "" {
+		value := protoreflect.ValueOfString(x.BurnFraction)
+		if !f(fd_Params_burn_fraction, value) {
+			return
+		}
+	}
+	if x.InflationSchedule != "" {
+		value := protoreflect.ValueOfString(x.InflationSchedule)
+		if !f(fd_Params_inflation_schedule, value) {
+			return
+		}
+	}
+	if len(x.InflationSteps) != 0 {
+		value := protoreflect.ValueOfList(&_Params_28_list{list: &x.InflationSteps})
+		if !f(fd_Params_inflation_steps, value) {
+			return
+		}
+	}
+	if x.InflationHalvingEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InflationHalvingEpochs)
+		if !f(fd_Params_inflation_halving_epochs, value) {
+			return
+		}
+	}
+	if x.InflationDecayRate != "" {
+		value := protoreflect.ValueOfString(x.InflationDecayRate)
+		if !f(fd_Params_inflation_decay_rate, value) {
+			return
+		}
+	}
+	if x.InflationFloor != "" {
+		value := protoreflect.ValueOfString(x.InflationFloor)
+		if !f(fd_Params_inflation_floor, value) {
+			return
+		}
+	}
+	if x.SoulboundEgv != false {
+		value := protoreflect.ValueOfBool(x.SoulboundEgv)
+		if !f(fd_Params_soulbound_egv, value) {
+			return
+		}
+	}
+	if len(x.EgvTransferAllowlist) != 0 {
+		value := protoreflect.ValueOfList(&_Params_33_list{list: &x.EgvTransferAllowlist})
+		if !f(fd_Params_egv_transfer_allowlist, value) {
+			return
+		}
+	}
+	if x.RewardDenom != "" {
+		value := protoreflect.ValueOfString(x.RewardDenom)
+		if !f(fd_Params_reward_denom, value) {
+			return
+		}
+	}
+	if x.MaxMissedGovernanceActions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMissedGovernanceActions)
+		if !f(fd_Params_max_missed_governance_actions, value) {
+			return
+		}
+	}
+	if x.InactivityPenalty != "" {
+		value := protoreflect.ValueOfString(x.InactivityPenalty)
+		if !f(fd_Params_inactivity_penalty, value) {
+			return
+		}
+	}
+	if x.InactivityRewardReduction != "" {
+		value := protoreflect.ValueOfString(x.InactivityRewardReduction)
+		if !f(fd_Params_inactivity_reward_reduction, value) {
+			return
+		}
+	}
+	if x.InactivityPenaltyEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InactivityPenaltyEpochs)
+		if !f(fd_Params_inactivity_penalty_epochs, value) {
+			return
+		}
+	}
+	if x.LockUnbondingPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockUnbondingPeriod)
+		if !f(fd_Params_lock_unbonding_period, value) {
+			return
+		}
+	}
+	if x.LockBoostCurve != "" {
+		value := protoreflect.ValueOfString(x.LockBoostCurve)
+		if !f(fd_Params_lock_boost_curve, value) {
+			return
+		}
+	}
+	if x.LockBoostMax != "" {
+		value := protoreflect.ValueOfString(x.LockBoostMax)
+		if !f(fd_Params_lock_boost_max, value) {
+			return
+		}
+	}
+	if x.LockBoostSaturation != "" {
+		value := protoreflect.ValueOfString(x.LockBoostSaturation)
+		if !f(fd_Params_lock_boost_saturation, value) {
+			return
+		}
+	}
+	if x.MinLockAmount != "" {
+		value := protoreflect.ValueOfString(x.MinLockAmount)
+		if !f(fd_Params_min_lock_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -169,6 +594,88 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.InflationRate != ""
 	case "zenoda.rewards.Params.predefined_wallets":
 		return len(x.PredefinedWallets) != 0
+	case "zenoda.rewards.Params.min_inflation_rate":
+		return x.MinInflationRate != ""
+	case "zenoda.rewards.Params.max_inflation_rate":
+		return x.MaxInflationRate != ""
+	case "zenoda.rewards.Params.max_inflation_rate_change":
+		return x.MaxInflationRateChange != ""
+	case "zenoda.rewards.Params.epoch_length":
+		return x.EpochLength != uint64(0)
+	case "zenoda.rewards.Params.param_change_delay":
+		return x.ParamChangeDelay != uint64(0)
+	case "zenoda.rewards.Params.veto_threshold":
+		return x.VetoThreshold != ""
+	case "zenoda.rewards.Params.voting_weight_function":
+		return x.VotingWeightFunction != ""
+	case "zenoda.rewards.Params.voting_weight_cap":
+		return x.VotingWeightCap != ""
+	case "zenoda.rewards.Params.election_interval":
+		return x.ElectionInterval != uint64(0)
+	case "zenoda.rewards.Params.council_size":
+		return x.CouncilSize != uint64(0)
+	case "zenoda.rewards.Params.min_candidate_balance":
+		return x.MinCandidateBalance != ""
+	case "zenoda.rewards.Params.max_consecutive_terms":
+		return x.MaxConsecutiveTerms != uint64(0)
+	case "zenoda.rewards.Params.min_governance_set_size":
+		return x.MinGovernanceSetSize != uint64(0)
+	case "zenoda.rewards.Params.max_governance_set_size":
+		return x.MaxGovernanceSetSize != uint64(0)
+	case "zenoda.rewards.Params.max_governance_set_change":
+		return x.MaxGovernanceSetChange != ""
+	case "zenoda.rewards.Params.contribution_retention_epochs":
+		return x.ContributionRetentionEpochs != uint64(0)
+	case "zenoda.rewards.Params.contribution_score_mode":
+		return x.ContributionScoreMode != ""
+	case "zenoda.rewards.Params.score_half_life_epochs":
+		return x.ScoreHalfLifeEpochs != uint64(0)
+	case "zenoda.rewards.Params.max_share_per_address":
+		return x.MaxSharePerAddress != ""
+	case "zenoda.rewards.Params.reward_vesting_fraction":
+		return x.RewardVestingFraction != ""
+	case "zenoda.rewards.Params.reward_vesting_duration":
+		return x.RewardVestingDuration != uint64(0)
+	case "zenoda.rewards.Params.fee_share":
+		return x.FeeShare != ""
+	case "zenoda.rewards.Params.max_supply":
+		return x.MaxSupply != ""
+	case "zenoda.rewards.Params.burn_fraction":
+		return x.BurnFraction != ""
+	case "zenoda.rewards.Params.inflation_schedule":
+		return x.InflationSchedule != ""
+	case "zenoda.rewards.Params.inflation_steps":
+		return len(x.InflationSteps) != 0
+	case "zenoda.rewards.Params.inflation_halving_epochs":
+		return x.InflationHalvingEpochs != uint64(0)
+	case "zenoda.rewards.Params.inflation_decay_rate":
+		return x.InflationDecayRate != ""
+	case "zenoda.rewards.Params.inflation_floor":
+		return x.InflationFloor != ""
+	case "zenoda.rewards.Params.soulbound_egv":
+		return x.SoulboundEgv != false
+	case "zenoda.rewards.Params.egv_transfer_allowlist":
+		return len(x.EgvTransferAllowlist) != 0
+	case "zenoda.rewards.Params.reward_denom":
+		return x.RewardDenom != ""
+	case "zenoda.rewards.Params.max_missed_governance_actions":
+		return x.MaxMissedGovernanceActions != uint64(0)
+	case "zenoda.rewards.Params.inactivity_penalty":
+		return x.InactivityPenalty != ""
+	case "zenoda.rewards.Params.inactivity_reward_reduction":
+		return x.InactivityRewardReduction != ""
+	case "zenoda.rewards.Params.inactivity_penalty_epochs":
+		return x.InactivityPenaltyEpochs != uint64(0)
+	case "zenoda.rewards.Params.lock_unbonding_period":
+		return x.LockUnbondingPeriod != uint64(0)
+	case "zenoda.rewards.Params.lock_boost_curve":
+		return x.LockBoostCurve != ""
+	case "zenoda.rewards.Params.lock_boost_max":
+		return x.LockBoostMax != ""
+	case "zenoda.rewards.Params.lock_boost_saturation":
+		return x.LockBoostSaturation != ""
+	case "zenoda.rewards.Params.min_lock_amount":
+		return x.MinLockAmount != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.Params"))
@@ -189,6 +696,88 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.InflationRate = ""
 	case "zenoda.rewards.Params.predefined_wallets":
 		x.PredefinedWallets = nil
+	case "zenoda.rewards.Params.min_inflation_rate":
+		x.MinInflationRate = ""
+	case "zenoda.rewards.Params.max_inflation_rate":
+		x.MaxInflationRate = ""
+	case "zenoda.rewards.Params.max_inflation_rate_change":
+		x.MaxInflationRateChange = ""
+	case "zenoda.rewards.Params.epoch_length":
+		x.EpochLength = uint64(0)
+	case "zenoda.rewards.Params.param_change_delay":
+		x.ParamChangeDelay = uint64(0)
+	case "zenoda.rewards.Params.veto_threshold":
+		x.VetoThreshold = ""
+	case "zenoda.rewards.Params.voting_weight_function":
+		x.VotingWeightFunction = ""
+	case "zenoda.rewards.Params.voting_weight_cap":
+		x.VotingWeightCap = ""
+	case "zenoda.rewards.Params.election_interval":
+		x.ElectionInterval = uint64(0)
+	case "zenoda.rewards.Params.council_size":
+		x.CouncilSize = uint64(0)
+	case "zenoda.rewards.Params.min_candidate_balance":
+		x.MinCandidateBalance = ""
+	case "zenoda.rewards.Params.max_consecutive_terms":
+		x.MaxConsecutiveTerms = uint64(0)
+	case "zenoda.rewards.Params.min_governance_set_size":
+		x.MinGovernanceSetSize = uint64(0)
+	case "zenoda.rewards.Params.max_governance_set_size":
+		x.MaxGovernanceSetSize = uint64(0)
+	case "zenoda.rewards.Params.max_governance_set_change":
+		x.MaxGovernanceSetChange = ""
+	case "zenoda.rewards.Params.contribution_retention_epochs":
+		x.ContributionRetentionEpochs = uint64(0)
+	case "zenoda.rewards.Params.contribution_score_mode":
+		x.ContributionScoreMode = ""
+	case "zenoda.rewards.Params.score_half_life_epochs":
+		x.ScoreHalfLifeEpochs = uint64(0)
+	case "zenoda.rewards.Params.max_share_per_address":
+		x.MaxSharePerAddress = ""
+	case "zenoda.rewards.Params.reward_vesting_fraction":
+		x.RewardVestingFraction = ""
+	case "zenoda.rewards.Params.reward_vesting_duration":
+		x.RewardVestingDuration = uint64(0)
+	case "zenoda.rewards.Params.fee_share":
+		x.FeeShare = ""
+	case "zenoda.rewards.Params.max_supply":
+		x.MaxSupply = ""
+	case "zenoda.rewards.Params.burn_fraction":
+		x.BurnFraction = ""
+	case "zenoda.rewards.Params.inflation_schedule":
+		x.InflationSchedule = ""
+	case "zenoda.rewards.Params.inflation_steps":
+		x.InflationSteps = nil
+	case "zenoda.rewards.Params.inflation_halving_epochs":
+		x.InflationHalvingEpochs = uint64(0)
+	case "zenoda.rewards.Params.inflation_decay_rate":
+		x.InflationDecayRate = ""
+	case "zenoda.rewards.Params.inflation_floor":
+		x.InflationFloor = ""
+	case "zenoda.rewards.Params.soulbound_egv":
+		x.SoulboundEgv = false
+	case "zenoda.rewards.Params.egv_transfer_allowlist":
+		x.EgvTransferAllowlist = nil
+	case "zenoda.rewards.Params.reward_denom":
+		x.RewardDenom = ""
+	case "zenoda.rewards.Params.max_missed_governance_actions":
+		x.MaxMissedGovernanceActions = uint64(0)
+	case "zenoda.rewards.Params.inactivity_penalty":
+		x.InactivityPenalty = ""
+	case "zenoda.rewards.Params.inactivity_reward_reduction":
+		x.InactivityRewardReduction = ""
+	case "zenoda.rewards.Params.inactivity_penalty_epochs":
+		x.InactivityPenaltyEpochs = uint64(0)
+	case "zenoda.rewards.Params.lock_unbonding_period":
+		x.LockUnbondingPeriod = uint64(0)
+	case "zenoda.rewards.Params.lock_boost_curve":
+		x.LockBoostCurve = ""
+	case "zenoda.rewards.Params.lock_boost_max":
+		x.LockBoostMax = ""
+	case "zenoda.rewards.Params.lock_boost_saturation":
+		x.LockBoostSaturation = ""
+	case "zenoda.rewards.Params.min_lock_amount":
+		x.MinLockAmount = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.Params"))
@@ -214,6 +803,135 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_2_list{list: &x.PredefinedWallets}
 		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.Params.min_inflation_rate":
+		value := x.MinInflationRate
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.max_inflation_rate":
+		value := x.MaxInflationRate
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.max_inflation_rate_change":
+		value := x.MaxInflationRateChange
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.epoch_length":
+		value := x.EpochLength
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.param_change_delay":
+		value := x.ParamChangeDelay
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.veto_threshold":
+		value := x.VetoThreshold
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.voting_weight_function":
+		value := x.VotingWeightFunction
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.voting_weight_cap":
+		value := x.VotingWeightCap
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.election_interval":
+		value := x.ElectionInterval
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.council_size":
+		value := x.CouncilSize
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.min_candidate_balance":
+		value := x.MinCandidateBalance
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.max_consecutive_terms":
+		value := x.MaxConsecutiveTerms
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.min_governance_set_size":
+		value := x.MinGovernanceSetSize
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.max_governance_set_size":
+		value := x.MaxGovernanceSetSize
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.max_governance_set_change":
+		value := x.MaxGovernanceSetChange
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.contribution_retention_epochs":
+		value := x.ContributionRetentionEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.contribution_score_mode":
+		value := x.ContributionScoreMode
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.score_half_life_epochs":
+		value := x.ScoreHalfLifeEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.max_share_per_address":
+		value := x.MaxSharePerAddress
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.reward_vesting_fraction":
+		value := x.RewardVestingFraction
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.reward_vesting_duration":
+		value := x.RewardVestingDuration
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.fee_share":
+		value := x.FeeShare
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.max_supply":
+		value := x.MaxSupply
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.burn_fraction":
+		value := x.BurnFraction
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.inflation_schedule":
+		value := x.InflationSchedule
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.inflation_steps":
+		if len(x.InflationSteps) == 0 {
+			return protoreflect.ValueOfList(&_Params_28_list{})
+		}
+		listValue := &_Params_28_list{list: &x.InflationSteps}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.Params.inflation_halving_epochs":
+		value := x.InflationHalvingEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.inflation_decay_rate":
+		value := x.InflationDecayRate
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.inflation_floor":
+		value := x.InflationFloor
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.soulbound_egv":
+		value := x.SoulboundEgv
+		return protoreflect.ValueOfBool(value)
+	case "zenoda.rewards.Params.egv_transfer_allowlist":
+		if len(x.EgvTransferAllowlist) == 0 {
+			return protoreflect.ValueOfList(&_Params_33_list{})
+		}
+		listValue := &_Params_33_list{list: &x.EgvTransferAllowlist}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.Params.reward_denom":
+		value := x.RewardDenom
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.max_missed_governance_actions":
+		value := x.MaxMissedGovernanceActions
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.inactivity_penalty":
+		value := x.InactivityPenalty
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.inactivity_reward_reduction":
+		value := x.InactivityRewardReduction
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.inactivity_penalty_epochs":
+		value := x.InactivityPenaltyEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.lock_unbonding_period":
+		value := x.LockUnbondingPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.Params.lock_boost_curve":
+		value := x.LockBoostCurve
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.lock_boost_max":
+		value := x.LockBoostMax
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.lock_boost_saturation":
+		value := x.LockBoostSaturation
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.Params.min_lock_amount":
+		value := x.MinLockAmount
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.Params"))
@@ -240,6 +958,92 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_2_list)
 		x.PredefinedWallets = *clv.list
+	case "zenoda.rewards.Params.min_inflation_rate":
+		x.MinInflationRate = value.Interface().(string)
+	case "zenoda.rewards.Params.max_inflation_rate":
+		x.MaxInflationRate = value.Interface().(string)
+	case "zenoda.rewards.Params.max_inflation_rate_change":
+		x.MaxInflationRateChange = value.Interface().(string)
+	case "zenoda.rewards.Params.epoch_length":
+		x.EpochLength = value.Uint()
+	case "zenoda.rewards.Params.param_change_delay":
+		x.ParamChangeDelay = value.Uint()
+	case "zenoda.rewards.Params.veto_threshold":
+		x.VetoThreshold = value.Interface().(string)
+	case "zenoda.rewards.Params.voting_weight_function":
+		x.VotingWeightFunction = value.Interface().(string)
+	case "zenoda.rewards.Params.voting_weight_cap":
+		x.VotingWeightCap = value.Interface().(string)
+	case "zenoda.rewards.Params.election_interval":
+		x.ElectionInterval = value.Uint()
+	case "zenoda.rewards.Params.council_size":
+		x.CouncilSize = value.Uint()
+	case "zenoda.rewards.Params.min_candidate_balance":
+		x.MinCandidateBalance = value.Interface().(string)
+	case "zenoda.rewards.Params.max_consecutive_terms":
+		x.MaxConsecutiveTerms = value.Uint()
+	case "zenoda.rewards.Params.min_governance_set_size":
+		x.MinGovernanceSetSize = value.Uint()
+	case "zenoda.rewards.Params.max_governance_set_size":
+		x.MaxGovernanceSetSize = value.Uint()
+	case "zenoda.rewards.Params.max_governance_set_change":
+		x.MaxGovernanceSetChange = value.Interface().(string)
+	case "zenoda.rewards.Params.contribution_retention_epochs":
+		x.ContributionRetentionEpochs = value.Uint()
+	case "zenoda.rewards.Params.contribution_score_mode":
+		x.ContributionScoreMode = value.Interface().(string)
+	case "zenoda.rewards.Params.score_half_life_epochs":
+		x.ScoreHalfLifeEpochs = value.Uint()
+	case "zenoda.rewards.Params.max_share_per_address":
+		x.MaxSharePerAddress = value.Interface().(string)
+	case "zenoda.rewards.Params.reward_vesting_fraction":
+		x.RewardVestingFraction = value.Interface().(string)
+	case "zenoda.rewards.Params.reward_vesting_duration":
+		x.RewardVestingDuration = value.Uint()
+	case "zenoda.rewards.Params.fee_share":
+		x.FeeShare = value.Interface().(string)
+	case "zenoda.rewards.Params.max_supply":
+		x.MaxSupply = value.Interface().(string)
+	case "zenoda.rewards.Params.burn_fraction":
+		x.BurnFraction = value.Interface().(string)
+	case "zenoda.rewards.Params.inflation_schedule":
+		x.InflationSchedule = value.Interface().(string)
+	case "zenoda.rewards.Params.inflation_steps":
+		lv := value.List()
+		clv := lv.(*_Params_28_list)
+		x.InflationSteps = *clv.list
+	case "zenoda.rewards.Params.inflation_halving_epochs":
+		x.InflationHalvingEpochs = value.Uint()
+	case "zenoda.rewards.Params.inflation_decay_rate":
+		x.InflationDecayRate = value.Interface().(string)
+	case "zenoda.rewards.Params.inflation_floor":
+		x.InflationFloor = value.Interface().(string)
+	case "zenoda.rewards.Params.soulbound_egv":
+		x.SoulboundEgv = value.Bool()
+	case "zenoda.rewards.Params.egv_transfer_allowlist":
+		lv := value.List()
+		clv := lv.(*_Params_33_list)
+		x.EgvTransferAllowlist = *clv.list
+	case "zenoda.rewards.Params.reward_denom":
+		x.RewardDenom = value.Interface().(string)
+	case "zenoda.rewards.Params.max_missed_governance_actions":
+		x.MaxMissedGovernanceActions = value.Uint()
+	case "zenoda.rewards.Params.inactivity_penalty":
+		x.InactivityPenalty = value.Interface().(string)
+	case "zenoda.rewards.Params.inactivity_reward_reduction":
+		x.InactivityRewardReduction = value.Interface().(string)
+	case "zenoda.rewards.Params.inactivity_penalty_epochs":
+		x.InactivityPenaltyEpochs = value.Uint()
+	case "zenoda.rewards.Params.lock_unbonding_period":
+		x.LockUnbondingPeriod = value.Uint()
+	case "zenoda.rewards.Params.lock_boost_curve":
+		x.LockBoostCurve = value.Interface().(string)
+	case "zenoda.rewards.Params.lock_boost_max":
+		x.LockBoostMax = value.Interface().(string)
+	case "zenoda.rewards.Params.lock_boost_saturation":
+		x.LockBoostSaturation = value.Interface().(string)
+	case "zenoda.rewards.Params.min_lock_amount":
+		x.MinLockAmount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.Params"))
@@ -266,8 +1070,98 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_2_list{list: &x.PredefinedWallets}
 		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.Params.inflation_steps":
+		if x.InflationSteps == nil {
+			x.InflationSteps = []*InflationStep{}
+		}
+		value := &_Params_28_list{list: &x.InflationSteps}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.Params.egv_transfer_allowlist":
+		if x.EgvTransferAllowlist == nil {
+			x.EgvTransferAllowlist = []string{}
+		}
+		value := &_Params_33_list{list: &x.EgvTransferAllowlist}
+		return protoreflect.ValueOfList(value)
 	case "zenoda.rewards.Params.inflation_rate":
 		panic(fmt.Errorf("field inflation_rate of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.min_inflation_rate":
+		panic(fmt.Errorf("field min_inflation_rate of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_inflation_rate":
+		panic(fmt.Errorf("field max_inflation_rate of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_inflation_rate_change":
+		panic(fmt.Errorf("field max_inflation_rate_change of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.param_change_delay":
+		panic(fmt.Errorf("field param_change_delay of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.veto_threshold":
+		panic(fmt.Errorf("field veto_threshold of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.voting_weight_function":
+		panic(fmt.Errorf("field voting_weight_function of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.voting_weight_cap":
+		panic(fmt.Errorf("field voting_weight_cap of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.election_interval":
+		panic(fmt.Errorf("field election_interval of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.council_size":
+		panic(fmt.Errorf("field council_size of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.min_candidate_balance":
+		panic(fmt.Errorf("field min_candidate_balance of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_consecutive_terms":
+		panic(fmt.Errorf("field max_consecutive_terms of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.min_governance_set_size":
+		panic(fmt.Errorf("field min_governance_set_size of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_governance_set_size":
+		panic(fmt.Errorf("field max_governance_set_size of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_governance_set_change":
+		panic(fmt.Errorf("field max_governance_set_change of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.contribution_retention_epochs":
+		panic(fmt.Errorf("field contribution_retention_epochs of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.contribution_score_mode":
+		panic(fmt.Errorf("field contribution_score_mode of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.score_half_life_epochs":
+		panic(fmt.Errorf("field score_half_life_epochs of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_share_per_address":
+		panic(fmt.Errorf("field max_share_per_address of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.reward_vesting_fraction":
+		panic(fmt.Errorf("field reward_vesting_fraction of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.reward_vesting_duration":
+		panic(fmt.Errorf("field reward_vesting_duration of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.fee_share":
+		panic(fmt.Errorf("field fee_share of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_supply":
+		panic(fmt.Errorf("field max_supply of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.burn_fraction":
+		panic(fmt.Errorf("field burn_fraction of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.inflation_schedule":
+		panic(fmt.Errorf("field inflation_schedule of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.inflation_halving_epochs":
+		panic(fmt.Errorf("field inflation_halving_epochs of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.inflation_decay_rate":
+		panic(fmt.Errorf("field inflation_decay_rate of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.inflation_floor":
+		panic(fmt.Errorf("field inflation_floor of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.soulbound_egv":
+		panic(fmt.Errorf("field soulbound_egv of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.reward_denom":
+		panic(fmt.Errorf("field reward_denom of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.max_missed_governance_actions":
+		panic(fmt.Errorf("field max_missed_governance_actions of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.inactivity_penalty":
+		panic(fmt.Errorf("field inactivity_penalty of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.inactivity_reward_reduction":
+		panic(fmt.Errorf("field inactivity_reward_reduction of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.inactivity_penalty_epochs":
+		panic(fmt.Errorf("field inactivity_penalty_epochs of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.lock_unbonding_period":
+		panic(fmt.Errorf("field lock_unbonding_period of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.lock_boost_curve":
+		panic(fmt.Errorf("field lock_boost_curve of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.lock_boost_max":
+		panic(fmt.Errorf("field lock_boost_max of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.lock_boost_saturation":
+		panic(fmt.Errorf("field lock_boost_saturation of message zenoda.rewards.Params is not mutable"))
+	case "zenoda.rewards.Params.min_lock_amount":
+		panic(fmt.Errorf("field min_lock_amount of message zenoda.rewards.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.Params"))
@@ -286,6 +1180,90 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "zenoda.rewards.Params.predefined_wallets":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
+	case "zenoda.rewards.Params.min_inflation_rate":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.max_inflation_rate":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.max_inflation_rate_change":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.epoch_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.param_change_delay":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.veto_threshold":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.voting_weight_function":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.voting_weight_cap":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.election_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.council_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.min_candidate_balance":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.max_consecutive_terms":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.min_governance_set_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.max_governance_set_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.max_governance_set_change":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.contribution_retention_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.contribution_score_mode":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.score_half_life_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.max_share_per_address":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.reward_vesting_fraction":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.reward_vesting_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.fee_share":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.max_supply":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.burn_fraction":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.inflation_schedule":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.inflation_steps":
+		list := []*InflationStep{}
+		return protoreflect.ValueOfList(&_Params_28_list{list: &list})
+	case "zenoda.rewards.Params.inflation_halving_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.inflation_decay_rate":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.inflation_floor":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.soulbound_egv":
+		return protoreflect.ValueOfBool(false)
+	case "zenoda.rewards.Params.egv_transfer_allowlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_33_list{list: &list})
+	case "zenoda.rewards.Params.reward_denom":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.max_missed_governance_actions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.inactivity_penalty":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.inactivity_reward_reduction":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.inactivity_penalty_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.lock_unbonding_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.Params.lock_boost_curve":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.lock_boost_max":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.lock_boost_saturation":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.Params.min_lock_amount":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.Params"))
@@ -365,15 +1343,168 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		l = len(x.MinInflationRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		l = len(x.MaxInflationRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-	}
-
+		l = len(x.MaxInflationRateChange)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		}
+		if x.ParamChangeDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.ParamChangeDelay))
+		}
+		l = len(x.VetoThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VotingWeightFunction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VotingWeightCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ElectionInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.ElectionInterval))
+		}
+		if x.CouncilSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.CouncilSize))
+		}
+		l = len(x.MinCandidateBalance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxConsecutiveTerms != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxConsecutiveTerms))
+		}
+		if x.MinGovernanceSetSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinGovernanceSetSize))
+		}
+		if x.MaxGovernanceSetSize != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxGovernanceSetSize))
+		}
+		l = len(x.MaxGovernanceSetChange)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ContributionRetentionEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.ContributionRetentionEpochs))
+		}
+		l = len(x.ContributionScoreMode)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ScoreHalfLifeEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.ScoreHalfLifeEpochs))
+		}
+		l = len(x.MaxSharePerAddress)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RewardVestingFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.RewardVestingDuration != 0 {
+			n += 2 + runtime.Sov(uint64(x.RewardVestingDuration))
+		}
+		l = len(x.FeeShare)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxSupply)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BurnFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationSchedule)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.InflationSteps) > 0 {
+			for _, e := range x.InflationSteps {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.InflationHalvingEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.InflationHalvingEpochs))
+		}
+		l = len(x.InflationDecayRate)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationFloor)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.SoulboundEgv {
+			n += 3
+		}
+		if len(x.EgvTransferAllowlist) > 0 {
+			for _, s := range x.EgvTransferAllowlist {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.RewardDenom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxMissedGovernanceActions != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxMissedGovernanceActions))
+		}
+		l = len(x.InactivityPenalty)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InactivityRewardReduction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.InactivityPenaltyEpochs != 0 {
+			n += 2 + runtime.Sov(uint64(x.InactivityPenaltyEpochs))
+		}
+		if x.LockUnbondingPeriod != 0 {
+			n += 2 + runtime.Sov(uint64(x.LockUnbondingPeriod))
+		}
+		l = len(x.LockBoostCurve)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockBoostMax)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LockBoostSaturation)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinLockAmount)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
 		x := input.Message.Interface().(*Params)
 		if x == nil {
@@ -394,6 +1525,335 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MinLockAmount) > 0 {
+			i -= len(x.MinLockAmount)
+			copy(dAtA[i:], x.MinLockAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinLockAmount)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+		if len(x.LockBoostSaturation) > 0 {
+			i -= len(x.LockBoostSaturation)
+			copy(dAtA[i:], x.LockBoostSaturation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockBoostSaturation)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
+		}
+		if len(x.LockBoostMax) > 0 {
+			i -= len(x.LockBoostMax)
+			copy(dAtA[i:], x.LockBoostMax)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockBoostMax)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.LockBoostCurve) > 0 {
+			i -= len(x.LockBoostCurve)
+			copy(dAtA[i:], x.LockBoostCurve)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LockBoostCurve)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+		if x.LockUnbondingPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockUnbondingPeriod))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.InactivityPenaltyEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InactivityPenaltyEpochs))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb0
+		}
+		if len(x.InactivityRewardReduction) > 0 {
+			i -= len(x.InactivityRewardReduction)
+			copy(dAtA[i:], x.InactivityRewardReduction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InactivityRewardReduction)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.InactivityPenalty) > 0 {
+			i -= len(x.InactivityPenalty)
+			copy(dAtA[i:], x.InactivityPenalty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InactivityPenalty)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+		if x.MaxMissedGovernanceActions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMissedGovernanceActions))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x98
+		}
+		if len(x.RewardDenom) > 0 {
+			i -= len(x.RewardDenom)
+			copy(dAtA[i:], x.RewardDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardDenom)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.EgvTransferAllowlist) > 0 {
+			for iNdEx := len(x.EgvTransferAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.EgvTransferAllowlist[iNdEx])
+				copy(dAtA[i:], x.EgvTransferAllowlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EgvTransferAllowlist[iNdEx])))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if x.SoulboundEgv {
+			i--
+			if x.SoulboundEgv {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.InflationFloor) > 0 {
+			i -= len(x.InflationFloor)
+			copy(dAtA[i:], x.InflationFloor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationFloor)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+		if len(x.InflationDecayRate) > 0 {
+			i -= len(x.InflationDecayRate)
+			copy(dAtA[i:], x.InflationDecayRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationDecayRate)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if x.InflationHalvingEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InflationHalvingEpochs))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe8
+		}
+		if len(x.InflationSteps) > 0 {
+			for iNdEx := len(x.InflationSteps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InflationSteps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xe2
+			}
+		}
+		if len(x.InflationSchedule) > 0 {
+			i -= len(x.InflationSchedule)
+			copy(dAtA[i:], x.InflationSchedule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationSchedule)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if len(x.BurnFraction) > 0 {
+			i -= len(x.BurnFraction)
+			copy(dAtA[i:], x.BurnFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+		if len(x.MaxSupply) > 0 {
+			i -= len(x.MaxSupply)
+			copy(dAtA[i:], x.MaxSupply)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSupply)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.FeeShare) > 0 {
+			i -= len(x.FeeShare)
+			copy(dAtA[i:], x.FeeShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeShare)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if x.RewardVestingDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardVestingDuration))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if len(x.RewardVestingFraction) > 0 {
+			i -= len(x.RewardVestingFraction)
+			copy(dAtA[i:], x.RewardVestingFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RewardVestingFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if len(x.MaxSharePerAddress) > 0 {
+			i -= len(x.MaxSharePerAddress)
+			copy(dAtA[i:], x.MaxSharePerAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxSharePerAddress)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if x.ScoreHalfLifeEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScoreHalfLifeEpochs))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if len(x.ContributionScoreMode) > 0 {
+			i -= len(x.ContributionScoreMode)
+			copy(dAtA[i:], x.ContributionScoreMode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContributionScoreMode)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.ContributionRetentionEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContributionRetentionEpochs))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.MaxGovernanceSetChange) > 0 {
+			i -= len(x.MaxGovernanceSetChange)
+			copy(dAtA[i:], x.MaxGovernanceSetChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxGovernanceSetChange)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.MaxGovernanceSetSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxGovernanceSetSize))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.MinGovernanceSetSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinGovernanceSetSize))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MaxConsecutiveTerms != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxConsecutiveTerms))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.MinCandidateBalance) > 0 {
+			i -= len(x.MinCandidateBalance)
+			copy(dAtA[i:], x.MinCandidateBalance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinCandidateBalance)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.CouncilSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CouncilSize))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.ElectionInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ElectionInterval))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.VotingWeightCap) > 0 {
+			i -= len(x.VotingWeightCap)
+			copy(dAtA[i:], x.VotingWeightCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VotingWeightCap)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.VotingWeightFunction) > 0 {
+			i -= len(x.VotingWeightFunction)
+			copy(dAtA[i:], x.VotingWeightFunction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VotingWeightFunction)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.VetoThreshold) > 0 {
+			i -= len(x.VetoThreshold)
+			copy(dAtA[i:], x.VetoThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoThreshold)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ParamChangeDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ParamChangeDelay))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.MaxInflationRateChange) > 0 {
+			i -= len(x.MaxInflationRateChange)
+			copy(dAtA[i:], x.MaxInflationRateChange)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxInflationRateChange)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MaxInflationRate) > 0 {
+			i -= len(x.MaxInflationRate)
+			copy(dAtA[i:], x.MaxInflationRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxInflationRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MinInflationRate) > 0 {
+			i -= len(x.MinInflationRate)
+			copy(dAtA[i:], x.MinInflationRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInflationRate)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.PredefinedWallets) > 0 {
 			for iNdEx := len(x.PredefinedWallets) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.PredefinedWallets[iNdEx])
@@ -523,98 +1983,3092 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.PredefinedWallets = append(x.PredefinedWallets, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInflationRate", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MinInflationRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxInflationRate", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: zenoda/rewards/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxInflationRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxInflationRateChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxInflationRateChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				}
+				x.EpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParamChangeDelay", wireType)
+				}
+				x.ParamChangeDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ParamChangeDelay |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingWeightFunction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingWeightFunction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingWeightCap", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingWeightCap = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ElectionInterval", wireType)
+				}
+				x.ElectionInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ElectionInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CouncilSize", wireType)
+				}
+				x.CouncilSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CouncilSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinCandidateBalance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinCandidateBalance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveTerms", wireType)
+				}
+				x.MaxConsecutiveTerms = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxConsecutiveTerms |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGovernanceSetSize", wireType)
+				}
+				x.MinGovernanceSetSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinGovernanceSetSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxGovernanceSetSize", wireType)
+				}
+				x.MaxGovernanceSetSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxGovernanceSetSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxGovernanceSetChange", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxGovernanceSetChange = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContributionRetentionEpochs", wireType)
+				}
+				x.ContributionRetentionEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ContributionRetentionEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContributionScoreMode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContributionScoreMode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScoreHalfLifeEpochs", wireType)
+				}
+				x.ScoreHalfLifeEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScoreHalfLifeEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSharePerAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSharePerAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardVestingFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardVestingFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+				}
+				x.RewardVestingDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardVestingDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxSupply = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationSchedule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 28:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationSteps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationSteps = append(x.InflationSteps, &InflationStep{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InflationSteps[len(x.InflationSteps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 29:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationHalvingEpochs", wireType)
+				}
+				x.InflationHalvingEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InflationHalvingEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationDecayRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationDecayRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 31:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationFloor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationFloor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 32:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SoulboundEgv", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SoulboundEgv = bool(v != 0)
+			case 33:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EgvTransferAllowlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EgvTransferAllowlist = append(x.EgvTransferAllowlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 35:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMissedGovernanceActions", wireType)
+				}
+				x.MaxMissedGovernanceActions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMissedGovernanceActions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 36:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InactivityPenalty = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 37:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivityRewardReduction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InactivityRewardReduction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 38:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InactivityPenaltyEpochs", wireType)
+				}
+				x.InactivityPenaltyEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InactivityPenaltyEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 39:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockUnbondingPeriod", wireType)
+				}
+				x.LockUnbondingPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockUnbondingPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 40:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockBoostCurve", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockBoostCurve = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 41:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockBoostMax", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockBoostMax = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 42:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockBoostSaturation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockBoostSaturation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 43:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinLockAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PendingInflationChange_4_list)(nil)
+
+type _PendingInflationChange_4_list struct {
+	list *[]*InflationStep
+}
+
+func (x *_PendingInflationChange_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PendingInflationChange_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PendingInflationChange_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationStep)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PendingInflationChange_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InflationStep)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PendingInflationChange_4_list) AppendMutable() protoreflect.Value {
+	v := new(InflationStep)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingInflationChange_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PendingInflationChange_4_list) NewElement() protoreflect.Value {
+	v := new(InflationStep)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PendingInflationChange_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PendingInflationChange                          protoreflect.MessageDescriptor
+	fd_PendingInflationChange_inflation_rate           protoreflect.FieldDescriptor
+	fd_PendingInflationChange_activation_height        protoreflect.FieldDescriptor
+	fd_PendingInflationChange_inflation_schedule       protoreflect.FieldDescriptor
+	fd_PendingInflationChange_inflation_steps          protoreflect.FieldDescriptor
+	fd_PendingInflationChange_inflation_halving_epochs protoreflect.FieldDescriptor
+	fd_PendingInflationChange_inflation_decay_rate     protoreflect.FieldDescriptor
+	fd_PendingInflationChange_inflation_floor          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zenoda_rewards_params_proto_init()
+	md_PendingInflationChange = File_zenoda_rewards_params_proto.Messages().ByName("PendingInflationChange")
+	fd_PendingInflationChange_inflation_rate = md_PendingInflationChange.Fields().ByName("inflation_rate")
+	fd_PendingInflationChange_activation_height = md_PendingInflationChange.Fields().ByName("activation_height")
+	fd_PendingInflationChange_inflation_schedule = md_PendingInflationChange.Fields().ByName("inflation_schedule")
+	fd_PendingInflationChange_inflation_steps = md_PendingInflationChange.Fields().ByName("inflation_steps")
+	fd_PendingInflationChange_inflation_halving_epochs = md_PendingInflationChange.Fields().ByName("inflation_halving_epochs")
+	fd_PendingInflationChange_inflation_decay_rate = md_PendingInflationChange.Fields().ByName("inflation_decay_rate")
+	fd_PendingInflationChange_inflation_floor = md_PendingInflationChange.Fields().ByName("inflation_floor")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingInflationChange)(nil)
+
+type fastReflection_PendingInflationChange PendingInflationChange
+
+func (x *PendingInflationChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingInflationChange)(x)
+}
+
+func (x *PendingInflationChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_zenoda_rewards_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingInflationChange_messageType fastReflection_PendingInflationChange_messageType
+var _ protoreflect.MessageType = fastReflection_PendingInflationChange_messageType{}
+
+type fastReflection_PendingInflationChange_messageType struct{}
+
+func (x fastReflection_PendingInflationChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingInflationChange)(nil)
+}
+func (x fastReflection_PendingInflationChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingInflationChange)
+}
+func (x fastReflection_PendingInflationChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingInflationChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingInflationChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingInflationChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingInflationChange) Type() protoreflect.MessageType {
+	return _fastReflection_PendingInflationChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingInflationChange) New() protoreflect.Message {
+	return new(fastReflection_PendingInflationChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingInflationChange) Interface() protoreflect.ProtoMessage {
+	return (*PendingInflationChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingInflationChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InflationRate != "" {
+		value := protoreflect.ValueOfString(x.InflationRate)
+		if !f(fd_PendingInflationChange_inflation_rate, value) {
+			return
+		}
+	}
+	if x.ActivationHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ActivationHeight)
+		if !f(fd_PendingInflationChange_activation_height, value) {
+			return
+		}
+	}
+	if x.InflationSchedule != "" {
+		value := protoreflect.ValueOfString(x.InflationSchedule)
+		if !f(fd_PendingInflationChange_inflation_schedule, value) {
+			return
+		}
+	}
+	if len(x.InflationSteps) != 0 {
+		value := protoreflect.ValueOfList(&_PendingInflationChange_4_list{list: &x.InflationSteps})
+		if !f(fd_PendingInflationChange_inflation_steps, value) {
+			return
+		}
+	}
+	if x.InflationHalvingEpochs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.InflationHalvingEpochs)
+		if !f(fd_PendingInflationChange_inflation_halving_epochs, value) {
+			return
+		}
+	}
+	if x.InflationDecayRate != "" {
+		value := protoreflect.ValueOfString(x.InflationDecayRate)
+		if !f(fd_PendingInflationChange_inflation_decay_rate, value) {
+			return
+		}
+	}
+	if x.InflationFloor != "" {
+		value := protoreflect.ValueOfString(x.InflationFloor)
+		if !f(fd_PendingInflationChange_inflation_floor, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingInflationChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zenoda.rewards.PendingInflationChange.inflation_rate":
+		return x.InflationRate != ""
+	case "zenoda.rewards.PendingInflationChange.activation_height":
+		return x.ActivationHeight != int64(0)
+	case "zenoda.rewards.PendingInflationChange.inflation_schedule":
+		return x.InflationSchedule != ""
+	case "zenoda.rewards.PendingInflationChange.inflation_steps":
+		return len(x.InflationSteps) != 0
+	case "zenoda.rewards.PendingInflationChange.inflation_halving_epochs":
+		return x.InflationHalvingEpochs != uint64(0)
+	case "zenoda.rewards.PendingInflationChange.inflation_decay_rate":
+		return x.InflationDecayRate != ""
+	case "zenoda.rewards.PendingInflationChange.inflation_floor":
+		return x.InflationFloor != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.PendingInflationChange"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.PendingInflationChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingInflationChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zenoda.rewards.PendingInflationChange.inflation_rate":
+		x.InflationRate = ""
+	case "zenoda.rewards.PendingInflationChange.activation_height":
+		x.ActivationHeight = int64(0)
+	case "zenoda.rewards.PendingInflationChange.inflation_schedule":
+		x.InflationSchedule = ""
+	case "zenoda.rewards.PendingInflationChange.inflation_steps":
+		x.InflationSteps = nil
+	case "zenoda.rewards.PendingInflationChange.inflation_halving_epochs":
+		x.InflationHalvingEpochs = uint64(0)
+	case "zenoda.rewards.PendingInflationChange.inflation_decay_rate":
+		x.InflationDecayRate = ""
+	case "zenoda.rewards.PendingInflationChange.inflation_floor":
+		x.InflationFloor = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.PendingInflationChange"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.PendingInflationChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingInflationChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zenoda.rewards.PendingInflationChange.inflation_rate":
+		value := x.InflationRate
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.PendingInflationChange.activation_height":
+		value := x.ActivationHeight
+		return protoreflect.ValueOfInt64(value)
+	case "zenoda.rewards.PendingInflationChange.inflation_schedule":
+		value := x.InflationSchedule
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.PendingInflationChange.inflation_steps":
+		if len(x.InflationSteps) == 0 {
+			return protoreflect.ValueOfList(&_PendingInflationChange_4_list{})
+		}
+		listValue := &_PendingInflationChange_4_list{list: &x.InflationSteps}
+		return protoreflect.ValueOfList(listValue)
+	case "zenoda.rewards.PendingInflationChange.inflation_halving_epochs":
+		value := x.InflationHalvingEpochs
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.PendingInflationChange.inflation_decay_rate":
+		value := x.InflationDecayRate
+		return protoreflect.ValueOfString(value)
+	case "zenoda.rewards.PendingInflationChange.inflation_floor":
+		value := x.InflationFloor
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.PendingInflationChange"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.PendingInflationChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingInflationChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zenoda.rewards.PendingInflationChange.inflation_rate":
+		x.InflationRate = value.Interface().(string)
+	case "zenoda.rewards.PendingInflationChange.activation_height":
+		x.ActivationHeight = value.Int()
+	case "zenoda.rewards.PendingInflationChange.inflation_schedule":
+		x.InflationSchedule = value.Interface().(string)
+	case "zenoda.rewards.PendingInflationChange.inflation_steps":
+		lv := value.List()
+		clv := lv.(*_PendingInflationChange_4_list)
+		x.InflationSteps = *clv.list
+	case "zenoda.rewards.PendingInflationChange.inflation_halving_epochs":
+		x.InflationHalvingEpochs = value.Uint()
+	case "zenoda.rewards.PendingInflationChange.inflation_decay_rate":
+		x.InflationDecayRate = value.Interface().(string)
+	case "zenoda.rewards.PendingInflationChange.inflation_floor":
+		x.InflationFloor = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.PendingInflationChange"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.PendingInflationChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingInflationChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.rewards.PendingInflationChange.inflation_steps":
+		if x.InflationSteps == nil {
+			x.InflationSteps = []*InflationStep{}
+		}
+		value := &_PendingInflationChange_4_list{list: &x.InflationSteps}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.rewards.PendingInflationChange.inflation_rate":
+		panic(fmt.Errorf("field inflation_rate of message zenoda.rewards.PendingInflationChange is not mutable"))
+	case "zenoda.rewards.PendingInflationChange.activation_height":
+		panic(fmt.Errorf("field activation_height of message zenoda.rewards.PendingInflationChange is not mutable"))
+	case "zenoda.rewards.PendingInflationChange.inflation_schedule":
+		panic(fmt.Errorf("field inflation_schedule of message zenoda.rewards.PendingInflationChange is not mutable"))
+	case "zenoda.rewards.PendingInflationChange.inflation_halving_epochs":
+		panic(fmt.Errorf("field inflation_halving_epochs of message zenoda.rewards.PendingInflationChange is not mutable"))
+	case "zenoda.rewards.PendingInflationChange.inflation_decay_rate":
+		panic(fmt.Errorf("field inflation_decay_rate of message zenoda.rewards.PendingInflationChange is not mutable"))
+	case "zenoda.rewards.PendingInflationChange.inflation_floor":
+		panic(fmt.Errorf("field inflation_floor of message zenoda.rewards.PendingInflationChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.PendingInflationChange"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.PendingInflationChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingInflationChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.rewards.PendingInflationChange.inflation_rate":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.PendingInflationChange.activation_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "zenoda.rewards.PendingInflationChange.inflation_schedule":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.PendingInflationChange.inflation_steps":
+		list := []*InflationStep{}
+		return protoreflect.ValueOfList(&_PendingInflationChange_4_list{list: &list})
+	case "zenoda.rewards.PendingInflationChange.inflation_halving_epochs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.PendingInflationChange.inflation_decay_rate":
+		return protoreflect.ValueOfString("")
+	case "zenoda.rewards.PendingInflationChange.inflation_floor":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.PendingInflationChange"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.PendingInflationChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingInflationChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zenoda.rewards.PendingInflationChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingInflationChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingInflationChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingInflationChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingInflationChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingInflationChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InflationRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ActivationHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ActivationHeight))
+		}
+		l = len(x.InflationSchedule)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.InflationSteps) > 0 {
+			for _, e := range x.InflationSteps {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.InflationHalvingEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.InflationHalvingEpochs))
+		}
+		l = len(x.InflationDecayRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.InflationFloor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingInflationChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InflationFloor) > 0 {
+			i -= len(x.InflationFloor)
+			copy(dAtA[i:], x.InflationFloor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationFloor)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.InflationDecayRate) > 0 {
+			i -= len(x.InflationDecayRate)
+			copy(dAtA[i:], x.InflationDecayRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationDecayRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.InflationHalvingEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InflationHalvingEpochs))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.InflationSteps) > 0 {
+			for iNdEx := len(x.InflationSteps) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InflationSteps[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.InflationSchedule) > 0 {
+			i -= len(x.InflationSchedule)
+			copy(dAtA[i:], x.InflationSchedule)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationSchedule)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ActivationHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ActivationHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.InflationRate) > 0 {
+			i -= len(x.InflationRate)
+			copy(dAtA[i:], x.InflationRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationRate)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingInflationChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingInflationChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingInflationChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+				}
+				x.ActivationHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ActivationHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationSchedule = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationSteps", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationSteps = append(x.InflationSteps, &InflationStep{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InflationSteps[len(x.InflationSteps)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationHalvingEpochs", wireType)
+				}
+				x.InflationHalvingEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InflationHalvingEpochs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationDecayRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationDecayRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationFloor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationFloor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_InflationStep                protoreflect.MessageDescriptor
+	fd_InflationStep_start_epoch    protoreflect.FieldDescriptor
+	fd_InflationStep_inflation_rate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zenoda_rewards_params_proto_init()
+	md_InflationStep = File_zenoda_rewards_params_proto.Messages().ByName("InflationStep")
+	fd_InflationStep_start_epoch = md_InflationStep.Fields().ByName("start_epoch")
+	fd_InflationStep_inflation_rate = md_InflationStep.Fields().ByName("inflation_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_InflationStep)(nil)
+
+type fastReflection_InflationStep InflationStep
+
+func (x *InflationStep) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InflationStep)(x)
+}
+
+func (x *InflationStep) slowProtoReflect() protoreflect.Message {
+	mi := &file_zenoda_rewards_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InflationStep_messageType fastReflection_InflationStep_messageType
+var _ protoreflect.MessageType = fastReflection_InflationStep_messageType{}
+
+type fastReflection_InflationStep_messageType struct{}
+
+func (x fastReflection_InflationStep_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InflationStep)(nil)
+}
+func (x fastReflection_InflationStep_messageType) New() protoreflect.Message {
+	return new(fastReflection_InflationStep)
+}
+func (x fastReflection_InflationStep_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationStep
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InflationStep) Descriptor() protoreflect.MessageDescriptor {
+	return md_InflationStep
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InflationStep) Type() protoreflect.MessageType {
+	return _fastReflection_InflationStep_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InflationStep) New() protoreflect.Message {
+	return new(fastReflection_InflationStep)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InflationStep) Interface() protoreflect.ProtoMessage {
+	return (*InflationStep)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InflationStep) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartEpoch)
+		if !f(fd_InflationStep_start_epoch, value) {
+			return
+		}
+	}
+	if x.InflationRate != "" {
+		value := protoreflect.ValueOfString(x.InflationRate)
+		if !f(fd_InflationStep_inflation_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InflationStep) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zenoda.rewards.InflationStep.start_epoch":
+		return x.StartEpoch != uint64(0)
+	case "zenoda.rewards.InflationStep.inflation_rate":
+		return x.InflationRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.InflationStep"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.InflationStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationStep) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zenoda.rewards.InflationStep.start_epoch":
+		x.StartEpoch = uint64(0)
+	case "zenoda.rewards.InflationStep.inflation_rate":
+		x.InflationRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.InflationStep"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.InflationStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InflationStep) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zenoda.rewards.InflationStep.start_epoch":
+		value := x.StartEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.rewards.InflationStep.inflation_rate":
+		value := x.InflationRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.InflationStep"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.InflationStep does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationStep) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zenoda.rewards.InflationStep.start_epoch":
+		x.StartEpoch = value.Uint()
+	case "zenoda.rewards.InflationStep.inflation_rate":
+		x.InflationRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.InflationStep"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.InflationStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationStep) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.rewards.InflationStep.start_epoch":
+		panic(fmt.Errorf("field start_epoch of message zenoda.rewards.InflationStep is not mutable"))
+	case "zenoda.rewards.InflationStep.inflation_rate":
+		panic(fmt.Errorf("field inflation_rate of message zenoda.rewards.InflationStep is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.InflationStep"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.InflationStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InflationStep) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.rewards.InflationStep.start_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.rewards.InflationStep.inflation_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.rewards.InflationStep"))
+		}
+		panic(fmt.Errorf("message zenoda.rewards.InflationStep does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InflationStep) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zenoda.rewards.InflationStep", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InflationStep) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InflationStep) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InflationStep) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InflationStep) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InflationStep)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartEpoch))
+		}
+		l = len(x.InflationRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InflationStep)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InflationRate) > 0 {
+			i -= len(x.InflationRate)
+			copy(dAtA[i:], x.InflationRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InflationRate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StartEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartEpoch))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InflationStep)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+				}
+				x.StartEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InflationRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zenoda/rewards/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
 	// Verify that runtime/protoimpl is sufficiently up-to-date.
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Params defines the parameters for the module.
-type Params struct {
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InflationRate     string   `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+	PredefinedWallets []string `protobuf:"bytes,2,rep,name=predefined_wallets,json=predefinedWallets,proto3" json:"predefined_wallets,omitempty"`
+	// min_inflation_rate is the lowest inflation rate governance may set.
+	MinInflationRate string `protobuf:"bytes,3,opt,name=min_inflation_rate,json=minInflationRate,proto3" json:"min_inflation_rate,omitempty"`
+	// max_inflation_rate is the highest inflation rate governance may set.
+	MaxInflationRate string `protobuf:"bytes,4,opt,name=max_inflation_rate,json=maxInflationRate,proto3" json:"max_inflation_rate,omitempty"`
+	// max_inflation_rate_change is the largest absolute change to the
+	// inflation rate that a single params update may schedule.
+	MaxInflationRateChange string `protobuf:"bytes,5,opt,name=max_inflation_rate_change,json=maxInflationRateChange,proto3" json:"max_inflation_rate_change,omitempty"`
+	// epoch_length is the number of blocks in a rewards epoch.
+	EpochLength uint64 `protobuf:"varint,6,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// param_change_delay is the number of blocks a governance params update
+	// waits in the timelock queue before it is applied, at least 720.
+	ParamChangeDelay uint64 `protobuf:"varint,7,opt,name=param_change_delay,json=paramChangeDelay,proto3" json:"param_change_delay,omitempty"`
+	// veto_threshold is the fraction of governance wallets that must veto a
+	// queued params update to cancel it.
+	VetoThreshold string `protobuf:"bytes,8,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// voting_weight_function selects how a transaction count turns into voting
+	// weight: "linear", "quadratic" (square root of the count) or "capped"
+	// (linear, capped at voting_weight_cap of all network transactions).
+	VotingWeightFunction string `protobuf:"bytes,9,opt,name=voting_weight_function,json=votingWeightFunction,proto3" json:"voting_weight_function,omitempty"`
+	// voting_weight_cap is the largest share of all network transactions a
+	// single address counts for under the "capped" voting weight function.
+	VotingWeightCap string `protobuf:"bytes,10,opt,name=voting_weight_cap,json=votingWeightCap,proto3" json:"voting_weight_cap,omitempty"`
+	// election_interval is the number of epochs between governance wallet
+	// elections. Zero disables elections and keeps predefined_wallets fixed.
+	ElectionInterval uint64 `protobuf:"varint,11,opt,name=election_interval,json=electionInterval,proto3" json:"election_interval,omitempty"`
+	// council_size is the number of governance wallets an election picks from
+	// the top contributors.
+	CouncilSize uint64 `protobuf:"varint,12,opt,name=council_size,json=councilSize,proto3" json:"council_size,omitempty"`
+	// min_candidate_balance is the EGV balance a registered candidate must hold
+	// at election time to be eligible.
+	MinCandidateBalance string `protobuf:"bytes,13,opt,name=min_candidate_balance,json=minCandidateBalance,proto3" json:"min_candidate_balance,omitempty"`
+	// max_consecutive_terms is the number of consecutive councils an address
+	// may sit on before it has to sit out an election. Zero means no limit.
+	MaxConsecutiveTerms uint64 `protobuf:"varint,14,opt,name=max_consecutive_terms,json=maxConsecutiveTerms,proto3" json:"max_consecutive_terms,omitempty"`
+	// min_governance_set_size is the smallest number of governance wallets
+	// predefined_wallets may hold.
+	MinGovernanceSetSize uint64 `protobuf:"varint,15,opt,name=min_governance_set_size,json=minGovernanceSetSize,proto3" json:"min_governance_set_size,omitempty"`
+	// max_governance_set_size is the largest number of governance wallets
+	// predefined_wallets may hold.
+	MaxGovernanceSetSize uint64 `protobuf:"varint,16,opt,name=max_governance_set_size,json=maxGovernanceSetSize,proto3" json:"max_governance_set_size,omitempty"`
+	// max_governance_set_change is the largest fraction of the current
+	// governance wallets a single params update may replace.
+	MaxGovernanceSetChange string `protobuf:"bytes,17,opt,name=max_governance_set_change,json=maxGovernanceSetChange,proto3" json:"max_governance_set_change,omitempty"`
+	// contribution_retention_epochs is the number of most recent epochs, the
+	// current one included, whose per-epoch transaction counts are kept.
+	// Older epochs are pruned at the end of the block. Zero keeps every epoch.
+	ContributionRetentionEpochs uint64 `protobuf:"varint,18,opt,name=contribution_retention_epochs,json=contributionRetentionEpochs,proto3" json:"contribution_retention_epochs,omitempty"`
+	// contribution_score_mode selects what rewards and voting weights are
+	// computed from: "lifetime" transaction counts or the "decayed"
+	// contribution score.
+	ContributionScoreMode string `protobuf:"bytes,19,opt,name=contribution_score_mode,json=contributionScoreMode,proto3" json:"contribution_score_mode,omitempty"`
+	// score_half_life_epochs is the number of epochs after which a
+	// transaction's share of the decayed contribution score has halved.
+	ScoreHalfLifeEpochs uint64 `protobuf:"varint,20,opt,name=score_half_life_epochs,json=scoreHalfLifeEpochs,proto3" json:"score_half_life_epochs,omitempty"`
+	// max_share_per_address is the largest fraction of a distribution's reward
+	// pool a single governance wallet may receive. The excess is shared out
+	// among the other wallets in proportion to their contribution.
+	MaxSharePerAddress string `protobuf:"bytes,21,opt,name=max_share_per_address,json=maxSharePerAddress,proto3" json:"max_share_per_address,omitempty"`
+	// reward_vesting_fraction is the fraction of each distributed reward that
+	// vests instead of being paid out immediately.
+	RewardVestingFraction string `protobuf:"bytes,22,opt,name=reward_vesting_fraction,json=rewardVestingFraction,proto3" json:"reward_vesting_fraction,omitempty"`
+	// reward_vesting_duration is the number of blocks over which the vesting
+	// part of a reward is released linearly.
+	RewardVestingDuration uint64 `protobuf:"varint,23,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3" json:"reward_vesting_duration,omitempty"`
+	// fee_share is the fraction of the fee collector balance moved into the
+	// rewards pool every block, before x/mint adds the staking inflation and
+	// x/distribution allocates it, so only fees are shared. The pool is split
+	// among contributors at each epoch boundary.
+	FeeShare string `protobuf:"bytes,24,opt,name=fee_share,json=feeShare,proto3" json:"fee_share,omitempty"`
+	// max_supply caps the EGV supply. Inflation is minted only up to the cap.
+	// Zero means no cap.
+	MaxSupply string `protobuf:"bytes,25,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// burn_fraction is the fraction of the EGV in each distribution's reward
+	// pool that is burned instead of paid out.
+	BurnFraction string `protobuf:"bytes,26,opt,name=burn_fraction,json=burnFraction,proto3" json:"burn_fraction,omitempty"`
+	// inflation_schedule selects how the effective inflation rate evolves with
+	// the epoch: "constant", "stepwise", "halving" or "exponential_decay".
+	// inflation_rate is the rate the schedule starts from.
+	InflationSchedule string `protobuf:"bytes,27,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule,omitempty"`
+	// inflation_steps are the rates of the stepwise schedule, each applying
+	// from its start epoch on, in increasing start epoch order.
+	InflationSteps []*InflationStep `protobuf:"bytes,28,rep,name=inflation_steps,json=inflationSteps,proto3" json:"inflation_steps,omitempty"`
+	// inflation_halving_epochs is the number of epochs after which the halving
+	// schedule halves the rate.
+	InflationHalvingEpochs uint64 `protobuf:"varint,29,opt,name=inflation_halving_epochs,json=inflationHalvingEpochs,proto3" json:"inflation_halving_epochs,omitempty"`
+	// inflation_decay_rate is the fraction of the distance to
+	// inflation_floor the exponential decay schedule removes every epoch.
+	InflationDecayRate string `protobuf:"bytes,30,opt,name=inflation_decay_rate,json=inflationDecayRate,proto3" json:"inflation_decay_rate,omitempty"`
+	// inflation_floor is the rate the exponential decay schedule approaches.
+	InflationFloor string `protobuf:"bytes,31,opt,name=inflation_floor,json=inflationFloor,proto3" json:"inflation_floor,omitempty"`
+	// soulbound_egv blocks EGV transfers between user accounts. Transfers from
+	// or to module accounts, such as reward payouts and governance deposits,
+	// stay allowed.
+	SoulboundEgv bool `protobuf:"varint,32,opt,name=soulbound_egv,json=soulboundEgv,proto3" json:"soulbound_egv,omitempty"`
+	// egv_transfer_allowlist are the addresses that can receive EGV from user
+	// accounts while soulbound_egv is set.
+	EgvTransferAllowlist []string `protobuf:"bytes,33,rep,name=egv_transfer_allowlist,json=egvTransferAllowlist,proto3" json:"egv_transfer_allowlist,omitempty"`
+	// reward_denom is the denom of the governance token the module mints,
+	// distributes and burns. It is set at genesis and cannot be changed by a
+	// params update.
+	RewardDenom string `protobuf:"bytes,34,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// max_missed_governance_actions is the number of x/gov proposals in a row
+	// a governance wallet can miss before inactivity_penalty applies. Zero
+	// disables inactivity penalties.
+	MaxMissedGovernanceActions uint64 `protobuf:"varint,35,opt,name=max_missed_governance_actions,json=maxMissedGovernanceActions,proto3" json:"max_missed_governance_actions,omitempty"`
+	// inactivity_penalty is the penalty for an inactive governance wallet:
+	// "reward_reduction", "suspension" or "removal".
+	InactivityPenalty string `protobuf:"bytes,36,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	// inactivity_reward_reduction is the fraction of its reward share a wallet
+	// loses under the reward_reduction penalty.
+	InactivityRewardReduction string `protobuf:"bytes,37,opt,name=inactivity_reward_reduction,json=inactivityRewardReduction,proto3" json:"inactivity_reward_reduction,omitempty"`
+	// inactivity_penalty_epochs is the number of epochs a reward reduction or
+	// suspension lasts.
+	InactivityPenaltyEpochs uint64 `protobuf:"varint,38,opt,name=inactivity_penalty_epochs,json=inactivityPenaltyEpochs,proto3" json:"inactivity_penalty_epochs,omitempty"`
+	// lock_unbonding_period is the number of blocks unlocked EGV stays in the
+	// module before it is returned to its owner.
+	LockUnbondingPeriod uint64 `protobuf:"varint,39,opt,name=lock_unbonding_period,json=lockUnbondingPeriod,proto3" json:"lock_unbonding_period,omitempty"`
+	// lock_boost_curve is how locked EGV boosts contribution weight: "linear"
+	// or "sqrt" in the locked amount up to lock_boost_saturation.
+	LockBoostCurve string `protobuf:"bytes,40,opt,name=lock_boost_curve,json=lockBoostCurve,proto3" json:"lock_boost_curve,omitempty"`
+	// lock_boost_max is the multiplier applied to the contribution of an
+	// address that locked lock_boost_saturation or more. One disables the
+	// boost.
+	LockBoostMax string `protobuf:"bytes,41,opt,name=lock_boost_max,json=lockBoostMax,proto3" json:"lock_boost_max,omitempty"`
+	// lock_boost_saturation is the locked amount, in base units of
+	// reward_denom, that earns lock_boost_max. At genesis it may not exceed the
+	// initial EGV supply.
+	LockBoostSaturation string `protobuf:"bytes,42,opt,name=lock_boost_saturation,json=lockBoostSaturation,proto3" json:"lock_boost_saturation,omitempty"`
+	// min_lock_amount is the smallest amount, in base units of reward_denom,
+	// an address may keep locked. Unlocks must leave either nothing or at
+	// least this much.
+	MinLockAmount string `protobuf:"bytes,43,opt,name=min_lock_amount,json=minLockAmount,proto3" json:"min_lock_amount,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zenoda_rewards_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_zenoda_rewards_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetInflationRate() string {
+	if x != nil {
+		return x.InflationRate
+	}
+	return ""
+}
+
+func (x *Params) GetPredefinedWallets() []string {
+	if x != nil {
+		return x.PredefinedWallets
+	}
+	return nil
+}
+
+func (x *Params) GetMinInflationRate() string {
+	if x != nil {
+		return x.MinInflationRate
+	}
+	return ""
+}
+
+func (x *Params) GetMaxInflationRate() string {
+	if x != nil {
+		return x.MaxInflationRate
+	}
+	return ""
+}
+
+func (x *Params) GetMaxInflationRateChange() string {
+	if x != nil {
+		return x.MaxInflationRateChange
+	}
+	return ""
+}
+
+func (x *Params) GetEpochLength() uint64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *Params) GetParamChangeDelay() uint64 {
+	if x != nil {
+		return x.ParamChangeDelay
+	}
+	return 0
+}
+
+func (x *Params) GetVetoThreshold() string {
+	if x != nil {
+		return x.VetoThreshold
+	}
+	return ""
+}
+
+func (x *Params) GetVotingWeightFunction() string {
+	if x != nil {
+		return x.VotingWeightFunction
+	}
+	return ""
+}
+
+func (x *Params) GetVotingWeightCap() string {
+	if x != nil {
+		return x.VotingWeightCap
+	}
+	return ""
+}
+
+func (x *Params) GetElectionInterval() uint64 {
+	if x != nil {
+		return x.ElectionInterval
+	}
+	return 0
+}
+
+func (x *Params) GetCouncilSize() uint64 {
+	if x != nil {
+		return x.CouncilSize
+	}
+	return 0
+}
+
+func (x *Params) GetMinCandidateBalance() string {
+	if x != nil {
+		return x.MinCandidateBalance
+	}
+	return ""
+}
+
+func (x *Params) GetMaxConsecutiveTerms() uint64 {
+	if x != nil {
+		return x.MaxConsecutiveTerms
+	}
+	return 0
+}
+
+func (x *Params) GetMinGovernanceSetSize() uint64 {
+	if x != nil {
+		return x.MinGovernanceSetSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxGovernanceSetSize() uint64 {
+	if x != nil {
+		return x.MaxGovernanceSetSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxGovernanceSetChange() string {
+	if x != nil {
+		return x.MaxGovernanceSetChange
+	}
+	return ""
+}
+
+func (x *Params) GetContributionRetentionEpochs() uint64 {
+	if x != nil {
+		return x.ContributionRetentionEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetContributionScoreMode() string {
+	if x != nil {
+		return x.ContributionScoreMode
+	}
+	return ""
+}
+
+func (x *Params) GetScoreHalfLifeEpochs() uint64 {
+	if x != nil {
+		return x.ScoreHalfLifeEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSharePerAddress() string {
+	if x != nil {
+		return x.MaxSharePerAddress
+	}
+	return ""
+}
+
+func (x *Params) GetRewardVestingFraction() string {
+	if x != nil {
+		return x.RewardVestingFraction
+	}
+	return ""
+}
+
+func (x *Params) GetRewardVestingDuration() uint64 {
+	if x != nil {
+		return x.RewardVestingDuration
+	}
+	return 0
+}
+
+func (x *Params) GetFeeShare() string {
+	if x != nil {
+		return x.FeeShare
+	}
+	return ""
+}
+
+func (x *Params) GetMaxSupply() string {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return ""
+}
+
+func (x *Params) GetBurnFraction() string {
+	if x != nil {
+		return x.BurnFraction
+	}
+	return ""
+}
+
+func (x *Params) GetInflationSchedule() string {
+	if x != nil {
+		return x.InflationSchedule
+	}
+	return ""
+}
+
+func (x *Params) GetInflationSteps() []*InflationStep {
+	if x != nil {
+		return x.InflationSteps
+	}
+	return nil
+}
+
+func (x *Params) GetInflationHalvingEpochs() uint64 {
+	if x != nil {
+		return x.InflationHalvingEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetInflationDecayRate() string {
+	if x != nil {
+		return x.InflationDecayRate
+	}
+	return ""
+}
+
+func (x *Params) GetInflationFloor() string {
+	if x != nil {
+		return x.InflationFloor
+	}
+	return ""
+}
+
+func (x *Params) GetSoulboundEgv() bool {
+	if x != nil {
+		return x.SoulboundEgv
+	}
+	return false
+}
+
+func (x *Params) GetEgvTransferAllowlist() []string {
+	if x != nil {
+		return x.EgvTransferAllowlist
+	}
+	return nil
+}
+
+func (x *Params) GetRewardDenom() string {
+	if x != nil {
+		return x.RewardDenom
+	}
+	return ""
+}
+
+func (x *Params) GetMaxMissedGovernanceActions() uint64 {
+	if x != nil {
+		return x.MaxMissedGovernanceActions
+	}
+	return 0
+}
+
+func (x *Params) GetInactivityPenalty() string {
+	if x != nil {
+		return x.InactivityPenalty
+	}
+	return ""
+}
+
+func (x *Params) GetInactivityRewardReduction() string {
+	if x != nil {
+		return x.InactivityRewardReduction
+	}
+	return ""
+}
+
+func (x *Params) GetInactivityPenaltyEpochs() uint64 {
+	if x != nil {
+		return x.InactivityPenaltyEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetLockUnbondingPeriod() uint64 {
+	if x != nil {
+		return x.LockUnbondingPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetLockBoostCurve() string {
+	if x != nil {
+		return x.LockBoostCurve
+	}
+	return ""
+}
+
+func (x *Params) GetLockBoostMax() string {
+	if x != nil {
+		return x.LockBoostMax
+	}
+	return ""
+}
+
+func (x *Params) GetLockBoostSaturation() string {
+	if x != nil {
+		return x.LockBoostSaturation
+	}
+	return ""
+}
+
+func (x *Params) GetMinLockAmount() string {
+	if x != nil {
+		return x.MinLockAmount
+	}
+	return ""
+}
+
+// PendingInflationChange is an inflation rate or schedule change waiting for
+// the next epoch boundary to take effect.
+type PendingInflationChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InflationRate     string   `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
-	PredefinedWallets []string `protobuf:"bytes,2,rep,name=predefined_wallets,json=predefinedWallets,proto3" json:"predefined_wallets,omitempty"`
+	// inflation_rate is the rate that will become active.
+	InflationRate string `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+	// activation_height is the block height at which the rate takes effect.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// inflation_schedule is the schedule that will become active, together
+	// with the settings below. Empty keeps the active schedule.
+	InflationSchedule string `protobuf:"bytes,3,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule,omitempty"`
+	// inflation_steps are the steps of the stepwise schedule that will become
+	// active.
+	InflationSteps []*InflationStep `protobuf:"bytes,4,rep,name=inflation_steps,json=inflationSteps,proto3" json:"inflation_steps,omitempty"`
+	// inflation_halving_epochs is the halving period that will become active.
+	InflationHalvingEpochs uint64 `protobuf:"varint,5,opt,name=inflation_halving_epochs,json=inflationHalvingEpochs,proto3" json:"inflation_halving_epochs,omitempty"`
+	// inflation_decay_rate is the decay rate that will become active.
+	InflationDecayRate string `protobuf:"bytes,6,opt,name=inflation_decay_rate,json=inflationDecayRate,proto3" json:"inflation_decay_rate,omitempty"`
+	// inflation_floor is the decay floor that will become active.
+	InflationFloor string `protobuf:"bytes,7,opt,name=inflation_floor,json=inflationFloor,proto3" json:"inflation_floor,omitempty"`
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
+func (x *PendingInflationChange) Reset() {
+	*x = PendingInflationChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_zenoda_rewards_params_proto_msgTypes[0]
+		mi := &file_zenoda_rewards_params_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Params) String() string {
+func (x *PendingInflationChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Params) ProtoMessage() {}
+func (*PendingInflationChange) ProtoMessage() {}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_zenoda_rewards_params_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PendingInflationChange.ProtoReflect.Descriptor instead.
+func (*PendingInflationChange) Descriptor() ([]byte, []int) {
+	return file_zenoda_rewards_params_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Params) GetInflationRate() string {
+func (x *PendingInflationChange) GetInflationRate() string {
 	if x != nil {
 		return x.InflationRate
 	}
 	return ""
 }
 
-func (x *Params) GetPredefinedWallets() []string {
+func (x *PendingInflationChange) GetActivationHeight() int64 {
 	if x != nil {
-		return x.PredefinedWallets
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *PendingInflationChange) GetInflationSchedule() string {
+	if x != nil {
+		return x.InflationSchedule
+	}
+	return ""
+}
+
+func (x *PendingInflationChange) GetInflationSteps() []*InflationStep {
+	if x != nil {
+		return x.InflationSteps
 	}
 	return nil
 }
 
+func (x *PendingInflationChange) GetInflationHalvingEpochs() uint64 {
+	if x != nil {
+		return x.InflationHalvingEpochs
+	}
+	return 0
+}
+
+func (x *PendingInflationChange) GetInflationDecayRate() string {
+	if x != nil {
+		return x.InflationDecayRate
+	}
+	return ""
+}
+
+func (x *PendingInflationChange) GetInflationFloor() string {
+	if x != nil {
+		return x.InflationFloor
+	}
+	return ""
+}
+
+// InflationStep is the inflation rate of a stepwise schedule from an epoch
+// on.
+type InflationStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start_epoch is the first epoch the rate applies to.
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// inflation_rate is the rate applying from start_epoch on.
+	InflationRate string `protobuf:"bytes,2,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+}
+
+func (x *InflationStep) Reset() {
+	*x = InflationStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zenoda_rewards_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflationStep) ProtoMessage() {}
+
+// Deprecated: Use InflationStep.ProtoReflect.Descriptor instead.
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return file_zenoda_rewards_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InflationStep) GetStartEpoch() uint64 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *InflationStep) GetInflationRate() string {
+	if x != nil {
+		return x.InflationRate
+	}
+	return ""
+}
+
 var File_zenoda_rewards_params_proto protoreflect.FileDescriptor
 
 var file_zenoda_rewards_params_proto_rawDesc = []byte{
@@ -623,25 +5077,182 @@ var file_zenoda_rewards_params_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x11, 0x61,
 	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x10, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
 	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x64,
 	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x3a, 0x20, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x17, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x78, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x95, 0x01, 0x0a, 0x12, 0x63, 0x6f,
-	0x6d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x19, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x65, 0x6e, 0x6f,
-	0x64, 0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0xa2, 0x02, 0x03, 0x5a, 0x52, 0x58,
-	0xaa, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0xca, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0xe2, 0x02, 0x1a, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0f, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x25, 0x0a, 0x0e, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x74, 0x6f, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x61,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x61, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x63, 0x69, 0x6c,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x75,
+	0x6e, 0x63, 0x69, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x43, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x12, 0x35, 0x0a, 0x17, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x67,
+	0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x47, 0x6f, 0x76,
+	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39,
+	0x0a, 0x19, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65,
+	0x53, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x1d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x36, 0x0a,
+	0x17, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x68,
+	0x61, 0x6c, 0x66, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x48, 0x61, 0x6c, 0x66,
+	0x4c, 0x69, 0x66, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x50, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a,
+	0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x65, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x61, 0x78, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x72,
+	0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
+	0x0a, 0x12, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x51, 0x0a,
+	0x0f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73,
+	0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x65, 0x70, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x73,
+	0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61,
+	0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6c,
+	0x76, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x61, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x6c, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x65, 0x67, 0x76, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x6f,
+	0x75, 0x6c, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x67, 0x76, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x67,
+	0x76, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x21, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x65, 0x67, 0x76, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x67, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1a, 0x6d, 0x61, 0x78, 0x4d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x24, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x1b, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x69, 0x6e, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x19, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x18, 0x26, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x6f,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x43, 0x75, 0x72, 0x76, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x6f, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x61,
+	0x78, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x6f,
+	0x73, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x6f,
+	0x6f, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x6f, 0x73, 0x74, 0x53,
+	0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x4c, 0x6f, 0x63, 0x6b, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x20, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x7a, 0x65, 0x6e, 0x6f,
+	0x64, 0x61, 0x2f, 0x78, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x83, 0x03, 0x0a, 0x16, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x51, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x7a, 0x65, 0x6e,
+	0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x6c, 0x76, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x30,
+	0x0a, 0x14, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x61,
+	0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x61, 0x79, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6c,
+	0x6f, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x22, 0x5d, 0x0a, 0x0d, 0x49, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x95, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d,
+	0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42,
+	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x19,
+	0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0xa2, 0x02, 0x03, 0x5a, 0x52, 0x58, 0xaa,
+	0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0xca, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0xe2, 0x02, 0x1a, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0f, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x3a, 0x3a, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -656,16 +5267,20 @@ func file_zenoda_rewards_params_proto_rawDescGZIP() []byte {
 	return file_zenoda_rewards_params_proto_rawDescData
 }
 
-var file_zenoda_rewards_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_zenoda_rewards_params_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_zenoda_rewards_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: zenoda.rewards.Params
+	(*Params)(nil),                 // 0: zenoda.rewards.Params
+	(*PendingInflationChange)(nil), // 1: zenoda.rewards.PendingInflationChange
+	(*InflationStep)(nil),          // 2: zenoda.rewards.InflationStep
 }
 var file_zenoda_rewards_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: zenoda.rewards.Params.inflation_steps:type_name -> zenoda.rewards.InflationStep
+	2, // 1: zenoda.rewards.PendingInflationChange.inflation_steps:type_name -> zenoda.rewards.InflationStep
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_zenoda_rewards_params_proto_init() }
@@ -686,6 +5301,30 @@ func file_zenoda_rewards_params_proto_init() {
 				return nil
 			}
 		}
+		file_zenoda_rewards_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingInflationChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_zenoda_rewards_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflationStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -693,7 +5332,7 @@ func file_zenoda_rewards_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_zenoda_rewards_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,7 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/zenoda.rewards.Query/Params"
+	Query_Params_FullMethodName                 = "/zenoda.rewards.Query/Params"
+	Query_PendingInflationChange_FullMethodName = "/zenoda.rewards.Query/PendingInflationChange"
+	Query_PendingParamChanges_FullMethodName    = "/zenoda.rewards.Query/PendingParamChanges"
+	Query_VotingPower_FullMethodName            = "/zenoda.rewards.Query/VotingPower"
+	Query_Tally_FullMethodName                  = "/zenoda.rewards.Query/Tally"
+	Query_VotingDelegation_FullMethodName       = "/zenoda.rewards.Query/VotingDelegation"
+	Query_VotingDelegationsTo_FullMethodName    = "/zenoda.rewards.Query/VotingDelegationsTo"
+	Query_Candidates_FullMethodName             = "/zenoda.rewards.Query/Candidates"
+	Query_Councils_FullMethodName               = "/zenoda.rewards.Query/Councils"
+	Query_Council_FullMethodName                = "/zenoda.rewards.Query/Council"
+	Query_EpochContributions_FullMethodName     = "/zenoda.rewards.Query/EpochContributions"
+	Query_EpochTotals_FullMethodName            = "/zenoda.rewards.Query/EpochTotals"
+	Query_ContributionScore_FullMethodName      = "/zenoda.rewards.Query/ContributionScore"
+	Query_EstimatedReward_FullMethodName        = "/zenoda.rewards.Query/EstimatedReward"
+	Query_RewardWithdrawAddress_FullMethodName  = "/zenoda.rewards.Query/RewardWithdrawAddress"
+	Query_VestingRewards_FullMethodName         = "/zenoda.rewards.Query/VestingRewards"
+	Query_RewardPool_FullMethodName             = "/zenoda.rewards.Query/RewardPool"
+	Query_EpochRewards_FullMethodName           = "/zenoda.rewards.Query/EpochRewards"
+	Query_AddressRewards_FullMethodName         = "/zenoda.rewards.Query/AddressRewards"
+	Query_Campaigns_FullMethodName              = "/zenoda.rewards.Query/Campaigns"
+	Query_Campaign_FullMethodName               = "/zenoda.rewards.Query/Campaign"
+	Query_SupplyInfo_FullMethodName             = "/zenoda.rewards.Query/SupplyInfo"
+	Query_CurrentInflation_FullMethodName       = "/zenoda.rewards.Query/CurrentInflation"
+	Query_Participation_FullMethodName          = "/zenoda.rewards.Query/Participation"
+	Query_Lock_FullMethodName                   = "/zenoda.rewards.Query/Lock"
+	Query_Locks_FullMethodName                  = "/zenoda.rewards.Query/Locks"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +53,73 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingInflationChange queries the inflation rate change scheduled for
+	// the next epoch boundary, if any.
+	PendingInflationChange(ctx context.Context, in *QueryPendingInflationChangeRequest, opts ...grpc.CallOption) (*QueryPendingInflationChangeResponse, error)
+	// PendingParamChanges queries the params updates waiting in the timelock
+	// queue.
+	PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error)
+	// VotingPower queries the contribution-weighted voting power of an address,
+	// including the power delegated to it.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// Tally queries the contribution-weighted tally of the votes cast on an
+	// x/gov proposal in its voting period, with delegated weight included. The
+	// tally is query-only: x/gov v0.50 cannot take a custom tally function, so
+	// proposals are still decided by bonded stake.
+	Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error)
+	// VotingDelegation queries the delegation made by a delegator.
+	VotingDelegation(ctx context.Context, in *QueryVotingDelegationRequest, opts ...grpc.CallOption) (*QueryVotingDelegationResponse, error)
+	// VotingDelegationsTo queries the delegations received by a delegatee.
+	VotingDelegationsTo(ctx context.Context, in *QueryVotingDelegationsToRequest, opts ...grpc.CallOption) (*QueryVotingDelegationsToResponse, error)
+	// Candidates queries the addresses registered for governance wallet
+	// elections.
+	Candidates(ctx context.Context, in *QueryCandidatesRequest, opts ...grpc.CallOption) (*QueryCandidatesResponse, error)
+	// Councils queries the history of elected governance wallet sets.
+	Councils(ctx context.Context, in *QueryCouncilsRequest, opts ...grpc.CallOption) (*QueryCouncilsResponse, error)
+	// Council queries the governance wallet set elected in a term.
+	Council(ctx context.Context, in *QueryCouncilRequest, opts ...grpc.CallOption) (*QueryCouncilResponse, error)
+	// EpochContributions queries the transactions an address made in each
+	// epoch of a range.
+	EpochContributions(ctx context.Context, in *QueryEpochContributionsRequest, opts ...grpc.CallOption) (*QueryEpochContributionsResponse, error)
+	// EpochTotals queries the network transaction totals of each epoch of a
+	// range.
+	EpochTotals(ctx context.Context, in *QueryEpochTotalsRequest, opts ...grpc.CallOption) (*QueryEpochTotalsResponse, error)
+	// ContributionScore queries the decayed contribution score of an address
+	// and of the whole network.
+	ContributionScore(ctx context.Context, in *QueryContributionScoreRequest, opts ...grpc.CallOption) (*QueryContributionScoreResponse, error)
+	// EstimatedReward queries the reward an address would receive if rewards
+	// were distributed now, with and without the per-address share cap.
+	EstimatedReward(ctx context.Context, in *QueryEstimatedRewardRequest, opts ...grpc.CallOption) (*QueryEstimatedRewardResponse, error)
+	// RewardWithdrawAddress queries the address an account's rewards are paid
+	// to.
+	RewardWithdrawAddress(ctx context.Context, in *QueryRewardWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardWithdrawAddressResponse, error)
+	// VestingRewards queries the reward vesting positions of an address.
+	VestingRewards(ctx context.Context, in *QueryVestingRewardsRequest, opts ...grpc.CallOption) (*QueryVestingRewardsResponse, error)
+	// RewardPool queries the undistributed reward pool in every denom.
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// EpochRewards queries the rewards distributed per denom in each retained
+	// epoch of a range.
+	EpochRewards(ctx context.Context, in *QueryEpochRewardsRequest, opts ...grpc.CallOption) (*QueryEpochRewardsResponse, error)
+	// AddressRewards queries the rewards paid out per denom to an address.
+	AddressRewards(ctx context.Context, in *QueryAddressRewardsRequest, opts ...grpc.CallOption) (*QueryAddressRewardsResponse, error)
+	// Campaigns queries every sponsored incentive campaign.
+	Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error)
+	// Campaign queries a sponsored incentive campaign and its contributions.
+	Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error)
+	// SupplyInfo queries the EGV minted, burned and circulating supply and the
+	// supply cap.
+	SupplyInfo(ctx context.Context, in *QuerySupplyInfoRequest, opts ...grpc.CallOption) (*QuerySupplyInfoResponse, error)
+	// CurrentInflation queries the effective inflation rate of the current
+	// epoch under the inflation schedule.
+	CurrentInflation(ctx context.Context, in *QueryCurrentInflationRequest, opts ...grpc.CallOption) (*QueryCurrentInflationResponse, error)
+	// Participation queries the governance participation record of an
+	// address and its participation in each retained epoch of a range.
+	Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error)
+	// Lock queries the EGV an address has locked, the boost it earns and its
+	// pending unlocks.
+	Lock(ctx context.Context, in *QueryLockRequest, opts ...grpc.CallOption) (*QueryLockResponse, error)
+	// Locks queries the EGV locked by every address.
+	Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +139,304 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingInflationChange(ctx context.Context, in *QueryPendingInflationChangeRequest, opts ...grpc.CallOption) (*QueryPendingInflationChangeResponse, error) {
+	out := new(QueryPendingInflationChangeResponse)
+	err := c.cc.Invoke(ctx, Query_PendingInflationChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error) {
+	out := new(QueryPendingParamChangesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingParamChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, Query_VotingPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tally(ctx context.Context, in *QueryTallyRequest, opts ...grpc.CallOption) (*QueryTallyResponse, error) {
+	out := new(QueryTallyResponse)
+	err := c.cc.Invoke(ctx, Query_Tally_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingDelegation(ctx context.Context, in *QueryVotingDelegationRequest, opts ...grpc.CallOption) (*QueryVotingDelegationResponse, error) {
+	out := new(QueryVotingDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_VotingDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingDelegationsTo(ctx context.Context, in *QueryVotingDelegationsToRequest, opts ...grpc.CallOption) (*QueryVotingDelegationsToResponse, error) {
+	out := new(QueryVotingDelegationsToResponse)
+	err := c.cc.Invoke(ctx, Query_VotingDelegationsTo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Candidates(ctx context.Context, in *QueryCandidatesRequest, opts ...grpc.CallOption) (*QueryCandidatesResponse, error) {
+	out := new(QueryCandidatesResponse)
+	err := c.cc.Invoke(ctx, Query_Candidates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Councils(ctx context.Context, in *QueryCouncilsRequest, opts ...grpc.CallOption) (*QueryCouncilsResponse, error) {
+	out := new(QueryCouncilsResponse)
+	err := c.cc.Invoke(ctx, Query_Councils_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Council(ctx context.Context, in *QueryCouncilRequest, opts ...grpc.CallOption) (*QueryCouncilResponse, error) {
+	out := new(QueryCouncilResponse)
+	err := c.cc.Invoke(ctx, Query_Council_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochContributions(ctx context.Context, in *QueryEpochContributionsRequest, opts ...grpc.CallOption) (*QueryEpochContributionsResponse, error) {
+	out := new(QueryEpochContributionsResponse)
+	err := c.cc.Invoke(ctx, Query_EpochContributions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochTotals(ctx context.Context, in *QueryEpochTotalsRequest, opts ...grpc.CallOption) (*QueryEpochTotalsResponse, error) {
+	out := new(QueryEpochTotalsResponse)
+	err := c.cc.Invoke(ctx, Query_EpochTotals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContributionScore(ctx context.Context, in *QueryContributionScoreRequest, opts ...grpc.CallOption) (*QueryContributionScoreResponse, error) {
+	out := new(QueryContributionScoreResponse)
+	err := c.cc.Invoke(ctx, Query_ContributionScore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimatedReward(ctx context.Context, in *QueryEstimatedRewardRequest, opts ...grpc.CallOption) (*QueryEstimatedRewardResponse, error) {
+	out := new(QueryEstimatedRewardResponse)
+	err := c.cc.Invoke(ctx, Query_EstimatedReward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardWithdrawAddress(ctx context.Context, in *QueryRewardWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryRewardWithdrawAddressResponse, error) {
+	out := new(QueryRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Query_RewardWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VestingRewards(ctx context.Context, in *QueryVestingRewardsRequest, opts ...grpc.CallOption) (*QueryVestingRewardsResponse, error) {
+	out := new(QueryVestingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_VestingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, Query_RewardPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochRewards(ctx context.Context, in *QueryEpochRewardsRequest, opts ...grpc.CallOption) (*QueryEpochRewardsResponse, error) {
+	out := new(QueryEpochRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_EpochRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressRewards(ctx context.Context, in *QueryAddressRewardsRequest, opts ...grpc.CallOption) (*QueryAddressRewardsResponse, error) {
+	out := new(QueryAddressRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_AddressRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaigns(ctx context.Context, in *QueryCampaignsRequest, opts ...grpc.CallOption) (*QueryCampaignsResponse, error) {
+	out := new(QueryCampaignsResponse)
+	err := c.cc.Invoke(ctx, Query_Campaigns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Campaign(ctx context.Context, in *QueryCampaignRequest, opts ...grpc.CallOption) (*QueryCampaignResponse, error) {
+	out := new(QueryCampaignResponse)
+	err := c.cc.Invoke(ctx, Query_Campaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyInfo(ctx context.Context, in *QuerySupplyInfoRequest, opts ...grpc.CallOption) (*QuerySupplyInfoResponse, error) {
+	out := new(QuerySupplyInfoResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentInflation(ctx context.Context, in *QueryCurrentInflationRequest, opts ...grpc.CallOption) (*QueryCurrentInflationResponse, error) {
+	out := new(QueryCurrentInflationResponse)
+	err := c.cc.Invoke(ctx, Query_CurrentInflation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Participation(ctx context.Context, in *QueryParticipationRequest, opts ...grpc.CallOption) (*QueryParticipationResponse, error) {
+	out := new(QueryParticipationResponse)
+	err := c.cc.Invoke(ctx, Query_Participation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Lock(ctx context.Context, in *QueryLockRequest, opts ...grpc.CallOption) (*QueryLockResponse, error) {
+	out := new(QueryLockResponse)
+	err := c.cc.Invoke(ctx, Query_Lock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Locks(ctx context.Context, in *QueryLocksRequest, opts ...grpc.CallOption) (*QueryLocksResponse, error) {
+	out := new(QueryLocksResponse)
+	err := c.cc.Invoke(ctx, Query_Locks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingInflationChange queries the inflation rate change scheduled for
+	// the next epoch boundary, if any.
+	PendingInflationChange(context.Context, *QueryPendingInflationChangeRequest) (*QueryPendingInflationChangeResponse, error)
+	// PendingParamChanges queries the params updates waiting in the timelock
+	// queue.
+	PendingParamChanges(context.Context, *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error)
+	// VotingPower queries the contribution-weighted voting power of an address,
+	// including the power delegated to it.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// Tally queries the contribution-weighted tally of the votes cast on an
+	// x/gov proposal in its voting period, with delegated weight included. The
+	// tally is query-only: x/gov v0.50 cannot take a custom tally function, so
+	// proposals are still decided by bonded stake.
+	Tally(context.Context, *QueryTallyRequest) (*QueryTallyResponse, error)
+	// VotingDelegation queries the delegation made by a delegator.
+	VotingDelegation(context.Context, *QueryVotingDelegationRequest) (*QueryVotingDelegationResponse, error)
+	// VotingDelegationsTo queries the delegations received by a delegatee.
+	VotingDelegationsTo(context.Context, *QueryVotingDelegationsToRequest) (*QueryVotingDelegationsToResponse, error)
+	// Candidates queries the addresses registered for governance wallet
+	// elections.
+	Candidates(context.Context, *QueryCandidatesRequest) (*QueryCandidatesResponse, error)
+	// Councils queries the history of elected governance wallet sets.
+	Councils(context.Context, *QueryCouncilsRequest) (*QueryCouncilsResponse, error)
+	// Council queries the governance wallet set elected in a term.
+	Council(context.Context, *QueryCouncilRequest) (*QueryCouncilResponse, error)
+	// EpochContributions queries the transactions an address made in each
+	// epoch of a range.
+	EpochContributions(context.Context, *QueryEpochContributionsRequest) (*QueryEpochContributionsResponse, error)
+	// EpochTotals queries the network transaction totals of each epoch of a
+	// range.
+	EpochTotals(context.Context, *QueryEpochTotalsRequest) (*QueryEpochTotalsResponse, error)
+	// ContributionScore queries the decayed contribution score of an address
+	// and of the whole network.
+	ContributionScore(context.Context, *QueryContributionScoreRequest) (*QueryContributionScoreResponse, error)
+	// EstimatedReward queries the reward an address would receive if rewards
+	// were distributed now, with and without the per-address share cap.
+	EstimatedReward(context.Context, *QueryEstimatedRewardRequest) (*QueryEstimatedRewardResponse, error)
+	// RewardWithdrawAddress queries the address an account's rewards are paid
+	// to.
+	RewardWithdrawAddress(context.Context, *QueryRewardWithdrawAddressRequest) (*QueryRewardWithdrawAddressResponse, error)
+	// VestingRewards queries the reward vesting positions of an address.
+	VestingRewards(context.Context, *QueryVestingRewardsRequest) (*QueryVestingRewardsResponse, error)
+	// RewardPool queries the undistributed reward pool in every denom.
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// EpochRewards queries the rewards distributed per denom in each retained
+	// epoch of a range.
+	EpochRewards(context.Context, *QueryEpochRewardsRequest) (*QueryEpochRewardsResponse, error)
+	// AddressRewards queries the rewards paid out per denom to an address.
+	AddressRewards(context.Context, *QueryAddressRewardsRequest) (*QueryAddressRewardsResponse, error)
+	// Campaigns queries every sponsored incentive campaign.
+	Campaigns(context.Context, *QueryCampaignsRequest) (*QueryCampaignsResponse, error)
+	// Campaign queries a sponsored incentive campaign and its contributions.
+	Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error)
+	// SupplyInfo queries the EGV minted, burned and circulating supply and the
+	// supply cap.
+	SupplyInfo(context.Context, *QuerySupplyInfoRequest) (*QuerySupplyInfoResponse, error)
+	// CurrentInflation queries the effective inflation rate of the current
+	// epoch under the inflation schedule.
+	CurrentInflation(context.Context, *QueryCurrentInflationRequest) (*QueryCurrentInflationResponse, error)
+	// Participation queries the governance participation record of an
+	// address and its participation in each retained epoch of a range.
+	Participation(context.Context, *QueryParticipationRequest) (*QueryParticipationResponse, error)
+	// Lock queries the EGV an address has locked, the boost it earns and its
+	// pending unlocks.
+	Lock(context.Context, *QueryLockRequest) (*QueryLockResponse, error)
+	// Locks queries the EGV locked by every address.
+	Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +447,81 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingInflationChange(context.Context, *QueryPendingInflationChangeRequest) (*QueryPendingInflationChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingInflationChange not implemented")
+}
+func (UnimplementedQueryServer) PendingParamChanges(context.Context, *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamChanges not implemented")
+}
+func (UnimplementedQueryServer) VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (UnimplementedQueryServer) Tally(context.Context, *QueryTallyRequest) (*QueryTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tally not implemented")
+}
+func (UnimplementedQueryServer) VotingDelegation(context.Context, *QueryVotingDelegationRequest) (*QueryVotingDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingDelegation not implemented")
+}
+func (UnimplementedQueryServer) VotingDelegationsTo(context.Context, *QueryVotingDelegationsToRequest) (*QueryVotingDelegationsToResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingDelegationsTo not implemented")
+}
+func (UnimplementedQueryServer) Candidates(context.Context, *QueryCandidatesRequest) (*QueryCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candidates not implemented")
+}
+func (UnimplementedQueryServer) Councils(context.Context, *QueryCouncilsRequest) (*QueryCouncilsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Councils not implemented")
+}
+func (UnimplementedQueryServer) Council(context.Context, *QueryCouncilRequest) (*QueryCouncilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Council not implemented")
+}
+func (UnimplementedQueryServer) EpochContributions(context.Context, *QueryEpochContributionsRequest) (*QueryEpochContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochContributions not implemented")
+}
+func (UnimplementedQueryServer) EpochTotals(context.Context, *QueryEpochTotalsRequest) (*QueryEpochTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTotals not implemented")
+}
+func (UnimplementedQueryServer) ContributionScore(context.Context, *QueryContributionScoreRequest) (*QueryContributionScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContributionScore not implemented")
+}
+func (UnimplementedQueryServer) EstimatedReward(context.Context, *QueryEstimatedRewardRequest) (*QueryEstimatedRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimatedReward not implemented")
+}
+func (UnimplementedQueryServer) RewardWithdrawAddress(context.Context, *QueryRewardWithdrawAddressRequest) (*QueryRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardWithdrawAddress not implemented")
+}
+func (UnimplementedQueryServer) VestingRewards(context.Context, *QueryVestingRewardsRequest) (*QueryVestingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingRewards not implemented")
+}
+func (UnimplementedQueryServer) RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (UnimplementedQueryServer) EpochRewards(context.Context, *QueryEpochRewardsRequest) (*QueryEpochRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochRewards not implemented")
+}
+func (UnimplementedQueryServer) AddressRewards(context.Context, *QueryAddressRewardsRequest) (*QueryAddressRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressRewards not implemented")
+}
+func (UnimplementedQueryServer) Campaigns(context.Context, *QueryCampaignsRequest) (*QueryCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaigns not implemented")
+}
+func (UnimplementedQueryServer) Campaign(context.Context, *QueryCampaignRequest) (*QueryCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
+func (UnimplementedQueryServer) SupplyInfo(context.Context, *QuerySupplyInfoRequest) (*QuerySupplyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyInfo not implemented")
+}
+func (UnimplementedQueryServer) CurrentInflation(context.Context, *QueryCurrentInflationRequest) (*QueryCurrentInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentInflation not implemented")
+}
+func (UnimplementedQueryServer) Participation(context.Context, *QueryParticipationRequest) (*QueryParticipationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Participation not implemented")
+}
+func (UnimplementedQueryServer) Lock(context.Context, *QueryLockRequest) (*QueryLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedQueryServer) Locks(context.Context, *QueryLocksRequest) (*QueryLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Locks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,16 +553,566 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "zenoda.rewards.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
+func _Query_PendingInflationChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingInflationChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingInflationChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingInflationChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingInflationChange(ctx, req.(*QueryPendingInflationChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingParamChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamChanges(ctx, req.(*QueryPendingParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Tally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tally(ctx, req.(*QueryTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingDelegation(ctx, req.(*QueryVotingDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingDelegationsTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingDelegationsToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingDelegationsTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingDelegationsTo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingDelegationsTo(ctx, req.(*QueryVotingDelegationsToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Candidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Candidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Candidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candidates(ctx, req.(*QueryCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Councils_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCouncilsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Councils(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Councils_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Councils(ctx, req.(*QueryCouncilsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Council_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCouncilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Council(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Council_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Council(ctx, req.(*QueryCouncilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochContributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochContributions(ctx, req.(*QueryEpochContributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochTotals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTotals(ctx, req.(*QueryEpochTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContributionScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContributionScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContributionScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContributionScore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContributionScore(ctx, req.(*QueryContributionScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimatedReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimatedRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimatedReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimatedReward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimatedReward(ctx, req.(*QueryEstimatedRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardWithdrawAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RewardWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardWithdrawAddress(ctx, req.(*QueryRewardWithdrawAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingRewards(ctx, req.(*QueryVestingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RewardPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EpochRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochRewards(ctx, req.(*QueryEpochRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AddressRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressRewards(ctx, req.(*QueryAddressRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Campaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaigns(ctx, req.(*QueryCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Campaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Campaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Campaign(ctx, req.(*QueryCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyInfo(ctx, req.(*QuerySupplyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CurrentInflation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentInflation(ctx, req.(*QueryCurrentInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Participation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Participation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Participation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Participation(ctx, req.(*QueryParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Lock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lock(ctx, req.(*QueryLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Locks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Locks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Locks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Locks(ctx, req.(*QueryLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "zenoda.rewards.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PendingInflationChange",
+			Handler:    _Query_PendingInflationChange_Handler,
+		},
+		{
+			MethodName: "PendingParamChanges",
+			Handler:    _Query_PendingParamChanges_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "Tally",
+			Handler:    _Query_Tally_Handler,
+		},
+		{
+			MethodName: "VotingDelegation",
+			Handler:    _Query_VotingDelegation_Handler,
+		},
+		{
+			MethodName: "VotingDelegationsTo",
+			Handler:    _Query_VotingDelegationsTo_Handler,
+		},
+		{
+			MethodName: "Candidates",
+			Handler:    _Query_Candidates_Handler,
+		},
+		{
+			MethodName: "Councils",
+			Handler:    _Query_Councils_Handler,
+		},
+		{
+			MethodName: "Council",
+			Handler:    _Query_Council_Handler,
+		},
+		{
+			MethodName: "EpochContributions",
+			Handler:    _Query_EpochContributions_Handler,
+		},
+		{
+			MethodName: "EpochTotals",
+			Handler:    _Query_EpochTotals_Handler,
+		},
+		{
+			MethodName: "ContributionScore",
+			Handler:    _Query_ContributionScore_Handler,
+		},
+		{
+			MethodName: "EstimatedReward",
+			Handler:    _Query_EstimatedReward_Handler,
+		},
+		{
+			MethodName: "RewardWithdrawAddress",
+			Handler:    _Query_RewardWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "VestingRewards",
+			Handler:    _Query_VestingRewards_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "EpochRewards",
+			Handler:    _Query_EpochRewards_Handler,
+		},
+		{
+			MethodName: "AddressRewards",
+			Handler:    _Query_AddressRewards_Handler,
+		},
+		{
+			MethodName: "Campaigns",
+			Handler:    _Query_Campaigns_Handler,
+		},
+		{
+			MethodName: "Campaign",
+			Handler:    _Query_Campaign_Handler,
+		},
+		{
+			MethodName: "SupplyInfo",
+			Handler:    _Query_SupplyInfo_Handler,
+		},
+		{
+			MethodName: "CurrentInflation",
+			Handler:    _Query_CurrentInflation_Handler,
+		},
+		{
+			MethodName: "Participation",
+			Handler:    _Query_Participation_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Query_Lock_Handler,
+		},
+		{
+			MethodName: "Locks",
+			Handler:    _Query_Locks_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -19,7 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/zenoda.rewards.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName             = "/zenoda.rewards.Msg/UpdateParams"
+	Msg_VetoParamChange_FullMethodName          = "/zenoda.rewards.Msg/VetoParamChange"
+	Msg_DelegateVotingPower_FullMethodName      = "/zenoda.rewards.Msg/DelegateVotingPower"
+	Msg_UndelegateVotingPower_FullMethodName    = "/zenoda.rewards.Msg/UndelegateVotingPower"
+	Msg_RegisterCandidate_FullMethodName        = "/zenoda.rewards.Msg/RegisterCandidate"
+	Msg_WithdrawCandidacy_FullMethodName        = "/zenoda.rewards.Msg/WithdrawCandidacy"
+	Msg_SetRewardWithdrawAddress_FullMethodName = "/zenoda.rewards.Msg/SetRewardWithdrawAddress"
+	Msg_WithdrawVested_FullMethodName           = "/zenoda.rewards.Msg/WithdrawVested"
+	Msg_FundRewardsPool_FullMethodName          = "/zenoda.rewards.Msg/FundRewardsPool"
+	Msg_RefundCampaign_FullMethodName           = "/zenoda.rewards.Msg/RefundCampaign"
+	Msg_LockEGV_FullMethodName                  = "/zenoda.rewards.Msg/LockEGV"
+	Msg_UnlockEGV_FullMethodName                = "/zenoda.rewards.Msg/UnlockEGV"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +40,38 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// VetoParamChange lets a governance wallet vote to cancel a params update
+	// that is still waiting in the timelock queue.
+	VetoParamChange(ctx context.Context, in *MsgVetoParamChange, opts ...grpc.CallOption) (*MsgVetoParamChangeResponse, error)
+	// DelegateVotingPower delegates the signer's contribution-weighted voting
+	// power to another address. Only governance wallets and election candidates
+	// have a transaction count, so only they can delegate.
+	DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error)
+	// UndelegateVotingPower removes the signer's voting power delegation.
+	UndelegateVotingPower(ctx context.Context, in *MsgUndelegateVotingPower, opts ...grpc.CallOption) (*MsgUndelegateVotingPowerResponse, error)
+	// RegisterCandidate registers the signer as a candidate in governance
+	// wallet elections.
+	RegisterCandidate(ctx context.Context, in *MsgRegisterCandidate, opts ...grpc.CallOption) (*MsgRegisterCandidateResponse, error)
+	// WithdrawCandidacy removes the signer from the election candidates.
+	WithdrawCandidacy(ctx context.Context, in *MsgWithdrawCandidacy, opts ...grpc.CallOption) (*MsgWithdrawCandidacyResponse, error)
+	// SetRewardWithdrawAddress sets the address the signer's rewards are paid
+	// to.
+	SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error)
+	// WithdrawVested pays out the vested part of the signer's reward vesting
+	// positions.
+	WithdrawVested(ctx context.Context, in *MsgWithdrawVested, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error)
+	// FundRewardsPool lets any account pay coins into the reward pool or into
+	// a new sponsored incentive campaign.
+	FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error)
+	// RefundCampaign returns the unspent funds of a settled campaign to its
+	// sponsor.
+	RefundCampaign(ctx context.Context, in *MsgRefundCampaign, opts ...grpc.CallOption) (*MsgRefundCampaignResponse, error)
+	// LockEGV locks EGV of the signer in the module, boosting its contribution
+	// weight for rewards and governance.
+	LockEGV(ctx context.Context, in *MsgLockEGV, opts ...grpc.CallOption) (*MsgLockEGVResponse, error)
+	// UnlockEGV starts returning locked EGV to the signer. The EGV stops
+	// boosting at once and is paid out after lock_unbonding_period blocks.
+	UnlockEGV(ctx context.Context, in *MsgUnlockEGV, opts ...grpc.CallOption) (*MsgUnlockEGVResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +91,105 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) VetoParamChange(ctx context.Context, in *MsgVetoParamChange, opts ...grpc.CallOption) (*MsgVetoParamChangeResponse, error) {
+	out := new(MsgVetoParamChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_VetoParamChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error) {
+	out := new(MsgDelegateVotingPowerResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateVotingPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVotingPower(ctx context.Context, in *MsgUndelegateVotingPower, opts ...grpc.CallOption) (*MsgUndelegateVotingPowerResponse, error) {
+	out := new(MsgUndelegateVotingPowerResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateVotingPower_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCandidate(ctx context.Context, in *MsgRegisterCandidate, opts ...grpc.CallOption) (*MsgRegisterCandidateResponse, error) {
+	out := new(MsgRegisterCandidateResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterCandidate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawCandidacy(ctx context.Context, in *MsgWithdrawCandidacy, opts ...grpc.CallOption) (*MsgWithdrawCandidacyResponse, error) {
+	out := new(MsgWithdrawCandidacyResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawCandidacy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRewardWithdrawAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawVested(ctx context.Context, in *MsgWithdrawVested, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error) {
+	out := new(MsgWithdrawVestedResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawVested_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error) {
+	out := new(MsgFundRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, Msg_FundRewardsPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundCampaign(ctx context.Context, in *MsgRefundCampaign, opts ...grpc.CallOption) (*MsgRefundCampaignResponse, error) {
+	out := new(MsgRefundCampaignResponse)
+	err := c.cc.Invoke(ctx, Msg_RefundCampaign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockEGV(ctx context.Context, in *MsgLockEGV, opts ...grpc.CallOption) (*MsgLockEGVResponse, error) {
+	out := new(MsgLockEGVResponse)
+	err := c.cc.Invoke(ctx, Msg_LockEGV_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockEGV(ctx context.Context, in *MsgUnlockEGV, opts ...grpc.CallOption) (*MsgUnlockEGVResponse, error) {
+	out := new(MsgUnlockEGVResponse)
+	err := c.cc.Invoke(ctx, Msg_UnlockEGV_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +197,38 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// VetoParamChange lets a governance wallet vote to cancel a params update
+	// that is still waiting in the timelock queue.
+	VetoParamChange(context.Context, *MsgVetoParamChange) (*MsgVetoParamChangeResponse, error)
+	// DelegateVotingPower delegates the signer's contribution-weighted voting
+	// power to another address. Only governance wallets and election candidates
+	// have a transaction count, so only they can delegate.
+	DelegateVotingPower(context.Context, *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error)
+	// UndelegateVotingPower removes the signer's voting power delegation.
+	UndelegateVotingPower(context.Context, *MsgUndelegateVotingPower) (*MsgUndelegateVotingPowerResponse, error)
+	// RegisterCandidate registers the signer as a candidate in governance
+	// wallet elections.
+	RegisterCandidate(context.Context, *MsgRegisterCandidate) (*MsgRegisterCandidateResponse, error)
+	// WithdrawCandidacy removes the signer from the election candidates.
+	WithdrawCandidacy(context.Context, *MsgWithdrawCandidacy) (*MsgWithdrawCandidacyResponse, error)
+	// SetRewardWithdrawAddress sets the address the signer's rewards are paid
+	// to.
+	SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error)
+	// WithdrawVested pays out the vested part of the signer's reward vesting
+	// positions.
+	WithdrawVested(context.Context, *MsgWithdrawVested) (*MsgWithdrawVestedResponse, error)
+	// FundRewardsPool lets any account pay coins into the reward pool or into
+	// a new sponsored incentive campaign.
+	FundRewardsPool(context.Context, *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error)
+	// RefundCampaign returns the unspent funds of a settled campaign to its
+	// sponsor.
+	RefundCampaign(context.Context, *MsgRefundCampaign) (*MsgRefundCampaignResponse, error)
+	// LockEGV locks EGV of the signer in the module, boosting its contribution
+	// weight for rewards and governance.
+	LockEGV(context.Context, *MsgLockEGV) (*MsgLockEGVResponse, error)
+	// UnlockEGV starts returning locked EGV to the signer. The EGV stops
+	// boosting at once and is paid out after lock_unbonding_period blocks.
+	UnlockEGV(context.Context, *MsgUnlockEGV) (*MsgUnlockEGVResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +239,39 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) VetoParamChange(context.Context, *MsgVetoParamChange) (*MsgVetoParamChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoParamChange not implemented")
+}
+func (UnimplementedMsgServer) DelegateVotingPower(context.Context, *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVotingPower not implemented")
+}
+func (UnimplementedMsgServer) UndelegateVotingPower(context.Context, *MsgUndelegateVotingPower) (*MsgUndelegateVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVotingPower not implemented")
+}
+func (UnimplementedMsgServer) RegisterCandidate(context.Context, *MsgRegisterCandidate) (*MsgRegisterCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCandidate not implemented")
+}
+func (UnimplementedMsgServer) WithdrawCandidacy(context.Context, *MsgWithdrawCandidacy) (*MsgWithdrawCandidacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCandidacy not implemented")
+}
+func (UnimplementedMsgServer) SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardWithdrawAddress not implemented")
+}
+func (UnimplementedMsgServer) WithdrawVested(context.Context, *MsgWithdrawVested) (*MsgWithdrawVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawVested not implemented")
+}
+func (UnimplementedMsgServer) FundRewardsPool(context.Context, *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardsPool not implemented")
+}
+func (UnimplementedMsgServer) RefundCampaign(context.Context, *MsgRefundCampaign) (*MsgRefundCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundCampaign not implemented")
+}
+func (UnimplementedMsgServer) LockEGV(context.Context, *MsgLockEGV) (*MsgLockEGVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockEGV not implemented")
+}
+func (UnimplementedMsgServer) UnlockEGV(context.Context, *MsgUnlockEGV) (*MsgUnlockEGVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockEGV not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +303,204 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VetoParamChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoParamChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoParamChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VetoParamChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoParamChange(ctx, req.(*MsgVetoParamChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVotingPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateVotingPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVotingPower(ctx, req.(*MsgDelegateVotingPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVotingPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateVotingPower_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVotingPower(ctx, req.(*MsgUndelegateVotingPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCandidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCandidate(ctx, req.(*MsgRegisterCandidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawCandidacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCandidacy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCandidacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawCandidacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCandidacy(ctx, req.(*MsgWithdrawCandidacy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRewardWithdrawAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, req.(*MsgSetRewardWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawVested_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawVested(ctx, req.(*MsgWithdrawVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardsPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FundRewardsPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardsPool(ctx, req.(*MsgFundRewardsPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RefundCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundCampaign(ctx, req.(*MsgRefundCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockEGV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockEGV)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockEGV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LockEGV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockEGV(ctx, req.(*MsgLockEGV))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockEGV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockEGV)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockEGV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnlockEGV_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockEGV(ctx, req.(*MsgUnlockEGV))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +512,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "VetoParamChange",
+			Handler:    _Msg_VetoParamChange_Handler,
+		},
+		{
+			MethodName: "DelegateVotingPower",
+			Handler:    _Msg_DelegateVotingPower_Handler,
+		},
+		{
+			MethodName: "UndelegateVotingPower",
+			Handler:    _Msg_UndelegateVotingPower_Handler,
+		},
+		{
+			MethodName: "RegisterCandidate",
+			Handler:    _Msg_RegisterCandidate_Handler,
+		},
+		{
+			MethodName: "WithdrawCandidacy",
+			Handler:    _Msg_WithdrawCandidacy_Handler,
+		},
+		{
+			MethodName: "SetRewardWithdrawAddress",
+			Handler:    _Msg_SetRewardWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawVested",
+			Handler:    _Msg_WithdrawVested_Handler,
+		},
+		{
+			MethodName: "FundRewardsPool",
+			Handler:    _Msg_FundRewardsPool_Handler,
+		},
+		{
+			MethodName: "RefundCampaign",
+			Handler:    _Msg_RefundCampaign_Handler,
+		},
+		{
+			MethodName: "LockEGV",
+			Handler:    _Msg_LockEGV_Handler,
+		},
+		{
+			MethodName: "UnlockEGV",
+			Handler:    _Msg_UnlockEGV_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zenoda/rewards/tx.proto",
@@ -14,15 +14,68 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*QueuedParamChange
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedParamChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedParamChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(QueuedParamChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(QueuedParamChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                      protoreflect.MessageDescriptor
+	fd_GenesisState_params               protoreflect.FieldDescriptor
+	fd_GenesisState_queued_param_changes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_zenoda_zenoda_genesis_proto_init()
 	md_GenesisState = File_zenoda_zenoda_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_queued_param_changes = md_GenesisState.Fields().ByName("queued_param_changes")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +149,12 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.QueuedParamChanges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.QueuedParamChanges})
+		if !f(fd_GenesisState_queued_param_changes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +172,8 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "zenoda.zenoda.GenesisState.params":
 		return x.Params != nil
+	case "zenoda.zenoda.GenesisState.queued_param_changes":
+		return len(x.QueuedParamChanges) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.GenesisState"))
@@ -131,6 +192,8 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "zenoda.zenoda.GenesisState.params":
 		x.Params = nil
+	case "zenoda.zenoda.GenesisState.queued_param_changes":
+		x.QueuedParamChanges = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.GenesisState"))
@@ -150,6 +213,12 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "zenoda.zenoda.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zenoda.zenoda.GenesisState.queued_param_changes":
+		if len(x.QueuedParamChanges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.QueuedParamChanges}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.GenesisState"))
@@ -172,6 +241,10 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "zenoda.zenoda.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "zenoda.zenoda.GenesisState.queued_param_changes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.QueuedParamChanges = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.GenesisState"))
@@ -197,6 +270,12 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "zenoda.zenoda.GenesisState.queued_param_changes":
+		if x.QueuedParamChanges == nil {
+			x.QueuedParamChanges = []*QueuedParamChange{}
+		}
+		value := &_GenesisState_2_list{list: &x.QueuedParamChanges}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.GenesisState"))
@@ -213,6 +292,9 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "zenoda.zenoda.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zenoda.zenoda.GenesisState.queued_param_changes":
+		list := []*QueuedParamChange{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.GenesisState"))
@@ -286,6 +368,12 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.QueuedParamChanges) > 0 {
+			for _, e := range x.QueuedParamChanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +403,22 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.QueuedParamChanges) > 0 {
+			for iNdEx := len(x.QueuedParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueuedParamChanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -414,6 +518,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedParamChanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueuedParamChanges = append(x.QueuedParamChanges, &QueuedParamChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedParamChanges[len(x.QueuedParamChanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -470,6 +608,8 @@ type GenesisState struct {
 
 	// params defines all the parameters of the module.
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// queued_param_changes are the params updates waiting in the timelock queue.
+	QueuedParamChanges []*QueuedParamChange `protobuf:"bytes,2,rep,name=queued_param_changes,json=queuedParamChanges,proto3" json:"queued_param_changes,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +639,13 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetQueuedParamChanges() []*QueuedParamChange {
+	if x != nil {
+		return x.QueuedParamChanges
+	}
+	return nil
+}
+
 var File_zenoda_zenoda_genesis_proto protoreflect.FileDescriptor
 
 var file_zenoda_zenoda_genesis_proto_rawDesc = []byte{
@@ -509,21 +656,29 @@ var file_zenoda_zenoda_genesis_proto_rawDesc = []byte{
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x7a, 0x65,
 	0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64,
-	0x61, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x90, 0x01, 0x0a, 0x11,
-	0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64,
-	0x61, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x18, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x65,
-	0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0xa2, 0x02, 0x03, 0x5a, 0x5a,
-	0x58, 0xaa, 0x02, 0x0d, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x5a, 0x65, 0x6e, 0x6f, 0x64,
-	0x61, 0xca, 0x02, 0x0d, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x5a, 0x65, 0x6e, 0x6f, 0x64,
-	0x61, 0xe2, 0x02, 0x19, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x5a, 0x65, 0x6e, 0x6f, 0x64,
-	0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
-	0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x3a, 0x3a, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x1a, 0x1c, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa7, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5d, 0x0a, 0x14, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x42, 0x90, 0x01, 0x0a, 0x11, 0x63, 0x6f,
+	0x6d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x18, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x65, 0x6e, 0x6f,
+	0x64, 0x61, 0x2f, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0xa2, 0x02, 0x03, 0x5a, 0x5a, 0x58, 0xaa,
+	0x02, 0x0d, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0xca,
+	0x02, 0x0d, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0xe2,
+	0x02, 0x19, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x5a, 0x65,
+	0x6e, 0x6f, 0x64, 0x61, 0x3a, 0x3a, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -540,16 +695,18 @@ func file_zenoda_zenoda_genesis_proto_rawDescGZIP() []byte {
 
 var file_zenoda_zenoda_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_zenoda_zenoda_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: zenoda.zenoda.GenesisState
-	(*Params)(nil),       // 1: zenoda.zenoda.Params
+	(*GenesisState)(nil),      // 0: zenoda.zenoda.GenesisState
+	(*Params)(nil),            // 1: zenoda.zenoda.Params
+	(*QueuedParamChange)(nil), // 2: zenoda.zenoda.QueuedParamChange
 }
 var file_zenoda_zenoda_genesis_proto_depIdxs = []int32{
 	1, // 0: zenoda.zenoda.GenesisState.params:type_name -> zenoda.zenoda.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: zenoda.zenoda.GenesisState.queued_param_changes:type_name -> zenoda.zenoda.QueuedParamChange
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_zenoda_zenoda_genesis_proto_init() }
@@ -558,6 +715,7 @@ func file_zenoda_zenoda_genesis_proto_init() {
 		return
 	}
 	file_zenoda_zenoda_params_proto_init()
+	file_zenoda_zenoda_timelock_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_zenoda_zenoda_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName = "/zenoda.zenoda.Query/Params"
+	Query_Params_FullMethodName              = "/zenoda.zenoda.Query/Params"
+	Query_PendingParamChanges_FullMethodName = "/zenoda.zenoda.Query/PendingParamChanges"
 )
 
 // QueryClient is the client API for Query service.
@@ -28,6 +29,9 @@ const (
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingParamChanges queries the params updates waiting in the timelock
+	// queue.
+	PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -47,12 +51,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error) {
+	out := new(QueryPendingParamChangesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingParamChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingParamChanges queries the params updates waiting in the timelock
+	// queue.
+	PendingParamChanges(context.Context, *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -63,6 +79,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PendingParamChanges(context.Context, *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamChanges not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +113,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingParamChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamChanges(ctx, req.(*QueryPendingParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +142,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingParamChanges",
+			Handler:    _Query_PendingParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zenoda/zenoda/query.proto",
@@ -0,0 +1,846 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package zenoda
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_QueuedParamChange_4_list)(nil)
+
+type _QueuedParamChange_4_list struct {
+	list *[]string
+}
+
+func (x *_QueuedParamChange_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueuedParamChange_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueuedParamChange_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueuedParamChange_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueuedParamChange_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueuedParamChange at list field Vetoes as it is not of Message kind"))
+}
+
+func (x *_QueuedParamChange_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueuedParamChange_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueuedParamChange_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueuedParamChange                protoreflect.MessageDescriptor
+	fd_QueuedParamChange_id             protoreflect.FieldDescriptor
+	fd_QueuedParamChange_params         protoreflect.FieldDescriptor
+	fd_QueuedParamChange_execute_height protoreflect.FieldDescriptor
+	fd_QueuedParamChange_vetoes         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_zenoda_zenoda_timelock_proto_init()
+	md_QueuedParamChange = File_zenoda_zenoda_timelock_proto.Messages().ByName("QueuedParamChange")
+	fd_QueuedParamChange_id = md_QueuedParamChange.Fields().ByName("id")
+	fd_QueuedParamChange_params = md_QueuedParamChange.Fields().ByName("params")
+	fd_QueuedParamChange_execute_height = md_QueuedParamChange.Fields().ByName("execute_height")
+	fd_QueuedParamChange_vetoes = md_QueuedParamChange.Fields().ByName("vetoes")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueuedParamChange)(nil)
+
+type fastReflection_QueuedParamChange QueuedParamChange
+
+func (x *QueuedParamChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueuedParamChange)(x)
+}
+
+func (x *QueuedParamChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_zenoda_zenoda_timelock_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueuedParamChange_messageType fastReflection_QueuedParamChange_messageType
+var _ protoreflect.MessageType = fastReflection_QueuedParamChange_messageType{}
+
+type fastReflection_QueuedParamChange_messageType struct{}
+
+func (x fastReflection_QueuedParamChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueuedParamChange)(nil)
+}
+func (x fastReflection_QueuedParamChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueuedParamChange)
+}
+func (x fastReflection_QueuedParamChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueuedParamChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueuedParamChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueuedParamChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueuedParamChange) Type() protoreflect.MessageType {
+	return _fastReflection_QueuedParamChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueuedParamChange) New() protoreflect.Message {
+	return new(fastReflection_QueuedParamChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueuedParamChange) Interface() protoreflect.ProtoMessage {
+	return (*QueuedParamChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueuedParamChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QueuedParamChange_id, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueuedParamChange_params, value) {
+			return
+		}
+	}
+	if x.ExecuteHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecuteHeight)
+		if !f(fd_QueuedParamChange_execute_height, value) {
+			return
+		}
+	}
+	if len(x.Vetoes) != 0 {
+		value := protoreflect.ValueOfList(&_QueuedParamChange_4_list{list: &x.Vetoes})
+		if !f(fd_QueuedParamChange_vetoes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueuedParamChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "zenoda.zenoda.QueuedParamChange.id":
+		return x.Id != uint64(0)
+	case "zenoda.zenoda.QueuedParamChange.params":
+		return x.Params != nil
+	case "zenoda.zenoda.QueuedParamChange.execute_height":
+		return x.ExecuteHeight != int64(0)
+	case "zenoda.zenoda.QueuedParamChange.vetoes":
+		return len(x.Vetoes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.QueuedParamChange"))
+		}
+		panic(fmt.Errorf("message zenoda.zenoda.QueuedParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedParamChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "zenoda.zenoda.QueuedParamChange.id":
+		x.Id = uint64(0)
+	case "zenoda.zenoda.QueuedParamChange.params":
+		x.Params = nil
+	case "zenoda.zenoda.QueuedParamChange.execute_height":
+		x.ExecuteHeight = int64(0)
+	case "zenoda.zenoda.QueuedParamChange.vetoes":
+		x.Vetoes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.QueuedParamChange"))
+		}
+		panic(fmt.Errorf("message zenoda.zenoda.QueuedParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueuedParamChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "zenoda.zenoda.QueuedParamChange.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "zenoda.zenoda.QueuedParamChange.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "zenoda.zenoda.QueuedParamChange.execute_height":
+		value := x.ExecuteHeight
+		return protoreflect.ValueOfInt64(value)
+	case "zenoda.zenoda.QueuedParamChange.vetoes":
+		if len(x.Vetoes) == 0 {
+			return protoreflect.ValueOfList(&_QueuedParamChange_4_list{})
+		}
+		listValue := &_QueuedParamChange_4_list{list: &x.Vetoes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.QueuedParamChange"))
+		}
+		panic(fmt.Errorf("message zenoda.zenoda.QueuedParamChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedParamChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "zenoda.zenoda.QueuedParamChange.id":
+		x.Id = value.Uint()
+	case "zenoda.zenoda.QueuedParamChange.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "zenoda.zenoda.QueuedParamChange.execute_height":
+		x.ExecuteHeight = value.Int()
+	case "zenoda.zenoda.QueuedParamChange.vetoes":
+		lv := value.List()
+		clv := lv.(*_QueuedParamChange_4_list)
+		x.Vetoes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.QueuedParamChange"))
+		}
+		panic(fmt.Errorf("message zenoda.zenoda.QueuedParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedParamChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.zenoda.QueuedParamChange.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "zenoda.zenoda.QueuedParamChange.vetoes":
+		if x.Vetoes == nil {
+			x.Vetoes = []string{}
+		}
+		value := &_QueuedParamChange_4_list{list: &x.Vetoes}
+		return protoreflect.ValueOfList(value)
+	case "zenoda.zenoda.QueuedParamChange.id":
+		panic(fmt.Errorf("field id of message zenoda.zenoda.QueuedParamChange is not mutable"))
+	case "zenoda.zenoda.QueuedParamChange.execute_height":
+		panic(fmt.Errorf("field execute_height of message zenoda.zenoda.QueuedParamChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.QueuedParamChange"))
+		}
+		panic(fmt.Errorf("message zenoda.zenoda.QueuedParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueuedParamChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "zenoda.zenoda.QueuedParamChange.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "zenoda.zenoda.QueuedParamChange.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "zenoda.zenoda.QueuedParamChange.execute_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "zenoda.zenoda.QueuedParamChange.vetoes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueuedParamChange_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: zenoda.zenoda.QueuedParamChange"))
+		}
+		panic(fmt.Errorf("message zenoda.zenoda.QueuedParamChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueuedParamChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in zenoda.zenoda.QueuedParamChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueuedParamChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueuedParamChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueuedParamChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueuedParamChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueuedParamChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecuteHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecuteHeight))
+		}
+		if len(x.Vetoes) > 0 {
+			for _, s := range x.Vetoes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueuedParamChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Vetoes) > 0 {
+			for iNdEx := len(x.Vetoes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Vetoes[iNdEx])
+				copy(dAtA[i:], x.Vetoes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vetoes[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.ExecuteHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecuteHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueuedParamChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueuedParamChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueuedParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+				}
+				x.ExecuteHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecuteHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vetoes = append(x.Vetoes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: zenoda/zenoda/timelock.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueuedParamChange is a governance approved params update waiting in the
+// timelock queue.
+type QueuedParamChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the sequence number of the queued change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// params are the parameters that will be applied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// execute_height is the block height at which the change is applied.
+	ExecuteHeight int64 `protobuf:"varint,3,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// vetoes lists the governance wallets that voted to cancel the change.
+	Vetoes []string `protobuf:"bytes,4,rep,name=vetoes,proto3" json:"vetoes,omitempty"`
+}
+
+func (x *QueuedParamChange) Reset() {
+	*x = QueuedParamChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_zenoda_zenoda_timelock_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueuedParamChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueuedParamChange) ProtoMessage() {}
+
+// Deprecated: Use QueuedParamChange.ProtoReflect.Descriptor instead.
+func (*QueuedParamChange) Descriptor() ([]byte, []int) {
+	return file_zenoda_zenoda_timelock_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueuedParamChange) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueuedParamChange) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *QueuedParamChange) GetExecuteHeight() int64 {
+	if x != nil {
+		return x.ExecuteHeight
+	}
+	return 0
+}
+
+func (x *QueuedParamChange) GetVetoes() []string {
+	if x != nil {
+		return x.Vetoes
+	}
+	return nil
+}
+
+var File_zenoda_zenoda_timelock_proto protoreflect.FileDescriptor
+
+var file_zenoda_zenoda_timelock_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
+	0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x1a, 0x11, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x7a,
+	0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x74,
+	0x6f, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x65, 0x74, 0x6f, 0x65,
+	0x73, 0x42, 0x91, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61,
+	0x2e, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x42, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x7a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x2f, 0x7a, 0x65, 0x6e, 0x6f,
+	0x64, 0x61, 0xa2, 0x02, 0x03, 0x5a, 0x5a, 0x58, 0xaa, 0x02, 0x0d, 0x5a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x2e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0xca, 0x02, 0x0d, 0x5a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x5c, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0xe2, 0x02, 0x19, 0x5a, 0x65, 0x6e, 0x6f, 0x64,
+	0x61, 0x5c, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x5a, 0x65, 0x6e, 0x6f, 0x64, 0x61, 0x3a, 0x3a, 0x5a,
+	0x65, 0x6e, 0x6f, 0x64, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_zenoda_zenoda_timelock_proto_rawDescOnce sync.Once
+	file_zenoda_zenoda_timelock_proto_rawDescData = file_zenoda_zenoda_timelock_proto_rawDesc
+)
+
+func file_zenoda_zenoda_timelock_proto_rawDescGZIP() []byte {
+	file_zenoda_zenoda_timelock_proto_rawDescOnce.Do(func() {
+		file_zenoda_zenoda_timelock_proto_rawDescData = protoimpl.X.CompressGZIP(file_zenoda_zenoda_timelock_proto_rawDescData)
+	})
+	return file_zenoda_zenoda_timelock_proto_rawDescData
+}
+
+var file_zenoda_zenoda_timelock_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_zenoda_zenoda_timelock_proto_goTypes = []interface{}{
+	(*QueuedParamChange)(nil), // 0: zenoda.zenoda.QueuedParamChange
+	(*Params)(nil),            // 1: zenoda.zenoda.Params
+}
+var file_zenoda_zenoda_timelock_proto_depIdxs = []int32{
+	1, // 0: zenoda.zenoda.QueuedParamChange.params:type_name -> zenoda.zenoda.Params
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_zenoda_zenoda_timelock_proto_init() }
+func file_zenoda_zenoda_timelock_proto_init() {
+	if File_zenoda_zenoda_timelock_proto != nil {
+		return
+	}
+	file_zenoda_zenoda_params_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_zenoda_zenoda_timelock_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueuedParamChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_zenoda_zenoda_timelock_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_zenoda_zenoda_timelock_proto_goTypes,
+		DependencyIndexes: file_zenoda_zenoda_timelock_proto_depIdxs,
+		MessageInfos:      file_zenoda_zenoda_timelock_proto_msgTypes,
+	}.Build()
+	File_zenoda_zenoda_timelock_proto = out.File
+	file_zenoda_zenoda_timelock_proto_rawDesc = nil
+	file_zenoda_zenoda_timelock_proto_goTypes = nil
+	file_zenoda_zenoda_timelock_proto_depIdxs = nil
+}
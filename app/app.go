@@ -76,6 +76,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	rewardsmodulekeeper "zenoda/x/rewards/keeper"
+	rewardspost "zenoda/x/rewards/post"
 	zenodamodulekeeper "zenoda/x/zenoda/keeper"
 
 	// this line is used by starport scaffolding # stargate/app/moduleImport
@@ -276,6 +277,11 @@ func New(
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
 
+	// record transaction and campaign contributions once a transaction succeeds
+	app.SetPostHandler(sdk.ChainPostDecorators(
+		rewardspost.NewContributionDecorator(app.appCodec, app.RewardsKeeper),
+	))
+
 	// create the simulation manager and define the order of the modules for deterministic simulations
 	overrideModules := map[string]module.AppModuleSimulation{
 		authtypes.ModuleName: auth.NewAppModule(app.appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts, app.GetSubspace(authtypes.ModuleName)),
@@ -108,6 +108,10 @@ var (
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	beginBlockers = []string{
 		// cosmos sdk modules
+		// rewards takes its fee share before mint adds the block provision to
+		// the fee collector, so the share comes from fees only, and before
+		// distr allocates the fee collector balance
+		rewardsmoduletypes.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -123,7 +127,6 @@ var (
 		ibcfeetypes.ModuleName,
 		// chain modules
 		zenodamoduletypes.ModuleName,
-		rewardsmoduletypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
 	}
 
@@ -164,7 +167,11 @@ var (
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: ibcfeetypes.ModuleName},
 		{Account: icatypes.ModuleName},
-		{Account: rewardsmoduletypes.ModuleName, Permissions: []string{authtypes.Minter}},
+		{Account: rewardsmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: rewardsmoduletypes.VestingModuleName},
+		{Account: rewardsmoduletypes.RewardsModuleName},
+		{Account: rewardsmoduletypes.CampaignModuleName},
+		{Account: rewardsmoduletypes.LockModuleName},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -176,6 +183,10 @@ var (
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
 		nft.ModuleName,
+		rewardsmoduletypes.VestingModuleName,
+		rewardsmoduletypes.RewardsModuleName,
+		rewardsmoduletypes.CampaignModuleName,
+		rewardsmoduletypes.LockModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
@@ -10,42 +10,123 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	"zenoda/testutil/sample"
 	"zenoda/x/rewards/keeper"
 	"zenoda/x/rewards/types"
 )
 
 func RewardsKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
-	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	return RewardsKeeperWithPrefix(t, sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+// RewardsKeeperWithPrefix returns a rewards keeper whose account keeper
+// encodes addresses with the given Bech32 prefix.
+func RewardsKeeperWithPrefix(t testing.TB, bech32Prefix string) (keeper.Keeper, sdk.Context) {
+	return rewardsKeeperWithParams(t, bech32Prefix, nil)
+}
+
+// RewardsKeeperWithGov returns a rewards keeper that reads x/gov votes from
+// the given query server.
+func RewardsKeeperWithGov(t testing.TB, govQuery types.GovQueryServer) (keeper.Keeper, sdk.Context) {
+	return rewardsKeeperWithParams(t, sdk.GetConfig().GetBech32AccountAddrPrefix(), govQuery)
+}
 
+func rewardsKeeperWithParams(t testing.TB, bech32Prefix string, govQuery types.GovQueryServer) (keeper.Keeper, sdk.Context) {
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
-	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	k := newRewardsKeeper(stateStore, db, bech32Prefix, govQuery)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
-	registry := codectypes.NewInterfaceRegistry()
-	cdc := codec.NewProtoCodec(registry)
-	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
-
-	k := keeper.NewKeeper(
-		cdc,
-		runtime.NewKVStoreService(storeKey),
-		log.NewNopLogger(),
-		authority.String(),
-	)
-
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
-	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
+	if err := k.SetParams(ctx, sample.RewardsParams()); err != nil {
 		panic(err)
 	}
 
 	return k, ctx
 }
+
+// RewardsKeeperWithoutParams returns a rewards keeper whose store is empty,
+// as it is before the rewards genesis has run.
+func RewardsKeeperWithoutParams(t testing.TB) (keeper.Keeper, sdk.Context) {
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	k := newRewardsKeeper(stateStore, db, sdk.GetConfig().GetBech32AccountAddrPrefix(), nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	return k, sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
+}
+
+// newRewardsKeeper mounts the rewards, auth and bank stores on the given
+// multistore and wires a rewards keeper on top of them. govQuery may be nil
+// when x/gov votes are not read.
+func newRewardsKeeper(stateStore storetypes.CommitMultiStore, db dbm.DB, bech32Prefix string, govQuery types.GovQueryServer) keeper.Keeper {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	authStoreKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(authStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(bankStoreKey, storetypes.StoreTypeIAVL, db)
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	addressCodec := addresscodec.NewBech32Codec(bech32Prefix)
+	authority, err := addressCodec.BytesToString(authtypes.NewModuleAddress(govtypes.ModuleName))
+	if err != nil {
+		panic(err)
+	}
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		runtime.NewKVStoreService(authStoreKey),
+		authtypes.ProtoBaseAccount,
+		map[string][]string{
+			types.ModuleName:           {authtypes.Minter, authtypes.Burner},
+			types.VestingModuleName:    nil,
+			types.RewardsModuleName:    nil,
+			types.CampaignModuleName:   nil,
+			types.LockModuleName:       nil,
+			authtypes.FeeCollectorName: nil,
+		},
+		addressCodec,
+		bech32Prefix,
+		authority,
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		runtime.NewKVStoreService(bankStoreKey),
+		accountKeeper,
+		map[string]bool{
+			authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(): true,
+			authtypes.NewModuleAddress(types.VestingModuleName).String():    true,
+		},
+		authority,
+		log.NewNopLogger(),
+	)
+
+	return keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		log.NewNopLogger(),
+		authority,
+		bankKeeper,
+		accountKeeper,
+		govQuery,
+	)
+}
@@ -17,16 +17,26 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
+	"zenoda/testutil/sample"
+	rewardskeeper "zenoda/x/rewards/keeper"
 	"zenoda/x/zenoda/keeper"
 	"zenoda/x/zenoda/types"
 )
 
 func ZenodaKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := ZenodaKeeperWithRewards(t)
+	return k, ctx
+}
+
+// ZenodaKeeperWithRewards also returns the rewards keeper backing the
+// governance wallets and timelock settings.
+func ZenodaKeeperWithRewards(t testing.TB) (keeper.Keeper, rewardskeeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	rewardsKeeper := newRewardsKeeper(stateStore, db, sdk.GetConfig().GetBech32AccountAddrPrefix(), nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -38,6 +48,7 @@ func ZenodaKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		rewardsKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -46,6 +57,9 @@ func ZenodaKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
 		panic(err)
 	}
+	if err := rewardsKeeper.SetParams(ctx, sample.RewardsParams()); err != nil {
+		panic(err)
+	}
 
-	return k, ctx
+	return k, rewardsKeeper, ctx
 }
@@ -1,8 +1,14 @@
 package sample
 
 import (
+	"fmt"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardstypes "zenoda/x/rewards/types"
 )
 
 // AccAddress returns a sample account address
@@ -11,3 +17,28 @@ func AccAddress() string {
 	addr := pk.Address()
 	return sdk.AccAddress(addr).String()
 }
+
+// GovernanceWallets returns n deterministic account addresses encoded with
+// the chain's Bech32 prefix from sdk.GetConfig().
+func GovernanceWallets(n int) []string {
+	codec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	wallets := make([]string, n)
+	for i := range wallets {
+		pk := secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("governance-wallet-%d", i))).PubKey()
+		wallet, err := codec.BytesToString(pk.Address())
+		if err != nil {
+			panic(err)
+		}
+		wallets[i] = wallet
+	}
+	return wallets
+}
+
+// RewardsParams returns the default rewards params with generated governance
+// wallets instead of the hardcoded genesis set, so tests do not depend on a
+// particular Bech32 prefix.
+func RewardsParams() rewardstypes.Params {
+	params := rewardstypes.DefaultParams()
+	params.PredefinedWallets = GovernanceWallets(len(params.PredefinedWallets))
+	return params
+}
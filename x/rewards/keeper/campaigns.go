@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- SPONSORED CAMPAIGNS ----------------------
+
+// FundRewardsPool pays coins from any account into the reward pool or, with
+// a campaign spec, into a new sponsored incentive campaign. It returns the
+// campaign id, zero when the reward pool was funded.
+func (k Keeper) FundRewardsPool(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins, spec *types.CampaignSpec) (uint64, error) {
+	senderStr, err := k.addressString(sender)
+	if err != nil {
+		return 0, err
+	}
+	if spec == nil {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.RewardsModuleName, amount); err != nil {
+			return 0, err
+		}
+		k.emitFundRewardsPool(ctx, senderStr, amount, 0)
+		return 0, nil
+	}
+
+	if err := spec.Validate(); err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidCampaign, err.Error())
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if spec.StartEpoch < epoch {
+		return 0, errorsmod.Wrapf(types.ErrInvalidCampaign, "start epoch %d is before the current epoch %d", spec.StartEpoch, epoch)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.CampaignModuleName, amount); err != nil {
+		return 0, err
+	}
+
+	// Campaign ids start at 1 so that zero stands for the reward pool
+	last, err := k.campaignSeq.Next(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to retrieve campaign sequence")
+	}
+	campaign := types.NewCampaign(last+1, senderStr, *spec, amount)
+	if err := k.SetCampaign(ctx, campaign); err != nil {
+		return 0, err
+	}
+
+	k.emitFundRewardsPool(ctx, senderStr, amount, campaign.Id)
+	return campaign.Id, nil
+}
+
+func (k Keeper) emitFundRewardsPool(ctx sdk.Context, sender string, amount sdk.Coins, campaignID uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundRewardsPool,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaignID, 10)),
+		),
+	)
+}
+
+// SetCampaign stores a campaign, indexes it by end epoch until it is settled
+// and moves the campaign id sequence up to its id.
+func (k Keeper) SetCampaign(ctx sdk.Context, campaign types.Campaign) error {
+	if err := k.campaigns.Set(ctx, campaign.Id, campaign); err != nil {
+		return errorsmod.Wrapf(err, "failed to store campaign %d", campaign.Id)
+	}
+	activeKey := collections.Join(campaign.Spec.EndEpoch, campaign.Id)
+	if campaign.Settled {
+		if err := k.activeCampaigns.Remove(ctx, activeKey); err != nil {
+			return errorsmod.Wrapf(err, "failed to unindex campaign %d", campaign.Id)
+		}
+	} else if err := k.activeCampaigns.Set(ctx, activeKey); err != nil {
+		return errorsmod.Wrapf(err, "failed to index campaign %d", campaign.Id)
+	}
+	last, err := k.campaignSeq.Peek(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to retrieve campaign sequence")
+	}
+	if campaign.Id > last {
+		return k.campaignSeq.Set(ctx, campaign.Id)
+	}
+	return nil
+}
+
+// GetCampaign returns a campaign by id.
+func (k Keeper) GetCampaign(ctx sdk.Context, id uint64) (types.Campaign, error) {
+	campaign, err := k.campaigns.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return campaign, errorsmod.Wrapf(types.ErrCampaignNotFound, "%d", id)
+	}
+	if err != nil {
+		return campaign, errorsmod.Wrapf(err, "failed to retrieve campaign %d", id)
+	}
+	return campaign, nil
+}
+
+// GetAllCampaigns returns every campaign, oldest first.
+func (k Keeper) GetAllCampaigns(ctx sdk.Context) (list []types.Campaign, err error) {
+	err = k.campaigns.Walk(ctx, nil, func(_ uint64, campaign types.Campaign) (bool, error) {
+		list = append(list, campaign)
+		return false, nil
+	})
+	return list, err
+}
+
+// SetCampaignContribution stores the matching messages an address sent
+// during a campaign.
+func (k Keeper) SetCampaignContribution(ctx sdk.Context, contribution types.CampaignContribution) error {
+	addr, err := k.accAddress(contribution.Address)
+	if err != nil {
+		return err
+	}
+	return k.campaignContributions.Set(ctx, collections.Join(contribution.CampaignId, addr), contribution.Count)
+}
+
+// GetCampaignContributions returns the matching messages counted per address
+// for a campaign.
+func (k Keeper) GetCampaignContributions(ctx sdk.Context, id uint64) (list []types.CampaignContribution, err error) {
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](id)
+	err = k.campaignContributions.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], count uint64) (bool, error) {
+		addr, err := k.addressString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.CampaignContribution{CampaignId: id, Address: addr, Count: count})
+		return false, nil
+	})
+	return list, err
+}
+
+// GetAllCampaignContributions returns the matching messages counted for
+// every campaign.
+func (k Keeper) GetAllCampaignContributions(ctx sdk.Context) (list []types.CampaignContribution, err error) {
+	err = k.campaignContributions.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.AccAddress], count uint64) (bool, error) {
+		addr, err := k.addressString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.CampaignContribution{CampaignId: key.K1(), Address: addr, Count: count})
+		return false, nil
+	})
+	return list, err
+}
+
+// RecordMsgContribution counts a message sent by an address towards every
+// running campaign whose filter it matches. Only unsettled campaigns that
+// have not ended are looked at.
+func (k Keeper) RecordMsgContribution(ctx sdk.Context, addr sdk.AccAddress, msgTypeURL string) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	campaigns, err := k.getActiveCampaigns(ctx, new(collections.Range[collections.Pair[uint64, uint64]]).
+		StartInclusive(collections.Join(epoch, uint64(0))))
+	if err != nil {
+		return err
+	}
+
+	for _, campaign := range campaigns {
+		if !campaign.Spec.Counts(epoch, msgTypeURL) {
+			continue
+		}
+		key := collections.Join(campaign.Id, addr)
+		count, err := k.campaignContributions.Get(ctx, key)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(err, "failed to retrieve campaign %d contribution of %s", campaign.Id, addr)
+		}
+		if err := k.campaignContributions.Set(ctx, key, count+1); err != nil {
+			return errorsmod.Wrapf(err, "failed to store campaign %d contribution of %s", campaign.Id, addr)
+		}
+	}
+	return nil
+}
+
+// SettleCampaigns splits the funds of every campaign that ended before the
+// current epoch among its contributors, in proportion to their matching
+// messages. What is not paid out, including the whole campaign if nobody
+// contributed, can be refunded to the sponsor.
+func (k Keeper) SettleCampaigns(ctx sdk.Context) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil || epoch == 0 {
+		return err
+	}
+	campaigns, err := k.getActiveCampaigns(ctx, collections.NewPrefixUntilPairRange[uint64, uint64](epoch-1))
+	if err != nil {
+		return err
+	}
+
+	for _, campaign := range campaigns {
+		if err := k.settleCampaign(ctx, campaign); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getActiveCampaigns returns the unsettled campaigns whose end epoch falls in
+// the range.
+func (k Keeper) getActiveCampaigns(ctx sdk.Context, rng collections.Ranger[collections.Pair[uint64, uint64]]) (list []types.Campaign, err error) {
+	err = k.activeCampaigns.Walk(ctx, rng, func(key collections.Pair[uint64, uint64]) (bool, error) {
+		campaign, err := k.GetCampaign(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		list = append(list, campaign)
+		return false, nil
+	})
+	return list, err
+}
+
+func (k Keeper) settleCampaign(ctx sdk.Context, campaign types.Campaign) error {
+	contributions, err := k.GetCampaignContributions(ctx, campaign.Id)
+	if err != nil {
+		return err
+	}
+	var total uint64
+	for _, contribution := range contributions {
+		total += contribution.Count
+	}
+
+	for _, contribution := range contributions {
+		share := math.LegacyNewDec(int64(contribution.Count)).QuoInt64(int64(total))
+		reward := types.ShareOfCoins(campaign.Funds, share)
+		if reward.IsZero() {
+			continue
+		}
+		addr, err := k.accAddress(contribution.Address)
+		if err != nil {
+			return err
+		}
+		withdrawAddr, err := k.GetRewardWithdrawAddress(ctx, addr)
+		if err != nil {
+			return err
+		}
+		withdrawAddrStr, err := k.addressString(withdrawAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CampaignModuleName, withdrawAddr, reward); err != nil {
+			k.Logger().Error("Failed to send campaign reward", "campaign", campaign.Id, "address", contribution.Address, "error", err)
+			continue
+		}
+		campaign.Distributed = campaign.Distributed.Add(reward...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeCampaignReward,
+				sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, contribution.Address),
+				sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddrStr),
+				sdk.NewAttribute(types.AttributeKeyAmount, reward.String()),
+			),
+		)
+	}
+
+	if err := k.campaignContributions.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](campaign.Id)); err != nil {
+		return errorsmod.Wrapf(err, "failed to clear campaign %d contributions", campaign.Id)
+	}
+	campaign.Settled = true
+	if err := k.SetCampaign(ctx, campaign); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(campaign.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, campaign.Distributed.String()),
+		),
+	)
+	return nil
+}
+
+// RefundCampaign returns the unspent funds of a settled campaign to its
+// sponsor.
+func (k Keeper) RefundCampaign(ctx sdk.Context, sponsor sdk.AccAddress, id uint64) (sdk.Coins, error) {
+	campaign, err := k.GetCampaign(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := k.accAddress(campaign.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.Equals(sponsor) {
+		sponsorStr, err := k.addressString(sponsor)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sponsor of campaign %d", sponsorStr, id)
+	}
+	if !campaign.Settled {
+		return nil, errorsmod.Wrapf(types.ErrCampaignNotRefundable, "campaign %d has not ended", id)
+	}
+	unspent := campaign.Unspent()
+	if unspent.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrCampaignNotRefundable, "campaign %d has nothing left to refund", id)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CampaignModuleName, sponsor, unspent); err != nil {
+		return nil, err
+	}
+	campaign.Refunded = campaign.Refunded.Add(unspent...)
+	if err := k.SetCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundCampaign,
+			sdk.NewAttribute(types.AttributeKeyCampaignID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeySponsor, campaign.Sponsor),
+			sdk.NewAttribute(types.AttributeKeyAmount, unspent.String()),
+		),
+	)
+	return unspent, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/types"
+)
+
+const msgSendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+// fundAccount mints coins to an account through the rewards module.
+func fundAccount(t *testing.T, k keeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	t.Helper()
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, k.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins))
+}
+
+func TestFundRewardsPool(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor := sdk.AccAddress([]byte("sponsor_____________"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	fundAccount(t, k, ctx, sponsor, funds)
+
+	res, err := ms.FundRewardsPool(ctx, types.NewMsgFundRewardsPool(sponsor.String(), funds, nil))
+	require.NoError(t, err)
+	require.Zero(t, res.CampaignId)
+	require.Equal(t, funds, k.GetRewardPool(ctx))
+	require.True(t, k.GetBankKeeper().GetAllBalances(ctx, sponsor).IsZero())
+}
+
+func TestCampaign(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	sponsor := sdk.AccAddress([]byte("sponsor_____________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	fundAccount(t, k, ctx, sponsor, sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)))
+
+	// A campaign cannot start before the current epoch.
+	funds := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	spec := types.CampaignSpec{StartEpoch: 0, EndEpoch: 2, MsgTypeUrls: []string{msgSendTypeURL}}
+	_, err := ms.FundRewardsPool(ctx, types.NewMsgFundRewardsPool(sponsor.String(), funds, &spec))
+	require.ErrorIs(t, err, types.ErrInvalidCampaign)
+
+	spec.StartEpoch = 1
+	res, err := ms.FundRewardsPool(ctx, types.NewMsgFundRewardsPool(sponsor.String(), funds, &spec))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.CampaignId)
+	require.True(t, k.GetRewardPool(ctx).IsZero())
+
+	// Only matching messages count.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.RecordMsgContribution(ctx, alice, msgSendTypeURL))
+	}
+	require.NoError(t, k.RecordMsgContribution(ctx, bob, msgSendTypeURL))
+	require.NoError(t, k.RecordMsgContribution(ctx, bob, "/cosmos.gov.v1.MsgVote"))
+
+	campaignRes, err := k.Campaign(ctx, &types.QueryCampaignRequest{Id: 1})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.CampaignContribution{
+		{CampaignId: 1, Address: alice.String(), Count: 3},
+		{CampaignId: 1, Address: bob.String(), Count: 1},
+	}, campaignRes.Contributions)
+
+	_, err = ms.RefundCampaign(ctx, types.NewMsgRefundCampaign(sponsor.String(), 1))
+	require.ErrorIs(t, err, types.ErrCampaignNotRefundable)
+
+	// The campaign is still running in its last epoch.
+	ctx = ctx.WithBlockHeight(29)
+	require.NoError(t, k.SettleCampaigns(ctx))
+	require.True(t, k.GetBankKeeper().GetAllBalances(ctx, alice).IsZero())
+
+	// Once it has ended the funds are split 3:1 and the dust is refundable.
+	ctx = ctx.WithBlockHeight(30)
+	require.NoError(t, k.SettleCampaigns(ctx))
+	require.Equal(t, math.NewInt(750), k.GetBankKeeper().GetBalance(ctx, alice, "stake").Amount)
+	require.Equal(t, math.NewInt(250), k.GetBankKeeper().GetBalance(ctx, bob, "stake").Amount)
+
+	campaign, err := k.GetCampaign(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, campaign.Settled)
+	require.Equal(t, funds, campaign.Distributed)
+	contributions, err := k.GetCampaignContributions(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, contributions)
+
+	_, err = ms.RefundCampaign(ctx, types.NewMsgRefundCampaign(sponsor.String(), 1))
+	require.ErrorIs(t, err, types.ErrCampaignNotRefundable)
+
+	// A campaign nobody contributed to is refunded in full, to its sponsor only.
+	spec = types.CampaignSpec{StartEpoch: 3, EndEpoch: 3}
+	res, err = ms.FundRewardsPool(ctx, types.NewMsgFundRewardsPool(sponsor.String(), funds, &spec))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.CampaignId)
+
+	ctx = ctx.WithBlockHeight(40)
+	require.NoError(t, k.SettleCampaigns(ctx))
+	_, err = ms.RefundCampaign(ctx, types.NewMsgRefundCampaign(other.String(), 2))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	refundRes, err := ms.RefundCampaign(ctx, types.NewMsgRefundCampaign(sponsor.String(), 2))
+	require.NoError(t, err)
+	require.Equal(t, funds, refundRes.Amount)
+	require.Equal(t, funds, k.GetBankKeeper().GetAllBalances(ctx, sponsor))
+
+	campaignsRes, err := k.Campaigns(ctx, &types.QueryCampaignsRequest{})
+	require.NoError(t, err)
+	require.Len(t, campaignsRes.Campaigns, 2)
+}
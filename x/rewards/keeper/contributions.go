@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- PER-EPOCH CONTRIBUTIONS ----------------------
+
+// incrementEpochTransactionCount counts a transaction of an address in the
+// current epoch.
+func (k Keeper) incrementEpochTransactionCount(ctx sdk.Context, addr sdk.AccAddress) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	key := collections.Join(epoch, addr)
+	count, err := k.epochTransactionCounts.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(err, "failed to retrieve epoch %d transaction count of %s", epoch, addr)
+	}
+	if err := k.epochTransactionCounts.Set(ctx, key, count+1); err != nil {
+		return errorsmod.Wrapf(err, "failed to store epoch %d transaction count of %s", epoch, addr)
+	}
+	return nil
+}
+
+// incrementEpochTotalTransactions counts a network transaction in the current
+// epoch.
+func (k Keeper) incrementEpochTotalTransactions(ctx sdk.Context) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	total, err := k.epochTotalTransactions.Get(ctx, epoch)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(err, "failed to retrieve epoch %d total transactions", epoch)
+	}
+	if err := k.epochTotalTransactions.Set(ctx, epoch, total+1); err != nil {
+		return errorsmod.Wrapf(err, "failed to store epoch %d total transactions", epoch)
+	}
+	return nil
+}
+
+// GetEpochTransactionCount returns the transactions an address made in an epoch.
+func (k Keeper) GetEpochTransactionCount(ctx sdk.Context, addr sdk.AccAddress, epoch uint64) uint64 {
+	count, err := k.epochTransactionCounts.Get(ctx, collections.Join(epoch, addr))
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+// GetEpochTotalTransactions returns the network transactions of an epoch.
+func (k Keeper) GetEpochTotalTransactions(ctx sdk.Context, epoch uint64) uint64 {
+	total, err := k.epochTotalTransactions.Get(ctx, epoch)
+	if err != nil {
+		return 0
+	}
+	return total
+}
+
+// SetEpochTransactionCount stores the transactions an address made in an
+// epoch.
+func (k Keeper) SetEpochTransactionCount(ctx sdk.Context, count types.EpochTransactionCount) error {
+	addr, err := k.accAddress(count.Address)
+	if err != nil {
+		return err
+	}
+	return k.epochTransactionCounts.Set(ctx, collections.Join(count.Epoch, addr), count.Count)
+}
+
+// GetAllEpochTransactionCounts returns the transaction counts per address of
+// every retained epoch.
+func (k Keeper) GetAllEpochTransactionCounts(ctx sdk.Context) (list []types.EpochTransactionCount, err error) {
+	err = k.epochTransactionCounts.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.AccAddress], count uint64) (bool, error) {
+		address, err := k.addressString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.EpochTransactionCount{Epoch: key.K1(), Address: address, Count: count})
+		return false, nil
+	})
+	return list, err
+}
+
+// SetEpochTotalTransactions stores the network transactions of an epoch.
+func (k Keeper) SetEpochTotalTransactions(ctx sdk.Context, total types.EpochContribution) error {
+	return k.epochTotalTransactions.Set(ctx, total.Epoch, total.Count)
+}
+
+// GetAllEpochTotalTransactions returns the network transactions of every
+// retained epoch.
+func (k Keeper) GetAllEpochTotalTransactions(ctx sdk.Context) (list []types.EpochContribution, err error) {
+	err = k.epochTotalTransactions.Walk(ctx, nil, func(epoch, count uint64) (bool, error) {
+		list = append(list, types.EpochContribution{Epoch: epoch, Count: count})
+		return false, nil
+	})
+	return list, err
+}
+
+// GetEpochContributions returns the epochs between start and end, both
+// included, in which the address made transactions, and the sum over them.
+// The range is limited to the retained epochs.
+func (k Keeper) GetEpochContributions(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	start, end uint64,
+) (contributions []types.EpochContribution, total uint64, err error) {
+	oldest, err := k.OldestRetainedEpoch(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	current, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	start, end = max(start, oldest), min(end, current)
+	for epoch := start; epoch <= end; epoch++ {
+		if count := k.GetEpochTransactionCount(ctx, addr, epoch); count > 0 {
+			contributions = append(contributions, types.EpochContribution{Epoch: epoch, Count: count})
+			total += count
+		}
+	}
+	return contributions, total, nil
+}
+
+// GetEpochTotals returns the network transactions of the epochs between start
+// and end, both included, that had any, and the sum over them. The range is
+// limited to the retained epochs.
+func (k Keeper) GetEpochTotals(ctx sdk.Context, start, end uint64) (totals []types.EpochContribution, total uint64, err error) {
+	oldest, err := k.OldestRetainedEpoch(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	ranger := new(collections.Range[uint64]).StartInclusive(max(start, oldest)).EndInclusive(end)
+	err = k.epochTotalTransactions.Walk(ctx, ranger, func(epoch, count uint64) (bool, error) {
+		totals = append(totals, types.EpochContribution{Epoch: epoch, Count: count})
+		total += count
+		return false, nil
+	})
+	return totals, total, err
+}
+
+// ---------------------- RETENTION ----------------------
+
+// OldestRetainedEpoch returns the oldest epoch whose contribution history is
+// kept under the contribution_retention_epochs param.
+func (k Keeper) OldestRetainedEpoch(ctx sdk.Context) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if params.ContributionRetentionEpochs == 0 || epoch < params.ContributionRetentionEpochs {
+		return 0, nil
+	}
+	return epoch - params.ContributionRetentionEpochs + 1, nil
+}
+
+// PruneContributionHistory removes the per-epoch transaction counts of the
+// epochs that fell out of the retention window. Lifetime counters are kept.
+func (k Keeper) PruneContributionHistory(ctx sdk.Context) error {
+	oldest, err := k.OldestRetainedEpoch(ctx)
+	if err != nil || oldest == 0 {
+		return err
+	}
+
+	if err := k.epochTotalTransactions.Clear(ctx, new(collections.Range[uint64]).EndExclusive(oldest)); err != nil {
+		return errorsmod.Wrap(err, "failed to prune epoch total transactions")
+	}
+	ranger := collections.NewPrefixUntilPairRange[uint64, sdk.AccAddress](oldest - 1)
+	if err := k.epochTransactionCounts.Clear(ctx, ranger); err != nil {
+		return errorsmod.Wrap(err, "failed to prune epoch transaction counts")
+	}
+	rewardRanger := collections.NewPrefixUntilPairRange[uint64, string](oldest - 1)
+	if err := k.epochRewards.Clear(ctx, rewardRanger); err != nil {
+		return errorsmod.Wrap(err, "failed to prune epoch rewards")
+	}
+	if err := k.epochParticipations.Clear(ctx, ranger); err != nil {
+		return errorsmod.Wrap(err, "failed to prune epoch participation")
+	}
+	return nil
+}
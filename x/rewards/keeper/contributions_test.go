@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/types"
+)
+
+func TestEpochContributions(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.ContributionRetentionEpochs = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	alice, bob := wallets[0], wallets[1]
+
+	// alice: epoch 0 once, epoch 1 twice, epoch 3 once; bob: epoch 1 once.
+	for _, height := range []int64{5, 15, 19, 35} {
+		require.NoError(t, k.IncrementTransactionCount(ctx.WithBlockHeight(height), alice))
+	}
+	require.NoError(t, k.IncrementTransactionCount(ctx.WithBlockHeight(12), bob))
+
+	ctx = ctx.WithBlockHeight(38)
+	res, err := k.EpochContributions(ctx, &types.QueryEpochContributionsRequest{Address: alice.String(), StartEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.EpochContribution{{Epoch: 1, Count: 2}, {Epoch: 3, Count: 1}}, res.Contributions)
+	require.Equal(t, uint64(3), res.Total)
+
+	// Epoch 0 is outside the retention window even before it is pruned.
+	res, err = k.EpochContributions(ctx, &types.QueryEpochContributionsRequest{Address: alice.String(), EndEpoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.EpochContribution{{Epoch: 1, Count: 2}}, res.Contributions)
+
+	totals, err := k.EpochTotals(ctx, &types.QueryEpochTotalsRequest{StartEpoch: 1, EndEpoch: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.EpochContribution{{Epoch: 1, Count: 3}}, totals.Totals)
+	require.Equal(t, uint64(3), totals.Total)
+
+	_, err = k.EpochTotals(ctx, &types.QueryEpochTotalsRequest{StartEpoch: 2, EndEpoch: 1})
+	require.Error(t, err)
+
+	// At epoch 4 only epochs 2 to 4 are retained.
+	ctx = ctx.WithBlockHeight(40)
+	require.NoError(t, k.PruneContributionHistory(ctx))
+	require.Zero(t, k.GetEpochTransactionCount(ctx, alice, 1))
+	require.Zero(t, k.GetEpochTransactionCount(ctx, bob, 1))
+	require.Zero(t, k.GetEpochTotalTransactions(ctx, 1))
+	require.Equal(t, uint64(1), k.GetEpochTransactionCount(ctx, alice, 3))
+
+	totals, err = k.EpochTotals(ctx, &types.QueryEpochTotalsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.EpochContribution{{Epoch: 3, Count: 1}}, totals.Totals)
+
+	// Lifetime counters are not pruned.
+	require.Equal(t, uint64(4), k.GetTransactionCount(ctx, alice))
+	require.Equal(t, uint64(5), k.GetTotalTransactions(ctx))
+}
@@ -0,0 +1,361 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- ELECTION CANDIDATES ----------------------
+
+// SetCandidate stores an election candidate.
+func (k Keeper) SetCandidate(ctx sdk.Context, candidate types.Candidate) error {
+	addr, err := k.accAddress(candidate.Address)
+	if err != nil {
+		return err
+	}
+	if err := k.candidates.Set(ctx, addr, candidate); err != nil {
+		return errorsmod.Wrapf(err, "failed to store candidate %s", addr)
+	}
+	return nil
+}
+
+// GetCandidate returns an election candidate by address.
+func (k Keeper) GetCandidate(ctx sdk.Context, addr sdk.AccAddress) (types.Candidate, error) {
+	candidate, err := k.candidates.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		addrStr, err := k.addressString(addr)
+		if err != nil {
+			return candidate, err
+		}
+		return candidate, errorsmod.Wrap(types.ErrCandidateNotFound, addrStr)
+	}
+	if err != nil {
+		return candidate, errorsmod.Wrapf(err, "failed to retrieve candidate %s", addr)
+	}
+	return candidate, nil
+}
+
+// IsCandidate reports whether the address is registered for elections.
+func (k Keeper) IsCandidate(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
+	found, err := k.candidates.Has(ctx, addr)
+	if err != nil {
+		return false, errorsmod.Wrapf(err, "failed to retrieve candidate %s", addr)
+	}
+	return found, nil
+}
+
+// RemoveCandidate removes an election candidate.
+func (k Keeper) RemoveCandidate(ctx sdk.Context, addr sdk.AccAddress) error {
+	if err := k.candidates.Remove(ctx, addr); err != nil {
+		return errorsmod.Wrapf(err, "failed to remove candidate %s", addr)
+	}
+	return nil
+}
+
+// GetAllCandidates returns every election candidate.
+func (k Keeper) GetAllCandidates(ctx sdk.Context) (list []types.Candidate, err error) {
+	err = k.candidates.Walk(ctx, nil, func(_ sdk.AccAddress, candidate types.Candidate) (bool, error) {
+		list = append(list, candidate)
+		return false, nil
+	})
+	return list, err
+}
+
+// RegisterCandidate registers an address for governance wallet elections.
+func (k Keeper) RegisterCandidate(ctx sdk.Context, addr sdk.AccAddress) error {
+	candidate, err := k.addressString(addr)
+	if err != nil {
+		return err
+	}
+	found, err := k.IsCandidate(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errorsmod.Wrap(types.ErrAlreadyCandidate, candidate)
+	}
+	if k.IsBlockedAddress(ctx, addr) {
+		return errorsmod.Wrap(types.ErrBlockedAddress, candidate)
+	}
+	if err := k.SetCandidate(ctx, types.NewCandidate(candidate, ctx.BlockHeight())); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCandidate,
+			sdk.NewAttribute(types.AttributeKeyCandidate, candidate),
+		),
+	)
+	return nil
+}
+
+// WithdrawCandidacy removes an address from the election candidates. A
+// sitting governance wallet keeps its seat until the next election.
+func (k Keeper) WithdrawCandidacy(ctx sdk.Context, addr sdk.AccAddress) error {
+	candidate, err := k.addressString(addr)
+	if err != nil {
+		return err
+	}
+	found, err := k.IsCandidate(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorsmod.Wrap(types.ErrCandidateNotFound, candidate)
+	}
+	if err := k.RemoveCandidate(ctx, addr); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawCandidacy,
+			sdk.NewAttribute(types.AttributeKeyCandidate, candidate),
+		),
+	)
+	return nil
+}
+
+// ---------------------- COUNCIL HISTORY ----------------------
+
+// GetCouncilCount returns the term of the latest elected council.
+func (k Keeper) GetCouncilCount(ctx sdk.Context) (uint64, error) {
+	term, err := k.councilSeq.Peek(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "failed to retrieve council sequence")
+	}
+	return term, nil
+}
+
+// SetCouncil stores an elected council in the history and moves the council
+// sequence up to its term.
+func (k Keeper) SetCouncil(ctx sdk.Context, council types.Council) error {
+	if err := k.councils.Set(ctx, council.Term, council); err != nil {
+		return errorsmod.Wrapf(err, "failed to store council %d", council.Term)
+	}
+
+	latest, err := k.GetCouncilCount(ctx)
+	if err != nil {
+		return err
+	}
+	if council.Term > latest {
+		return k.councilSeq.Set(ctx, council.Term)
+	}
+	return nil
+}
+
+// GetCouncil returns the council elected in a term.
+func (k Keeper) GetCouncil(ctx sdk.Context, term uint64) (types.Council, error) {
+	council, err := k.councils.Get(ctx, term)
+	if errors.Is(err, collections.ErrNotFound) {
+		return council, errorsmod.Wrapf(types.ErrCouncilNotFound, "term %d", term)
+	}
+	if err != nil {
+		return council, errorsmod.Wrapf(err, "failed to retrieve council %d", term)
+	}
+	return council, nil
+}
+
+// GetAllCouncils returns every elected council, oldest first.
+func (k Keeper) GetAllCouncils(ctx sdk.Context) (list []types.Council, err error) {
+	err = k.councils.Walk(ctx, nil, func(_ uint64, council types.Council) (bool, error) {
+		list = append(list, council)
+		return false, nil
+	})
+	return list, err
+}
+
+// consecutiveTerms returns the number of councils in a row, ending with the
+// latest one, that the address sat on.
+func (k Keeper) consecutiveTerms(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	member, err := k.addressString(addr)
+	if err != nil {
+		return 0, err
+	}
+	latest, err := k.GetCouncilCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var terms uint64
+	for term := latest; term > 0; term-- {
+		council, err := k.GetCouncil(ctx, term)
+		if errors.Is(err, types.ErrCouncilNotFound) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		if !council.HasMember(member) {
+			break
+		}
+		terms++
+	}
+	return terms, nil
+}
+
+// ---------------------- ELECTIONS ----------------------
+
+// ElectionDue reports whether elections are enabled and the current block
+// opens an epoch in which an election is held.
+func (k Keeper) ElectionDue(ctx sdk.Context) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	if params.ElectionInterval == 0 || ctx.BlockHeight() <= 0 {
+		return false, nil
+	}
+	if uint64(ctx.BlockHeight())%params.EpochLength != 0 {
+		return false, nil
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return false, err
+	}
+	return epoch%params.ElectionInterval == 0, nil
+}
+
+// ProcessElection holds a governance wallet election when one is due. A
+// failed election is logged and skipped, leaving the governance wallets in
+// place, instead of failing EndBlock.
+func (k Keeper) ProcessElection(ctx sdk.Context) error {
+	due, err := k.ElectionDue(ctx)
+	if err != nil || !due {
+		return err
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, _, err := k.ElectCouncil(cacheCtx); err != nil {
+		k.Logger().Error("Skipping failed governance wallet election", "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeElectionFailed,
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+		return nil
+	}
+	write()
+	return nil
+}
+
+// ElectCouncil replaces the governance wallets with the eligible candidates
+// of the highest effective contribution, the same lock boosted contribution
+// rewards and voting weights use. A candidate is eligible when it holds at
+// least the minimum EGV balance, has a positive contribution, has not
+// reached the consecutive term limit and is not a blocked address. With fewer
+// eligible candidates than min_governance_set_size the current governance
+// wallets stay in place and no council is recorded.
+func (k Keeper) ElectCouncil(ctx sdk.Context) (council types.Council, elected bool, err error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return council, false, err
+	}
+	minBalance, err := params.GetMinCandidateBalanceAsInt()
+	if err != nil {
+		return council, false, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return council, false, err
+	}
+
+	type contender struct {
+		addr         sdk.AccAddress
+		contribution math.LegacyDec
+	}
+	candidates, err := k.GetAllCandidates(ctx)
+	if err != nil {
+		return council, false, err
+	}
+	var contenders []contender
+	for _, candidate := range candidates {
+		addr, err := k.accAddress(candidate.Address)
+		if err != nil || k.IsBlockedAddress(ctx, addr) {
+			continue
+		}
+		if k.bankKeeper.GetBalance(ctx, addr, params.RewardDenom).Amount.LT(minBalance) {
+			continue
+		}
+		if params.MaxConsecutiveTerms > 0 {
+			terms, err := k.consecutiveTerms(ctx, addr)
+			if err != nil {
+				return council, false, err
+			}
+			if terms >= params.MaxConsecutiveTerms {
+				continue
+			}
+		}
+		contribution, err := k.GetEffectiveContribution(ctx, addr)
+		if err != nil {
+			return council, false, err
+		}
+		if !contribution.IsPositive() {
+			continue
+		}
+		contenders = append(contenders, contender{addr: addr, contribution: contribution})
+	}
+
+	if uint64(len(contenders)) < params.MinGovernanceSetSize {
+		k.Logger().Info("Not enough eligible candidates, keeping governance wallets", "eligible", len(contenders))
+		return council, false, nil
+	}
+
+	sort.Slice(contenders, func(i, j int) bool {
+		if !contenders[i].contribution.Equal(contenders[j].contribution) {
+			return contenders[i].contribution.GT(contenders[j].contribution)
+		}
+		return bytes.Compare(contenders[i].addr, contenders[j].addr) < 0
+	})
+	if uint64(len(contenders)) > params.CouncilSize {
+		contenders = contenders[:params.CouncilSize]
+	}
+
+	latest, err := k.GetCouncilCount(ctx)
+	if err != nil {
+		return council, false, err
+	}
+	council = types.Council{
+		Term:   latest + 1,
+		Epoch:  epoch,
+		Height: ctx.BlockHeight(),
+	}
+	for _, c := range contenders {
+		member, err := k.addressString(c.addr)
+		if err != nil {
+			return council, false, err
+		}
+		council.Members = append(council.Members, member)
+	}
+
+	params.PredefinedWallets = council.Members
+	if err := k.SetParams(ctx, params); err != nil {
+		return council, false, err
+	}
+	if err := k.SetCouncil(ctx, council); err != nil {
+		return council, false, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCouncilElected,
+			sdk.NewAttribute(types.AttributeKeyTerm, strconv.FormatUint(council.Term, 10)),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(council.Epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(council.Members, ",")),
+		),
+	)
+
+	k.Logger().Info("Governance wallets elected", "term", council.Term, "members", len(council.Members))
+	return council, true, nil
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/types"
+)
+
+func TestCouncilElection(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.ElectionInterval = 2
+	params.CouncilSize = 2
+	params.MinCandidateBalance = "100"
+	params.MaxConsecutiveTerms = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	a := sdk.AccAddress([]byte("candidate_a_________"))
+	b := sdk.AccAddress([]byte("candidate_b_________"))
+	c := sdk.AccAddress([]byte("candidate_c_________"))
+	d := sdk.AccAddress([]byte("candidate_d_________"))
+
+	stake := sdk.NewCoins(sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(100)))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, stake.MulInt(math.NewInt(3))))
+	for i, addr := range []sdk.AccAddress{a, b, c, d} {
+		_, err := ms.RegisterCandidate(ctx, &types.MsgRegisterCandidate{Candidate: addr.String()})
+		require.NoError(t, err)
+		// d never holds the minimum balance.
+		if addr.Equals(d) {
+			continue
+		}
+		require.NoError(t, k.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, stake))
+		for n := 0; n < 5-i; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, addr))
+		}
+	}
+	require.NoError(t, k.IncrementTransactionCount(ctx, d))
+
+	_, err := ms.RegisterCandidate(ctx, &types.MsgRegisterCandidate{Candidate: a.String()})
+	require.ErrorIs(t, err, types.ErrAlreadyCandidate)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	_, err = ms.RegisterCandidate(ctx, &types.MsgRegisterCandidate{Candidate: feeCollector.String()})
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	// Elections only run at the first block of every second epoch.
+	requireElectionDue(t, false, k, ctx.WithBlockHeight(10))
+	requireElectionDue(t, false, k, ctx.WithBlockHeight(21))
+	requireElectionDue(t, true, k, ctx.WithBlockHeight(20))
+
+	require.NoError(t, k.ProcessElection(ctx.WithBlockHeight(20)))
+	require.Equal(t, []string{a.String(), b.String()}, getParams(t, k, ctx).PredefinedWallets)
+
+	// a and b reached the term limit and sit out; d lacks the balance.
+	require.NoError(t, k.ProcessElection(ctx.WithBlockHeight(40)))
+	require.Equal(t, []string{c.String()}, getParams(t, k, ctx).PredefinedWallets)
+
+	require.NoError(t, k.ProcessElection(ctx.WithBlockHeight(60)))
+	require.Equal(t, []string{a.String(), b.String()}, getParams(t, k, ctx).PredefinedWallets)
+
+	councils, err := k.Councils(ctx, &types.QueryCouncilsRequest{})
+	require.NoError(t, err)
+	require.Len(t, councils.Councils, 3)
+	council, err := k.Council(ctx, &types.QueryCouncilRequest{Term: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), council.Council.Epoch)
+	require.Equal(t, []string{c.String()}, council.Council.Members)
+
+	// Without eligible candidates the governance wallets stay in place.
+	for _, addr := range []sdk.AccAddress{a, b, c, d} {
+		_, err := ms.WithdrawCandidacy(ctx, &types.MsgWithdrawCandidacy{Candidate: addr.String()})
+		require.NoError(t, err)
+	}
+	_, err = ms.WithdrawCandidacy(ctx, &types.MsgWithdrawCandidacy{Candidate: a.String()})
+	require.ErrorIs(t, err, types.ErrCandidateNotFound)
+	require.NoError(t, k.ProcessElection(ctx.WithBlockHeight(80)))
+	require.Equal(t, []string{a.String(), b.String()}, getParams(t, k, ctx).PredefinedWallets)
+	term, err := k.GetCouncilCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), term)
+}
+
+func TestElectionRanksByEffectiveContribution(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	setLockBoost(t, k, ctx, "3")
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.ElectionInterval = 1
+	params.CouncilSize = 1
+	params.MinCandidateBalance = "100"
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// x sent more transactions, but y's lock triples its contribution.
+	x := sdk.AccAddress([]byte("candidate_x_________"))
+	y := sdk.AccAddress([]byte("candidate_y_________"))
+	fundAccount(t, k, ctx, x, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 100)))
+	fundAccount(t, k, ctx, y, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1100)))
+	_, err := ms.LockEGV(ctx, types.NewMsgLockEGV(y.String(), math.NewInt(1000)))
+	require.NoError(t, err)
+	for i, addr := range []sdk.AccAddress{x, y} {
+		_, err := ms.RegisterCandidate(ctx, &types.MsgRegisterCandidate{Candidate: addr.String()})
+		require.NoError(t, err)
+		for n := 0; n < 3-i; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, addr))
+		}
+	}
+
+	require.NoError(t, k.ProcessElection(ctx.WithBlockHeight(10)))
+	require.Equal(t, []string{y.String()}, getParams(t, k, ctx).PredefinedWallets)
+}
+
+func TestFailedElectionIsSkipped(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.ElectionInterval = 1
+	params.MinCandidateBalance = "0"
+	require.NoError(t, k.SetParams(ctx, params))
+
+	a := sdk.AccAddress([]byte("candidate_a_________"))
+	_, err := ms.RegisterCandidate(ctx, &types.MsgRegisterCandidate{Candidate: a.String()})
+	require.NoError(t, err)
+	require.NoError(t, k.IncrementTransactionCount(ctx, a))
+
+	// An undecodable candidate record makes the election fail.
+	b := sdk.AccAddress([]byte("candidate_b_________"))
+	rewardsStore(t, ctx).Set(append(types.CandidateKeyPrefix.Bytes(), b...), []byte{0xff})
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ProcessElection(ctx))
+	require.Equal(t, params.PredefinedWallets, getParams(t, k, ctx).PredefinedWallets)
+	term, err := k.GetCouncilCount(ctx)
+	require.NoError(t, err)
+	require.Zero(t, term)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeElectionFailed, ctx.EventManager().Events()[0].Type)
+}
+
+// rewardsStore returns the raw rewards store of a test keeper's context.
+func rewardsStore(t *testing.T, ctx sdk.Context) storetypes.KVStore {
+	t.Helper()
+	ms, ok := ctx.MultiStore().(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	require.True(t, ok)
+	return ctx.KVStore(ms.StoreKeysByName()[types.StoreKey])
+}
+
+func requireElectionDue(t *testing.T, expected bool, k keeper.Keeper, ctx sdk.Context) {
+	t.Helper()
+	due, err := k.ElectionDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, due)
+}
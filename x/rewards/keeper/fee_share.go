@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- FEE SHARE ----------------------
+
+// CollectFeeShare moves fee_share of every denom in the fee collector balance
+// into the rewards pool module account. It runs in BeginBlock ahead of
+// x/mint, so the balance holds only the fees of the previous block and not
+// the staking inflation, and ahead of x/distribution, which allocates
+// whatever is left.
+func (k Keeper) CollectFeeShare(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	feeShare, err := params.GetFeeShareAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid fee share: %s", err)
+	}
+	if !feeShare.IsPositive() {
+		return nil
+	}
+
+	feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	share := types.ShareOfCoins(k.bankKeeper.GetAllBalances(ctx, feeCollector), feeShare)
+	if share.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.RewardsModuleName, share); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCollectFeeShare,
+			sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/types"
+)
+
+func TestFeeShare(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	ctx = ctx.WithBlockHeight(9)
+
+	params := getParams(t, k, ctx)
+	params.FeeShare = "0.5"
+	params.EpochLength = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Fees collected in two denoms.
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("uatom", 101))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, fees))
+	require.NoError(t, k.GetBankKeeper().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fees))
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	for i, count := range []int{3, 1} {
+		for n := 0; n < count; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, wallets[i]))
+		}
+	}
+
+	// Half of every fee denom moves into the rewards pool; mid-epoch nothing is paid out.
+	require.NoError(t, k.CollectFeeShare(ctx))
+	require.NoError(t, k.DistributeEpochRewards(ctx))
+	expectedPool := sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("uatom", 50))
+	require.Equal(t, expectedPool, k.GetRewardPool(ctx))
+	feeCollector := k.GetAccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, fees.Sub(expectedPool...), k.GetBankKeeper().GetAllBalances(ctx, feeCollector))
+
+	// At the epoch boundary the pool is split by contribution share.
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.DistributeEpochRewards(ctx))
+	require.Equal(t, math.NewInt(375), k.GetBankKeeper().GetBalance(ctx, wallets[0], "stake").Amount)
+	require.Equal(t, math.NewInt(37), k.GetBankKeeper().GetBalance(ctx, wallets[0], "uatom").Amount)
+	require.Equal(t, math.NewInt(125), k.GetBankKeeper().GetBalance(ctx, wallets[1], "stake").Amount)
+	require.Equal(t, math.NewInt(12), k.GetBankKeeper().GetBalance(ctx, wallets[1], "uatom").Amount)
+
+	// Rounding dust stays in the pool.
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), k.GetRewardPool(ctx))
+}
+
+func TestFeeShareDisabled(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, fees))
+	require.NoError(t, k.GetBankKeeper().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fees))
+
+	require.NoError(t, k.CollectFeeShare(ctx))
+	require.True(t, k.GetRewardPool(ctx).IsZero())
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks records the x/gov votes of governance wallets and checks their
+// participation when a proposal's voting period ends.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the x/gov hooks of the rewards module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+func (h Hooks) AfterProposalSubmission(context.Context, uint64) error { return nil }
+
+func (h Hooks) AfterProposalDeposit(context.Context, uint64, sdk.AccAddress) error { return nil }
+
+func (h Hooks) AfterProposalVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error {
+	return h.k.RecordGovernanceVote(sdk.UnwrapSDKContext(ctx), proposalID, voterAddr)
+}
+
+func (h Hooks) AfterProposalFailedMinDeposit(context.Context, uint64) error { return nil }
+
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	return h.k.ProcessProposalParticipation(sdk.UnwrapSDKContext(ctx), proposalID)
+}
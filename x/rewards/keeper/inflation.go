@@ -0,0 +1,337 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- EPOCHS ----------------------
+
+// GetCurrentEpoch returns the epoch the current block belongs to.
+func (k Keeper) GetCurrentEpoch(ctx sdk.Context) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(ctx.BlockHeight()) / params.EpochLength, nil
+}
+
+// NextEpochBoundary returns the first block height of the next epoch.
+func (k Keeper) NextEpochBoundary(ctx sdk.Context) (int64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	epochLength := int64(params.EpochLength)
+	return (ctx.BlockHeight()/epochLength + 1) * epochLength, nil
+}
+
+// ---------------------- PENDING INFLATION CHANGE ----------------------
+
+// SetPendingInflationChange stores the inflation rate change waiting for activation.
+func (k Keeper) SetPendingInflationChange(ctx sdk.Context, change types.PendingInflationChange) error {
+	if err := k.pendingInflationChange.Set(ctx, change); err != nil {
+		return errorsmod.Wrap(err, "failed to store pending inflation change")
+	}
+	return nil
+}
+
+// GetPendingInflationChange returns the scheduled inflation rate change, if any.
+func (k Keeper) GetPendingInflationChange(ctx sdk.Context) (change types.PendingInflationChange, found bool, err error) {
+	change, err = k.pendingInflationChange.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return change, false, nil
+	}
+	if err != nil {
+		return change, false, errorsmod.Wrap(err, "failed to retrieve pending inflation change")
+	}
+	return change, true, nil
+}
+
+// ClearPendingInflationChange removes the scheduled inflation rate change.
+func (k Keeper) ClearPendingInflationChange(ctx sdk.Context) error {
+	return k.pendingInflationChange.Remove(ctx)
+}
+
+// ValidateParamsUpdate checks governance supplied params against the
+// currently active params without storing them.
+func (k Keeper) ValidateParamsUpdate(ctx sdk.Context, params types.Params) error {
+	current, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	currentRate, err := current.GetInflationRateAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid active inflation rate: %s", err)
+	}
+	newRate, err := params.GetInflationRateAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid inflation rate: %s", err)
+	}
+
+	if !newRate.Equal(currentRate) {
+		if err := checkInflationChange(current, params, currentRate, newRate); err != nil {
+			return err
+		}
+	}
+	if err := k.checkEffectiveInflationChange(ctx, current, params); err != nil {
+		return err
+	}
+
+	if err := checkInflationGuardrails(current, params); err != nil {
+		return err
+	}
+
+	if params.RewardDenom != current.RewardDenom {
+		return errorsmod.Wrapf(
+			types.ErrRewardDenomImmutable,
+			"cannot change reward denom from %s to %s", current.RewardDenom, params.RewardDenom,
+		)
+	}
+	// Epoch numbers, halving and decay schedules and vesting all count in
+	// epochs of the genesis length.
+	if params.EpochLength != current.EpochLength {
+		return errorsmod.Wrapf(
+			types.ErrEpochLengthImmutable,
+			"cannot change epoch length from %d to %d", current.EpochLength, params.EpochLength,
+		)
+	}
+
+	if err := checkGovernanceSetChange(current, params); err != nil {
+		return err
+	}
+	if err := k.checkBlockedWallets(ctx, params.PredefinedWallets); err != nil {
+		return err
+	}
+
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	// The active rate and schedule stay in place until a pending change
+	// activates.
+	held := params.WithInflationSchedule(current)
+	held.InflationRate = current.InflationRate
+	if err := held.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+// UpdateParams stores governance supplied params. A change of the inflation
+// rate or schedule is not applied immediately: it is checked against the
+// currently active guardrails and scheduled for the next epoch boundary.
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params) error {
+	if err := k.ValidateParamsUpdate(ctx, params); err != nil {
+		return err
+	}
+
+	current, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	currentRate, _ := current.GetInflationRateAsDec()
+	newRate, _ := params.GetInflationRateAsDec()
+	scheduled := params
+	scheduled.InflationRate = newRate.String()
+
+	params = params.WithInflationSchedule(current)
+	params.InflationRate = current.InflationRate
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	if newRate.Equal(currentRate) && scheduled.SameInflationSchedule(current) {
+		// Restating the active rate and schedule supersedes any previously
+		// scheduled change.
+		return k.ClearPendingInflationChange(ctx)
+	}
+
+	activationHeight, err := k.NextEpochBoundary(ctx)
+	if err != nil {
+		return err
+	}
+	change := types.NewPendingInflationChange(scheduled, activationHeight)
+	if err := k.SetPendingInflationChange(ctx, change); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInflationChangeScheduled,
+			sdk.NewAttribute(types.AttributeKeyPreviousInflationRate, currentRate.String()),
+			sdk.NewAttribute(types.AttributeKeyInflationRate, change.InflationRate),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(change.ActivationHeight, 10)),
+		),
+	)
+
+	return nil
+}
+
+// checkInflationChange checks the step from the active rate against the
+// guardrails of the active params and the bounds of the new params.
+func checkInflationChange(current, params types.Params, currentRate, newRate math.LegacyDec) error {
+	maxChange, err := current.GetMaxInflationRateChangeAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid active max inflation rate change: %s", err)
+	}
+	if newRate.Sub(currentRate).Abs().GT(maxChange) {
+		return errorsmod.Wrapf(
+			types.ErrInflationChangeTooLarge,
+			"change from %s to %s exceeds %s", currentRate, newRate, maxChange,
+		)
+	}
+	if err := params.ValidateInflationBounds(newRate.String()); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidInflationBounds, err.Error())
+	}
+	return nil
+}
+
+// checkEffectiveInflationChange checks the step in the effective inflation
+// rate of the current epoch and of the epoch the change activates in. A new
+// schedule can move the effective rate even when inflation_rate stays put.
+func (k Keeper) checkEffectiveInflationChange(ctx sdk.Context, current, params types.Params) error {
+	maxChange, err := current.GetMaxInflationRateChangeAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid active max inflation rate change: %s", err)
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	for _, e := range []uint64{epoch, epoch + 1} {
+		currentRate, err := current.InflationRateAt(e)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "invalid active inflation schedule: %s", err)
+		}
+		newRate, err := params.InflationRateAt(e)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "invalid inflation schedule: %s", err)
+		}
+		if newRate.Sub(currentRate).Abs().GT(maxChange) {
+			return errorsmod.Wrapf(
+				types.ErrInflationChangeTooLarge,
+				"effective rate change in epoch %d from %s to %s exceeds %s", e, currentRate, newRate, maxChange,
+			)
+		}
+	}
+	return nil
+}
+
+// checkInflationGuardrails applies the step limit to the guardrails
+// themselves: the min/max inflation bounds and max_inflation_rate_change
+// each move by at most the active max_inflation_rate_change per update, up or
+// down.
+func checkInflationGuardrails(current, params types.Params) error {
+	maxChange, err := current.GetMaxInflationRateChangeAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid active max inflation rate change: %s", err)
+	}
+	newMaxChange, err := params.GetMaxInflationRateChangeAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid max inflation rate change: %s", err)
+	}
+	if newMaxChange.Sub(maxChange).Abs().GT(maxChange) {
+		return errorsmod.Wrapf(
+			types.ErrInflationGuardrail,
+			"max inflation rate change from %s to %s exceeds %s", maxChange, newMaxChange, maxChange,
+		)
+	}
+
+	bounds := []struct {
+		name             string
+		current, updated string
+	}{
+		{"min inflation rate", current.MinInflationRate, params.MinInflationRate},
+		{"max inflation rate", current.MaxInflationRate, params.MaxInflationRate},
+	}
+	for _, bound := range bounds {
+		currentBound, err := math.LegacyNewDecFromStr(bound.current)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "invalid active %s: %s", bound.name, err)
+		}
+		updatedBound, err := math.LegacyNewDecFromStr(bound.updated)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "invalid %s: %s", bound.name, err)
+		}
+		if updatedBound.Sub(currentBound).Abs().GT(maxChange) {
+			return errorsmod.Wrapf(
+				types.ErrInflationGuardrail,
+				"%s change from %s to %s exceeds %s", bound.name, currentBound, updatedBound, maxChange,
+			)
+		}
+	}
+	return nil
+}
+
+// checkGovernanceSetChange ensures an update replaces at most the
+// max_governance_set_change fraction of the active governance wallets.
+func checkGovernanceSetChange(current, params types.Params) error {
+	if len(current.PredefinedWallets) == 0 {
+		return nil
+	}
+	maxChange, err := current.GetMaxGovernanceSetChangeAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid active max governance set change: %s", err)
+	}
+	changes, err := types.GovernanceSetChanges(current.PredefinedWallets, params.PredefinedWallets)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	limit := maxChange.MulInt64(int64(len(current.PredefinedWallets)))
+	if math.LegacyNewDecFromInt(math.NewIntFromUint64(changes)).GT(limit) {
+		return errorsmod.Wrapf(
+			types.ErrGovernanceSetChange,
+			"replacing %d of %d governance wallets exceeds %s",
+			changes, len(current.PredefinedWallets), maxChange,
+		)
+	}
+	return nil
+}
+
+// ApplyPendingInflationChange activates the scheduled inflation rate change
+// once its activation height has been reached.
+func (k Keeper) ApplyPendingInflationChange(ctx sdk.Context) error {
+	change, found, err := k.GetPendingInflationChange(ctx)
+	if err != nil || !found || ctx.BlockHeight() < change.ActivationHeight {
+		return err
+	}
+
+	if err := k.ClearPendingInflationChange(ctx); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	previousRate := params.InflationRate
+	params = change.ApplyTo(params)
+	if err := params.Validate(); err != nil {
+		// The bounds moved after the change was scheduled; drop it rather than halt.
+		k.Logger().Error("Dropping pending inflation change", "rate", change.InflationRate, "error", err)
+		return nil
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return fmt.Errorf("failed to apply pending inflation change: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInflationChangeApplied,
+			sdk.NewAttribute(types.AttributeKeyPreviousInflationRate, previousRate),
+			sdk.NewAttribute(types.AttributeKeyInflationRate, change.InflationRate),
+		),
+	)
+
+	k.Logger().Info("Inflation rate updated", "previous", previousRate, "current", change.InflationRate)
+	return nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/testutil/sample"
+	"zenoda/x/rewards/types"
+)
+
+func TestInflationChangeActivatesAtEpochBoundary(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	params := sample.RewardsParams()
+	params.EpochLength = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(13)
+	params.InflationRate = "0.06"
+	require.NoError(t, k.UpdateParams(ctx, params))
+
+	// The active rate is unchanged until the next epoch boundary.
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.05"), getInflationRate(t, k, ctx))
+	change, found, err := k.GetPendingInflationChange(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(20), change.ActivationHeight)
+
+	response, err := k.PendingInflationChange(ctx, &types.QueryPendingInflationChangeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, change, response.PendingChange)
+
+	require.NoError(t, k.ApplyPendingInflationChange(ctx.WithBlockHeight(19)))
+	_, found, err = k.GetPendingInflationChange(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.ApplyPendingInflationChange(ctx))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.06"), getInflationRate(t, k, ctx))
+	_, found, err = k.GetPendingInflationChange(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestRestatingActiveInflationClearsPendingChange(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	params := sample.RewardsParams()
+
+	scheduled := params
+	scheduled.InflationRate = "0.04"
+	require.NoError(t, k.UpdateParams(ctx, scheduled))
+	_, found, err := k.GetPendingInflationChange(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	require.NoError(t, k.UpdateParams(ctx, params))
+	_, found, err = k.GetPendingInflationChange(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = k.PendingInflationChange(ctx, &types.QueryPendingInflationChangeRequest{})
+	require.Error(t, err)
+}
+
+func TestInflationScheduleChangeIsStepLimited(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	params := sample.RewardsParams()
+	params.EpochLength = 10
+	require.NoError(t, k.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(13)
+
+	// A stepwise schedule must not jump past max_inflation_rate_change while
+	// inflation_rate stays put, now or from the next epoch.
+	jump := params
+	jump.InflationSchedule = types.InflationScheduleStepwise
+	jump.InflationSteps = []types.InflationStep{{StartEpoch: 0, InflationRate: "0.20"}}
+	require.ErrorIs(t, k.UpdateParams(ctx, jump), types.ErrInflationChangeTooLarge)
+	jump.InflationSteps = []types.InflationStep{{StartEpoch: 0, InflationRate: "0.05"}, {StartEpoch: 2, InflationRate: "0.20"}}
+	require.ErrorIs(t, k.UpdateParams(ctx, jump), types.ErrInflationChangeTooLarge)
+
+	// A schedule change within the limit waits for the next epoch boundary
+	// like a rate change.
+	step := params
+	step.InflationSchedule = types.InflationScheduleStepwise
+	step.InflationSteps = []types.InflationStep{{StartEpoch: 0, InflationRate: "0.06"}}
+	require.NoError(t, k.UpdateParams(ctx, step))
+	require.Equal(t, types.InflationScheduleConstant, getParams(t, k, ctx).InflationSchedule)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.05"), getInflationRate(t, k, ctx))
+	change, found, err := k.GetPendingInflationChange(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, types.NewPendingInflationChange(step, 20), change)
+
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.ApplyPendingInflationChange(ctx))
+	require.True(t, getParams(t, k, ctx).SameInflationSchedule(step))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.06"), getInflationRate(t, k, ctx))
+}
+
+func TestCurrentInflation(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.InflationSchedule = types.InflationScheduleHalving
+	params.InflationHalvingEpochs = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Epoch 5 is past two halvings.
+	ctx = ctx.WithBlockHeight(55)
+	res, err := k.CurrentInflation(ctx, &types.QueryCurrentInflationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCurrentInflationResponse{
+		Schedule:          types.InflationScheduleHalving,
+		Epoch:             5,
+		InflationRate:     "0.012500000000000000",
+		BaseInflationRate: "0.050000000000000000",
+	}, res)
+}
@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	math "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,6 +28,47 @@ type (
 		authority     string
 		bankKeeper    types.BankKeeper
 		accountKeeper types.AccountKeeper
+		govQuery      types.GovQueryServer
+		addressCodec  address.Codec
+
+		Schema            collections.Schema
+		params            collections.Item[types.Params]
+		transactionCounts collections.Map[sdk.AccAddress, uint64]
+		totalTransactions collections.Item[uint64]
+		totalSupply       collections.Item[sdk.Coin]
+
+		epochTransactionCounts  collections.Map[collections.Pair[uint64, sdk.AccAddress], uint64]
+		epochTotalTransactions  collections.Map[uint64, uint64]
+		contributionScores      collections.Map[sdk.AccAddress, types.ContributionScore]
+		totalContributionScore  collections.Item[types.ContributionScore]
+		rewardWithdrawAddresses collections.Map[sdk.AccAddress, sdk.AccAddress]
+		vestingPositions        collections.Map[collections.Pair[sdk.AccAddress, uint64], types.VestingPosition]
+		vestingPositionSeq      collections.Sequence
+		epochRewards            collections.Map[collections.Pair[uint64, string], math.Int]
+		addressRewards          collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+		campaigns               collections.Map[uint64, types.Campaign]
+		campaignSeq             collections.Sequence
+		campaignContributions   collections.Map[collections.Pair[uint64, sdk.AccAddress], uint64]
+		activeCampaigns         collections.KeySet[collections.Pair[uint64, uint64]]
+		burnedSupply            collections.Item[math.Int]
+		participations          collections.Map[sdk.AccAddress, types.Participation]
+		epochParticipations     collections.Map[collections.Pair[uint64, sdk.AccAddress], types.EpochParticipation]
+		proposalVotes           collections.KeySet[collections.Pair[uint64, sdk.AccAddress]]
+		locks                   collections.Map[sdk.AccAddress, math.Int]
+		unlocks                 collections.Map[collections.Pair[sdk.AccAddress, uint64], types.EGVUnlock]
+		unlockSeq               collections.Sequence
+		unlockQueue             collections.KeySet[collections.Triple[int64, sdk.AccAddress, uint64]]
+		lockBonus               collections.Item[math.LegacyDec]
+		lockScoreBonus          collections.Item[types.ContributionScore]
+		governanceSetRemovals   collections.Item[types.GovernanceSetRemovals]
+		pendingInflationChange  collections.Item[types.PendingInflationChange]
+		queuedParamChanges      collections.Map[uint64, types.QueuedParamChange]
+		queuedParamChangeSeq    collections.Sequence
+		votingDelegations       collections.Map[sdk.AccAddress, types.VotingDelegation]
+		delegatorsOf            collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+		candidates              collections.Map[sdk.AccAddress, types.Candidate]
+		councils                collections.Map[uint64, types.Council]
+		councilSeq              collections.Sequence
 	}
 )
 
@@ -32,19 +79,129 @@ func NewKeeper(
 	authority string,
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	govQuery types.GovQueryServer,
 ) Keeper {
-	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+	addressCodec := accountKeeper.AddressCodec()
+	if _, err := addressCodec.StringToBytes(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
 		cdc:           cdc,
 		storeService:  storeService,
 		authority:     authority,
 		logger:        logger,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		govQuery:      govQuery,
+		addressCodec:  addressCodec,
+
+		params:            collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		transactionCounts: collections.NewMap(sb, types.TransactionCountKeyPrefix, "transaction_counts", sdk.AccAddressKey, collections.Uint64Value),
+		totalTransactions: collections.NewItem(sb, types.TotalTransactionsKey, "total_transactions", collections.Uint64Value),
+		totalSupply:       collections.NewItem(sb, types.TotalSupplyKey, "total_supply", codec.CollValue[sdk.Coin](cdc)),
+
+		epochTransactionCounts: collections.NewMap(
+			sb, types.EpochTransactionCountKeyPrefix, "epoch_transaction_counts",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), collections.Uint64Value,
+		),
+		epochTotalTransactions: collections.NewMap(sb, types.EpochTotalTransactionsKeyPrefix, "epoch_total_transactions", collections.Uint64Key, collections.Uint64Value),
+		contributionScores:     collections.NewMap(sb, types.ContributionScoreKeyPrefix, "contribution_scores", sdk.AccAddressKey, codec.CollValue[types.ContributionScore](cdc)),
+		totalContributionScore: collections.NewItem(sb, types.TotalContributionScoreKey, "total_contribution_score", codec.CollValue[types.ContributionScore](cdc)),
+		rewardWithdrawAddresses: collections.NewMap(
+			sb, types.RewardWithdrawAddressKeyPrefix, "reward_withdraw_addresses",
+			sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.AccAddressKey),
+		),
+		vestingPositions: collections.NewMap(
+			sb, types.VestingPositionKeyPrefix, "vesting_positions",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.VestingPosition](cdc),
+		),
+		vestingPositionSeq: collections.NewSequence(sb, types.VestingPositionSequenceKey, "vesting_position_sequence"),
+		epochRewards: collections.NewMap(
+			sb, types.EpochRewardKeyPrefix, "epoch_rewards",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), sdk.IntValue,
+		),
+		addressRewards: collections.NewMap(
+			sb, types.AddressRewardKeyPrefix, "address_rewards",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), sdk.IntValue,
+		),
+		campaigns:   collections.NewMap(sb, types.CampaignKeyPrefix, "campaigns", collections.Uint64Key, codec.CollValue[types.Campaign](cdc)),
+		campaignSeq: collections.NewSequence(sb, types.CampaignSequenceKey, "campaign_sequence"),
+		campaignContributions: collections.NewMap(
+			sb, types.CampaignContributionKeyPrefix, "campaign_contributions",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), collections.Uint64Value,
+		),
+		activeCampaigns: collections.NewKeySet(
+			sb, types.ActiveCampaignKeyPrefix, "active_campaigns",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		burnedSupply: collections.NewItem(sb, types.BurnedSupplyKey, "burned_supply", sdk.IntValue),
+		participations: collections.NewMap(
+			sb, types.ParticipationKeyPrefix, "participations",
+			sdk.AccAddressKey, codec.CollValue[types.Participation](cdc),
+		),
+		epochParticipations: collections.NewMap(
+			sb, types.EpochParticipationKeyPrefix, "epoch_participations",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[types.EpochParticipation](cdc),
+		),
+		proposalVotes: collections.NewKeySet(
+			sb, types.ProposalVoteKeyPrefix, "proposal_votes",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+		),
+		locks: collections.NewMap(sb, types.LockKeyPrefix, "locks", sdk.AccAddressKey, sdk.IntValue),
+		unlocks: collections.NewMap(
+			sb, types.UnlockKeyPrefix, "unlocks",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.EGVUnlock](cdc),
+		),
+		unlockSeq: collections.NewSequence(sb, types.UnlockSequenceKey, "unlock_sequence"),
+		unlockQueue: collections.NewKeySet(
+			sb, types.UnlockQueueKeyPrefix, "unlock_queue",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.AccAddressKey, collections.Uint64Key),
+		),
+		lockBonus: collections.NewItem(sb, types.LockBonusKey, "lock_bonus", sdk.LegacyDecValue),
+		lockScoreBonus: collections.NewItem(
+			sb, types.LockScoreBonusKey, "lock_score_bonus", codec.CollValue[types.ContributionScore](cdc),
+		),
+		governanceSetRemovals: collections.NewItem(
+			sb, types.GovernanceSetRemovalsKey, "governance_set_removals",
+			codec.CollValue[types.GovernanceSetRemovals](cdc),
+		),
+		pendingInflationChange: collections.NewItem(
+			sb, types.PendingInflationChangeKey, "pending_inflation_change",
+			codec.CollValue[types.PendingInflationChange](cdc),
+		),
+		queuedParamChanges: collections.NewMap(
+			sb, types.QueuedParamChangeKeyPrefix, "queued_param_changes",
+			collections.Uint64Key, codec.CollValue[types.QueuedParamChange](cdc),
+		),
+		queuedParamChangeSeq: collections.NewSequence(sb, types.QueuedParamChangeSequenceKey, "queued_param_change_sequence"),
+		votingDelegations: collections.NewMap(
+			sb, types.VotingDelegationKeyPrefix, "voting_delegations",
+			sdk.AccAddressKey, codec.CollValue[types.VotingDelegation](cdc),
+		),
+		delegatorsOf: collections.NewKeySet(
+			sb, types.VotingDelegationByDelegateeKeyPrefix, "delegators_of",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+		candidates: collections.NewMap(
+			sb, types.CandidateKeyPrefix, "candidates",
+			sdk.AccAddressKey, codec.CollValue[types.Candidate](cdc),
+		),
+		councils: collections.NewMap(
+			sb, types.CouncilKeyPrefix, "councils",
+			collections.Uint64Key, codec.CollValue[types.Council](cdc),
+		),
+		councilSeq: collections.NewSequence(sb, types.CouncilSequenceKey, "council_sequence"),
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
 }
 
 // ---------------------- CORE UTILS ----------------------
@@ -69,77 +226,130 @@ func (k Keeper) GetAccountKeeper() types.AccountKeeper {
 	return k.accountKeeper
 }
 
+// AddressCodec returns the chain's account address codec.
+func (k Keeper) AddressCodec() address.Codec {
+	return k.addressCodec
+}
+
+// accAddress decodes an account address with the chain's address codec.
+func (k Keeper) accAddress(addr string) (sdk.AccAddress, error) {
+	return k.addressCodec.StringToBytes(addr)
+}
+
+// addressString encodes an account address with the chain's address codec.
+func (k Keeper) addressString(addr sdk.AccAddress) (string, error) {
+	return k.addressCodec.BytesToString(addr)
+}
+
 // SetTotalSupply stores the total supply of EGV tokens
-func (k Keeper) SetTotalSupply(ctx sdk.Context, supply sdk.Coin) {
-	store := k.storeService.OpenKVStore(ctx)
-	bz, err := k.cdc.Marshal(&supply)
-	if err != nil {
-		panic(fmt.Sprintf("failed to marshal total supply: %s", err))
+func (k Keeper) SetTotalSupply(ctx sdk.Context, supply sdk.Coin) error {
+	if err := k.totalSupply.Set(ctx, supply); err != nil {
+		return errorsmod.Wrap(err, "failed to store total supply")
 	}
-	_ = store.Set([]byte(types.TotalSupplyKey), bz)
+	return nil
 }
 
 // ---------------------- PARAMETER ACCESS ----------------------
 
 // GetParams fetches the module's parameters.
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	store := k.storeService.OpenKVStore(ctx)
-	var params types.Params
-	bz, err := store.Get([]byte(types.ParamsKey))
-	if err != nil || bz == nil {
-		panic("failed to retrieve params from store")
+func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	params, err := k.params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return params, types.ErrParamsNotFound
+	}
+	if err != nil {
+		return params, errorsmod.Wrap(err, "failed to retrieve params from store")
 	}
-	k.cdc.MustUnmarshal(bz, &params)
-	return params
+	return params, nil
 }
 
 // ---------------------- ADDRESS MANAGEMENT ----------------------
 
+// IsBlockedAddress reports whether the address is blocked from receiving
+// funds by the bank module or belongs to a module account. Such addresses
+// cannot act as governance wallets.
+func (k Keeper) IsBlockedAddress(ctx context.Context, addr sdk.AccAddress) bool {
+	if k.bankKeeper.BlockedAddr(addr) {
+		return true
+	}
+	_, isModuleAccount := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return isModuleAccount
+}
+
 // GetPredefinedAddresses fetches the predefined governance addresses from the module parameters.
-func (k Keeper) GetPredefinedAddresses(ctx sdk.Context) []sdk.AccAddress {
-	params := k.GetParams(ctx)
+func (k Keeper) GetPredefinedAddresses(ctx sdk.Context) ([]sdk.AccAddress, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
 	var addresses []sdk.AccAddress
 
 	for _, addrStr := range params.PredefinedWallets {
-		accAddr, err := sdk.AccAddressFromBech32(addrStr)
+		accAddr, err := k.addressCodec.StringToBytes(addrStr)
 		if err != nil {
 			ctx.Logger().Error("Invalid predefined address", "address", addrStr, "error", err)
 			continue
 		}
-		addresses = append(addresses, accAddr)
+		addresses = append(addresses, sdk.AccAddress(accAddr))
 	}
-	return addresses
+	return addresses, nil
 }
 
 // ---------------------- TRANSACTION COUNT TRACKING ----------------------
 
-// Increment transaction count for a given address
-func (k Keeper) IncrementTransactionCount(ctx sdk.Context, addr sdk.AccAddress) {
-	store := k.storeService.OpenKVStore(ctx)
-
-	// Check if the address is part of the predefined set
-	predefinedAddresses := k.GetPredefinedAddresses(ctx)
-	if !k.isPredefinedAddress(addr, predefinedAddresses) {
-		return
+// tracksTransactions reports whether the transactions of an address are
+// counted. Only governance wallets and election candidates have a transaction
+// count, so only they carry contribution-weighted voting weight.
+func (k Keeper) tracksTransactions(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
+	predefinedAddresses, err := k.GetPredefinedAddresses(ctx)
+	if err != nil {
+		return false, err
 	}
+	if k.isPredefinedAddress(addr, predefinedAddresses) {
+		return true, nil
+	}
+	return k.IsCandidate(ctx, addr)
+}
 
-	addrKey := append([]byte(types.TransactionCountKey), addr.Bytes()...)
+// Increment transaction count for a given address
+func (k Keeper) IncrementTransactionCount(ctx sdk.Context, addr sdk.AccAddress) error {
+	// Check if the address is part of the predefined set or stands for election
+	tracked, err := k.tracksTransactions(ctx, addr)
+	if err != nil || !tracked {
+		return err
+	}
 
 	// Get current count
-	bz, err := store.Get(addrKey)
-	var count uint64
-	if err == nil && bz != nil {
-		count = sdk.BigEndianToUint64(bz)
+	count, err := k.transactionCounts.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(err, "failed to retrieve transaction count of %s", addr)
 	}
 
 	// Increment count
 	count++
 
 	// Store updated count
-	_ = store.Set(addrKey, sdk.Uint64ToBigEndian(count))
+	if err := k.transactionCounts.Set(ctx, addr, count); err != nil {
+		return errorsmod.Wrapf(err, "failed to store transaction count of %s", addr)
+	}
+
+	// Record the transaction in the current epoch's history
+	if err := k.incrementEpochTransactionCount(ctx, addr); err != nil {
+		return err
+	}
+
+	// Add the transaction to the decayed contribution scores
+	if err := k.addContributionScore(ctx, addr); err != nil {
+		return err
+	}
+
+	// Add what the lock boost makes of the transaction to the boosted totals
+	if err := k.addLockedTransaction(ctx, addr); err != nil {
+		return err
+	}
 
 	// Increment total network transactions (includes all network addresses)
-	k.IncrementTotalTransactions(ctx)
+	return k.IncrementTotalTransactions(ctx)
 }
 
 // Helper function to check if an address is predefined
@@ -154,98 +364,131 @@ func (k Keeper) isPredefinedAddress(addr sdk.AccAddress, predefined []sdk.AccAdd
 
 // Get transaction count for an address
 func (k Keeper) GetTransactionCount(ctx sdk.Context, addr sdk.AccAddress) uint64 {
-	store := k.storeService.OpenKVStore(ctx)
-	addrKey := append([]byte(types.TransactionCountKey), addr.Bytes()...)
-
-	bz, err := store.Get(addrKey)
-	if err != nil || bz == nil {
+	count, err := k.transactionCounts.Get(ctx, addr)
+	if err != nil {
 		return 0 // Default to 0 if not found
 	}
-	return sdk.BigEndianToUint64(bz)
+	return count
+}
+
+// SetTransactionCount stores the lifetime transaction count of an address.
+func (k Keeper) SetTransactionCount(ctx sdk.Context, count types.TransactionCount) error {
+	addr, err := k.accAddress(count.Address)
+	if err != nil {
+		return err
+	}
+	return k.transactionCounts.Set(ctx, addr, count.Count)
+}
+
+// GetAllTransactionCounts returns the lifetime transaction count of every
+// address.
+func (k Keeper) GetAllTransactionCounts(ctx sdk.Context) (list []types.TransactionCount, err error) {
+	err = k.transactionCounts.Walk(ctx, nil, func(addr sdk.AccAddress, count uint64) (bool, error) {
+		address, err := k.addressString(addr)
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.TransactionCount{Address: address, Count: count})
+		return false, nil
+	})
+	return list, err
 }
 
 // ---------------------- TOTAL TRANSACTION TRACKING ----------------------
 
 // Increment total transaction count in the network
-func (k Keeper) IncrementTotalTransactions(ctx sdk.Context) {
-	store := k.storeService.OpenKVStore(ctx)
-	totalTxKey := []byte(types.TotalTxKey)
-
+func (k Keeper) IncrementTotalTransactions(ctx sdk.Context) error {
 	// Get current count
-	bz, err := store.Get(totalTxKey)
-	var total uint64
-	if err == nil && bz != nil {
-		total = sdk.BigEndianToUint64(bz)
+	total, err := k.totalTransactions.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(err, "failed to retrieve total transactions")
 	}
 
 	// Increment count
 	total++
 
 	// Store updated total transactions
-	_ = store.Set(totalTxKey, sdk.Uint64ToBigEndian(total))
+	if err := k.totalTransactions.Set(ctx, total); err != nil {
+		return errorsmod.Wrap(err, "failed to store total transactions")
+	}
+	return k.incrementEpochTotalTransactions(ctx)
 }
 
 // Get total transactions in the network
 func (k Keeper) GetTotalTransactions(ctx sdk.Context) uint64 {
-	store := k.storeService.OpenKVStore(ctx)
-	totalTxKey := []byte(types.TotalTxKey)
-
-	bz, err := store.Get(totalTxKey)
-	if err != nil || bz == nil {
+	total, err := k.totalTransactions.Get(ctx)
+	if err != nil {
 		return 0 // Default to 0 if not found
 	}
-	return sdk.BigEndianToUint64(bz)
+	return total
+}
+
+// SetTotalTransactions stores the lifetime transaction count of the network.
+func (k Keeper) SetTotalTransactions(ctx sdk.Context, total uint64) error {
+	return k.totalTransactions.Set(ctx, total)
 }
 
 // ---------------------- EGV SUPPLY AND INFLATION ----------------------
 
+// GetRewardDenom returns the reward_denom param, the denom of the EGV token.
+// DefaultRewardDenom is returned before the params are set.
+func (k Keeper) GetRewardDenom(ctx context.Context) string {
+	params, err := k.params.Get(ctx)
+	if err != nil || params.RewardDenom == "" {
+		return types.DefaultRewardDenom
+	}
+	return params.RewardDenom
+}
+
 // GetTotalSupply returns the total supply of EGV tokens
 func (k Keeper) GetTotalSupply(ctx sdk.Context) sdk.Coin {
-	return k.bankKeeper.GetSupply(ctx, types.EGVDenom)
+	return k.bankKeeper.GetSupply(ctx, k.GetRewardDenom(ctx))
 }
 
-// GetInflationRate returns the current inflation rate for EGV
-func (k Keeper) GetInflationRate(ctx sdk.Context) math.LegacyDec {
-	params := k.GetParams(ctx)
-	inflationRate, err := params.GetInflationRateAsDec()
+// GetInflationRate returns the current inflation rate for EGV: the rate of
+// the current epoch under the inflation schedule.
+func (k Keeper) GetInflationRate(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
 	if err != nil {
-		panic(fmt.Sprintf("invalid inflation rate stored in params: %s", err))
+		return math.LegacyDec{}, err
 	}
-	return inflationRate
+	inflationRate, err := params.InflationRateAt(epoch)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidParams, "invalid inflation schedule stored in params: %s", err)
+	}
+	return inflationRate, nil
 }
 
 // Lock setter: Sets a lock in the KV store.
-func (k Keeper) SetLock(ctx sdk.Context, lockKey string) {
+func (k Keeper) SetLock(ctx sdk.Context, lockKey string) error {
 	store := k.storeService.OpenKVStore(ctx)
-	err := store.Set([]byte(lockKey), []byte("locked"))
-	if err != nil {
-		// Handle error (log it or return if you want to fail early)
-		k.Logger().Error("Error setting lock", "error", err)
-		panic("Failed to set lock")
+	if err := store.Set([]byte(lockKey), []byte("locked")); err != nil {
+		return errorsmod.Wrapf(types.ErrLockFailed, "set %s: %s", lockKey, err)
 	}
+	return nil
 }
 
 // Lock checker: Checks if the lock is set.
-func (k Keeper) IsLockSet(ctx sdk.Context, lockKey string) bool {
+func (k Keeper) IsLockSet(ctx sdk.Context, lockKey string) (bool, error) {
 	store := k.storeService.OpenKVStore(ctx)
 	bz, err := store.Get([]byte(lockKey))
 	if err != nil {
-		// Handle error (log it or return false if you want to allow continuing)
-		k.Logger().Error("Error retrieving lock", "error", err)
-		return false
+		return false, errorsmod.Wrapf(types.ErrLockFailed, "get %s: %s", lockKey, err)
 	}
-	return bz != nil && string(bz) == "locked"
+	return bz != nil && string(bz) == "locked", nil
 }
 
 // Clear lock: Removes the lock after operation is complete.
-func (k Keeper) ClearLock(ctx sdk.Context, lockKey string) {
+func (k Keeper) ClearLock(ctx sdk.Context, lockKey string) error {
 	store := k.storeService.OpenKVStore(ctx)
-	err := store.Delete([]byte(lockKey))
-	if err != nil {
-		// Handle error (log it or return if you want to fail early)
-		k.Logger().Error("Error clearing lock", "error", err)
-		panic("Failed to clear lock")
+	if err := store.Delete([]byte(lockKey)); err != nil {
+		return errorsmod.Wrapf(types.ErrLockFailed, "clear %s: %s", lockKey, err)
 	}
+	return nil
 }
 
 // func (k Keeper) MintEGV(ctx sdk.Context, amount sdk.Coin) error {
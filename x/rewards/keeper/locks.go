@@ -0,0 +1,500 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- EGV LOCKS ----------------------
+
+// GetLocked returns the EGV an address has locked, zero if none.
+func (k Keeper) GetLocked(ctx sdk.Context, addr sdk.AccAddress) (math.Int, error) {
+	locked, err := k.locks.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.Int{}, errorsmod.Wrapf(err, "failed to retrieve lock of %s", addr)
+	}
+	return locked, nil
+}
+
+// setLocked stores the EGV an address has locked, removing the lock when
+// nothing is left, and moves the lock bonus by what the new boost changes.
+func (k Keeper) setLocked(ctx sdk.Context, addr sdk.AccAddress, locked math.Int) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	previous, err := k.GetLocked(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if err := k.moveLockBonus(ctx, params, addr, previous, locked); err != nil {
+		return err
+	}
+
+	if locked.IsZero() {
+		return k.locks.Remove(ctx, addr)
+	}
+	if err := k.locks.Set(ctx, addr, locked); err != nil {
+		return errorsmod.Wrapf(err, "failed to store lock of %s", addr)
+	}
+	return nil
+}
+
+// SetEGVLock stores an EGV lock.
+func (k Keeper) SetEGVLock(ctx sdk.Context, lock types.EGVLock) error {
+	addr, err := k.accAddress(lock.Address)
+	if err != nil {
+		return err
+	}
+	amount, ok := math.NewIntFromString(lock.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInsufficientLocked, "invalid amount %q", lock.Amount)
+	}
+	return k.setLocked(ctx, addr, amount)
+}
+
+// GetAllEGVLocks returns the EGV locked by every address.
+func (k Keeper) GetAllEGVLocks(ctx sdk.Context) (list []types.EGVLock, err error) {
+	err = k.locks.Walk(ctx, nil, func(addr sdk.AccAddress, locked math.Int) (bool, error) {
+		owner, err := k.addressString(addr)
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.NewEGVLock(owner, locked))
+		return false, nil
+	})
+	return list, err
+}
+
+// SetEGVUnlock stores an EGV unlock, queues it under its completion height
+// and moves the unlock id sequence past its id.
+func (k Keeper) SetEGVUnlock(ctx sdk.Context, unlock types.EGVUnlock) error {
+	addr, err := k.accAddress(unlock.Address)
+	if err != nil {
+		return err
+	}
+	if err := k.unlocks.Set(ctx, collections.Join(addr, unlock.Id), unlock); err != nil {
+		return errorsmod.Wrapf(err, "failed to store unlock %d", unlock.Id)
+	}
+	if err := k.unlockQueue.Set(ctx, collections.Join3(unlock.CompletionHeight, addr, unlock.Id)); err != nil {
+		return errorsmod.Wrapf(err, "failed to queue unlock %d", unlock.Id)
+	}
+
+	next, err := k.unlockSeq.Peek(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to retrieve unlock sequence")
+	}
+	if unlock.Id >= next {
+		return k.unlockSeq.Set(ctx, unlock.Id+1)
+	}
+	return nil
+}
+
+// GetEGVUnlocks returns the pending unlocks of an address.
+func (k Keeper) GetEGVUnlocks(ctx sdk.Context, addr sdk.AccAddress) (list []types.EGVUnlock, err error) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr)
+	err = k.unlocks.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, uint64], unlock types.EGVUnlock) (bool, error) {
+		list = append(list, unlock)
+		return false, nil
+	})
+	return list, err
+}
+
+// GetAllEGVUnlocks returns every pending unlock.
+func (k Keeper) GetAllEGVUnlocks(ctx sdk.Context) (list []types.EGVUnlock, err error) {
+	err = k.unlocks.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], unlock types.EGVUnlock) (bool, error) {
+		list = append(list, unlock)
+		return false, nil
+	})
+	return list, err
+}
+
+// LockEGV moves EGV from the owner to the lock module account and adds it to
+// the owner's lock. It returns the owner's locked EGV in total.
+func (k Keeper) LockEGV(ctx sdk.Context, owner sdk.AccAddress, amount math.Int) (math.Int, error) {
+	if !amount.IsPositive() {
+		return math.Int{}, errorsmod.Wrapf(types.ErrInsufficientLocked, "lock amount %s must be positive", amount)
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	locked, err := k.GetLocked(ctx, owner)
+	if err != nil {
+		return math.Int{}, err
+	}
+	if err := params.ValidateLockAmount(locked.Add(amount)); err != nil {
+		return math.Int{}, errorsmod.Wrap(types.ErrLockBelowMinimum, err.Error())
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, owner, types.LockModuleName, sdk.NewCoins(sdk.NewCoin(k.GetRewardDenom(ctx), amount)),
+	)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	locked = locked.Add(amount)
+	if err := k.setLocked(ctx, owner, locked); err != nil {
+		return math.Int{}, err
+	}
+	ownerStr, err := k.addressString(owner)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockEGV,
+			sdk.NewAttribute(types.AttributeKeyAddress, ownerStr),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLocked, locked.String()),
+		),
+	)
+	return locked, nil
+}
+
+// UnlockEGV takes EGV out of the owner's lock. It stops boosting at once and
+// stays in the lock module account until lock_unbonding_period blocks have
+// passed.
+func (k Keeper) UnlockEGV(ctx sdk.Context, owner sdk.AccAddress, amount math.Int) (types.EGVUnlock, error) {
+	ownerStr, err := k.addressString(owner)
+	if err != nil {
+		return types.EGVUnlock{}, err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.EGVUnlock{}, err
+	}
+	locked, err := k.GetLocked(ctx, owner)
+	if err != nil {
+		return types.EGVUnlock{}, err
+	}
+	if !amount.IsPositive() || amount.GT(locked) {
+		return types.EGVUnlock{}, errorsmod.Wrapf(types.ErrInsufficientLocked, "cannot unlock %s of %s locked by %s", amount, locked, ownerStr)
+	}
+	if err := params.ValidateLockAmount(locked.Sub(amount)); err != nil {
+		return types.EGVUnlock{}, errorsmod.Wrap(types.ErrLockBelowMinimum, err.Error())
+	}
+	if err := k.setLocked(ctx, owner, locked.Sub(amount)); err != nil {
+		return types.EGVUnlock{}, err
+	}
+
+	id, err := k.unlockSeq.Next(ctx)
+	if err != nil {
+		return types.EGVUnlock{}, errorsmod.Wrap(err, "failed to retrieve unlock sequence")
+	}
+	unlock := types.NewEGVUnlock(id, ownerStr, amount, ctx.BlockHeight()+int64(params.LockUnbondingPeriod))
+	if err := k.SetEGVUnlock(ctx, unlock); err != nil {
+		return types.EGVUnlock{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnlockEGV,
+			sdk.NewAttribute(types.AttributeKeyAddress, ownerStr),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCompletionHeight, strconv.FormatInt(unlock.CompletionHeight, 10)),
+		),
+	)
+	return unlock, nil
+}
+
+// CompleteUnlocks returns the EGV of every unlock that has reached its
+// completion height to its owner. Only the unlock queue up to the current
+// height is visited, so pending unlocks cost nothing until they mature.
+func (k Keeper) CompleteUnlocks(ctx sdk.Context) error {
+	var matured []collections.Triple[int64, sdk.AccAddress, uint64]
+	rng := collections.NewPrefixUntilTripleRange[int64, sdk.AccAddress, uint64](ctx.BlockHeight())
+	err := k.unlockQueue.Walk(ctx, rng, func(key collections.Triple[int64, sdk.AccAddress, uint64]) (bool, error) {
+		matured = append(matured, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	denom := k.GetRewardDenom(ctx)
+	for _, key := range matured {
+		addr, id := key.K2(), key.K3()
+		unlock, err := k.unlocks.Get(ctx, collections.Join(addr, id))
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to retrieve unlock %d", id)
+		}
+		amount, ok := math.NewIntFromString(unlock.Amount)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInsufficientLocked, "unlock %d: invalid amount %q", unlock.Id, unlock.Amount)
+		}
+		if err := k.unlocks.Remove(ctx, collections.Join(addr, id)); err != nil {
+			return errorsmod.Wrapf(err, "failed to remove unlock %d", unlock.Id)
+		}
+		if err := k.unlockQueue.Remove(ctx, key); err != nil {
+			return errorsmod.Wrapf(err, "failed to dequeue unlock %d", unlock.Id)
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LockModuleName, addr, sdk.NewCoins(sdk.NewCoin(denom, amount)))
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnlock,
+				sdk.NewAttribute(types.AttributeKeyAddress, unlock.Address),
+				sdk.NewAttribute(types.AttributeKeyAmount, unlock.Amount),
+				sdk.NewAttribute(types.AttributeKeyUnlockID, strconv.FormatUint(unlock.Id, 10)),
+			),
+		)
+	}
+	return nil
+}
+
+// ---------------------- LOCK BOOST ----------------------
+
+// GetLockBoost returns the multiplier the EGV locked by an address applies to
+// its contribution, following the lock boost curve.
+func (k Keeper) GetLockBoost(ctx sdk.Context, addr sdk.AccAddress) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	locked, err := k.GetLocked(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	boost, err := params.LockBoost(locked)
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	return boost, nil
+}
+
+// GetEffectiveContribution returns the contribution of an address multiplied
+// by its lock boost. Rewards and voting weights are computed from it.
+func (k Keeper) GetEffectiveContribution(ctx sdk.Context, addr sdk.AccAddress) (math.LegacyDec, error) {
+	contribution, err := k.GetContribution(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !contribution.IsPositive() {
+		return contribution, nil
+	}
+	boost, err := k.GetLockBoost(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return contribution.Mul(boost), nil
+}
+
+// GetTotalEffectiveContribution returns the network counterpart of
+// GetEffectiveContribution: the total contribution plus the lock bonus, what
+// the lock boosts add to it.
+func (k Keeper) GetTotalEffectiveContribution(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	total, err := k.GetTotalContribution(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if params.ContributionScoreMode == types.ContributionScoreDecayed {
+		stored, err := k.lockScoreBonus.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return math.LegacyDec{}, errorsmod.Wrap(err, "failed to retrieve lock score bonus")
+		}
+		bonus, err := k.decayToCurrentEpoch(ctx, stored)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		return total.Add(bonus), nil
+	}
+	bonus, err := k.GetLockBonus(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return total.Add(bonus), nil
+}
+
+// ---------------------- LOCK BONUS ----------------------
+//
+// The lock bonus is the sum over all locks of contribution * (boost - 1). It
+// is kept as a running total for both contribution score modes, so rewards
+// never walk the locks: every boosted transaction adds boost - 1, a lock
+// change adds contribution * (new boost - old boost), and a change of the
+// lock boost params recomputes it. The decayed bonus decays like every score.
+
+// GetLockBonus returns the running lock bonus on lifetime transaction counts.
+func (k Keeper) GetLockBonus(ctx sdk.Context) (math.LegacyDec, error) {
+	bonus, found, err := k.GetStoredLockBonus(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !found {
+		return math.LegacyZeroDec(), nil
+	}
+	return bonus, nil
+}
+
+// GetStoredLockBonus returns the running lock bonus on lifetime transaction
+// counts and whether one was stored.
+func (k Keeper) GetStoredLockBonus(ctx sdk.Context) (math.LegacyDec, bool, error) {
+	bonus, err := k.lockBonus.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, false, nil
+	}
+	if err != nil {
+		return math.LegacyDec{}, false, errorsmod.Wrap(err, "failed to retrieve lock bonus")
+	}
+	return bonus, true, nil
+}
+
+// SetLockBonus stores the running lock bonus on lifetime transaction counts.
+func (k Keeper) SetLockBonus(ctx sdk.Context, bonus math.LegacyDec) error {
+	return k.lockBonus.Set(ctx, bonus)
+}
+
+// GetStoredLockScoreBonus returns the stored, undecayed lock bonus on decayed
+// scores and whether one was stored.
+func (k Keeper) GetStoredLockScoreBonus(ctx sdk.Context) (types.ContributionScore, bool, error) {
+	bonus, err := k.lockScoreBonus.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ContributionScore{}, false, nil
+	}
+	if err != nil {
+		return types.ContributionScore{}, false, errorsmod.Wrap(err, "failed to retrieve lock score bonus")
+	}
+	return bonus, true, nil
+}
+
+// SetLockScoreBonus stores the lock bonus on decayed scores as of the epoch
+// it was last updated in.
+func (k Keeper) SetLockScoreBonus(ctx sdk.Context, bonus types.ContributionScore) error {
+	return k.lockScoreBonus.Set(ctx, bonus)
+}
+
+// addLockBonus adds to both running lock bonuses; deltas may be negative.
+func (k Keeper) addLockBonus(ctx sdk.Context, params types.Params, transactions, score math.LegacyDec) error {
+	bonus, err := k.GetLockBonus(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.lockBonus.Set(ctx, math.LegacyMaxDec(bonus.Add(transactions), math.LegacyZeroDec())); err != nil {
+		return errorsmod.Wrap(err, "failed to store lock bonus")
+	}
+
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	stored, err := k.lockScoreBonus.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(err, "failed to retrieve lock score bonus")
+	}
+	decayed, err := stored.DecayedTo(epoch, params.ScoreHalfLifeEpochs)
+	if err != nil {
+		return err
+	}
+	// Decay rounds differently than the scores it sums, so the bonus is
+	// kept from drifting below zero.
+	scoreBonus := math.LegacyMaxDec(decayed.Add(score), math.LegacyZeroDec())
+	if err := k.lockScoreBonus.Set(ctx, types.NewContributionScore(scoreBonus, epoch)); err != nil {
+		return errorsmod.Wrap(err, "failed to store lock score bonus")
+	}
+	return nil
+}
+
+// addLockedTransaction adds what the lock boost of an address adds to one of
+// its transactions to the lock bonus.
+func (k Keeper) addLockedTransaction(ctx sdk.Context, addr sdk.AccAddress) error {
+	locked, err := k.GetLocked(ctx, addr)
+	if err != nil || locked.IsZero() {
+		return err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	boost, err := params.LockBoost(locked)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	extra := boost.Sub(math.LegacyOneDec())
+	return k.addLockBonus(ctx, params, extra, extra)
+}
+
+// moveLockBonus moves the lock bonus by what changing the lock of an address
+// from previous to locked changes its boosted contribution.
+func (k Keeper) moveLockBonus(ctx sdk.Context, params types.Params, addr sdk.AccAddress, previous, locked math.Int) error {
+	previousBoost, err := params.LockBoost(previous)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	boost, err := params.LockBoost(locked)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	delta := boost.Sub(previousBoost)
+	if delta.IsZero() {
+		return nil
+	}
+
+	transactions := math.LegacyNewDecFromInt(math.NewIntFromUint64(k.GetTransactionCount(ctx, addr)))
+	score, err := k.GetContributionScore(ctx, addr)
+	if err != nil {
+		return err
+	}
+	return k.addLockBonus(ctx, params, transactions.Mul(delta), score.Mul(delta))
+}
+
+// computeLockBonus walks every lock and returns both lock bonuses from
+// scratch.
+func (k Keeper) computeLockBonus(ctx sdk.Context, params types.Params) (transactions, score math.LegacyDec, err error) {
+	transactions, score = math.LegacyZeroDec(), math.LegacyZeroDec()
+	err = k.locks.Walk(ctx, nil, func(addr sdk.AccAddress, locked math.Int) (bool, error) {
+		boost, err := params.LockBoost(locked)
+		if err != nil {
+			return true, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+		}
+		extra := boost.Sub(math.LegacyOneDec())
+		count := math.LegacyNewDecFromInt(math.NewIntFromUint64(k.GetTransactionCount(ctx, addr)))
+		addrScore, err := k.GetContributionScore(ctx, addr)
+		if err != nil {
+			return true, err
+		}
+		transactions = transactions.Add(count.Mul(extra))
+		score = score.Add(addrScore.Mul(extra))
+		return false, nil
+	})
+	return transactions, score, err
+}
+
+// resetLockBonus recomputes both lock bonuses after the lock boost params
+// changed. It walks every lock, but only runs on a params change.
+func (k Keeper) resetLockBonus(ctx sdk.Context, params types.Params) error {
+	transactions, score, err := k.computeLockBonus(ctx, params)
+	if err != nil {
+		return err
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.lockBonus.Set(ctx, transactions); err != nil {
+		return errorsmod.Wrap(err, "failed to store lock bonus")
+	}
+	if err := k.lockScoreBonus.Set(ctx, types.NewContributionScore(score, epoch)); err != nil {
+		return errorsmod.Wrap(err, "failed to store lock score bonus")
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/types"
+)
+
+// setLockBoost sets a linear lock boost reaching max at 1000 locked EGV and
+// a ten block unbonding period.
+func setLockBoost(t *testing.T, k keeper.Keeper, ctx sdk.Context, max string) {
+	t.Helper()
+	params := getParams(t, k, ctx)
+	params.LockUnbondingPeriod = 10
+	params.LockBoostCurve = types.LockBoostLinear
+	params.LockBoostMax = max
+	params.LockBoostSaturation = "1000"
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+func TestLockEGV(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(100)
+	setLockBoost(t, k, ctx, "3")
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	fundAccount(t, k, ctx, owner, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 2000)))
+	lockAddr := k.GetAccountKeeper().GetModuleAddress(types.LockModuleName)
+	escrow := keeper.LockEscrowInvariant(k)
+
+	lockRes, err := ms.LockEGV(ctx, types.NewMsgLockEGV(owner.String(), math.NewInt(500)))
+	require.NoError(t, err)
+	require.Equal(t, "500", lockRes.Locked)
+	require.Equal(t, math.NewInt(1500), k.GetBankKeeper().GetBalance(ctx, owner, types.DefaultRewardDenom).Amount)
+	require.Equal(t, math.NewInt(500), k.GetBankKeeper().GetBalance(ctx, lockAddr, types.DefaultRewardDenom).Amount)
+	_, broken := escrow(ctx)
+	require.False(t, broken)
+
+	_, err = ms.UnlockEGV(ctx, types.NewMsgUnlockEGV(owner.String(), math.NewInt(600)))
+	require.ErrorIs(t, err, types.ErrInsufficientLocked)
+
+	// The unlocked EGV stops boosting at once but stays in escrow.
+	unlockRes, err := ms.UnlockEGV(ctx, types.NewMsgUnlockEGV(owner.String(), math.NewInt(200)))
+	require.NoError(t, err)
+	require.Equal(t, int64(110), unlockRes.CompletionHeight)
+
+	res, err := k.Lock(ctx, &types.QueryLockRequest{Address: owner.String()})
+	require.NoError(t, err)
+	require.Equal(t, "300", res.Locked)
+	require.Equal(t, "1.600000000000000000", res.Boost)
+	require.Equal(t, []types.EGVUnlock{types.NewEGVUnlock(unlockRes.UnlockId, owner.String(), math.NewInt(200), 110)}, res.Unlocks)
+	locksRes, err := k.Locks(ctx, &types.QueryLocksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.EGVLock{types.NewEGVLock(owner.String(), math.NewInt(300))}, locksRes.Locks)
+	_, broken = escrow(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, k.CompleteUnlocks(ctx.WithBlockHeight(109)))
+	require.Equal(t, math.NewInt(1500), k.GetBankKeeper().GetBalance(ctx, owner, types.DefaultRewardDenom).Amount)
+
+	ctx = ctx.WithBlockHeight(110)
+	require.NoError(t, k.CompleteUnlocks(ctx))
+	require.Equal(t, math.NewInt(1700), k.GetBankKeeper().GetBalance(ctx, owner, types.DefaultRewardDenom).Amount)
+	require.Equal(t, math.NewInt(300), k.GetBankKeeper().GetBalance(ctx, lockAddr, types.DefaultRewardDenom).Amount)
+	unlocks, err := k.GetAllEGVUnlocks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, unlocks)
+	_, broken = escrow(ctx)
+	require.False(t, broken)
+
+	// Unlocking everything removes the lock.
+	_, err = ms.UnlockEGV(ctx, types.NewMsgUnlockEGV(owner.String(), math.NewInt(300)))
+	require.NoError(t, err)
+	locks, err := k.GetAllEGVLocks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, locks)
+
+	// EGV reaching the escrow outside of a lock breaks the invariant.
+	extra := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, extra))
+	require.NoError(t, k.GetBankKeeper().SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LockModuleName, extra))
+	_, broken = escrow(ctx)
+	require.True(t, broken)
+}
+
+func TestCompleteUnlocksByHeight(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(100)
+	setLockBoost(t, k, ctx, "3")
+
+	owner := sdk.AccAddress([]byte("owner_______________"))
+	fundAccount(t, k, ctx, owner, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1000)))
+	_, err := ms.LockEGV(ctx, types.NewMsgLockEGV(owner.String(), math.NewInt(1000)))
+	require.NoError(t, err)
+
+	first, err := ms.UnlockEGV(ctx, types.NewMsgUnlockEGV(owner.String(), math.NewInt(100)))
+	require.NoError(t, err)
+	second, err := ms.UnlockEGV(ctx.WithBlockHeight(105), types.NewMsgUnlockEGV(owner.String(), math.NewInt(200)))
+	require.NoError(t, err)
+	require.Equal(t, int64(115), second.CompletionHeight)
+
+	// Only the unlock queued at or below the current height completes.
+	ctx = ctx.WithBlockHeight(112)
+	require.NoError(t, k.CompleteUnlocks(ctx))
+	require.Equal(t, math.NewInt(100), k.GetBankKeeper().GetBalance(ctx, owner, types.DefaultRewardDenom).Amount)
+	unlocks, err := k.GetEGVUnlocks(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, []types.EGVUnlock{types.NewEGVUnlock(second.UnlockId, owner.String(), math.NewInt(200), 115)}, unlocks)
+	require.NotEqual(t, first.UnlockId, second.UnlockId)
+
+	// A completed unlock is dequeued and not paid out twice.
+	require.NoError(t, k.CompleteUnlocks(ctx.WithBlockHeight(115)))
+	require.NoError(t, k.CompleteUnlocks(ctx.WithBlockHeight(116)))
+	require.Equal(t, math.NewInt(300), k.GetBankKeeper().GetBalance(ctx, owner, types.DefaultRewardDenom).Amount)
+	unlocks, err = k.GetAllEGVUnlocks(ctx)
+	require.NoError(t, err)
+	require.Empty(t, unlocks)
+}
+
+func TestLockBoostedContribution(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	setLockBoost(t, k, ctx, "3")
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	for _, wallet := range wallets[:2] {
+		require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+	}
+	fundAccount(t, k, ctx, wallets[0], sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1000)))
+
+	// A saturated lock triples the first wallet's contribution: 3 of 4.
+	_, err := ms.LockEGV(ctx, types.NewMsgLockEGV(wallets[0].String(), math.NewInt(1000)))
+	require.NoError(t, err)
+
+	total, err := k.GetTotalEffectiveContribution(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(4), total)
+
+	res, err := k.EstimatedReward(ctx, &types.QueryEstimatedRewardRequest{Address: wallets[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, "0.750000000000000000", res.Share)
+	res, err = k.EstimatedReward(ctx, &types.QueryEstimatedRewardRequest{Address: wallets[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, "0.250000000000000000", res.Share)
+
+	weights, err := k.TallyVotingPower(ctx, wallets[:2])
+	require.NoError(t, err)
+	require.Equal(t, []math.LegacyDec{math.LegacyNewDec(3), math.LegacyOneDec()}, weights)
+
+	boost, err := k.GetLockBoost(ctx, wallets[0])
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(3), boost)
+
+	// Without a contribution there is nothing to boost.
+	contribution, err := k.GetEffectiveContribution(ctx, wallets[2])
+	require.NoError(t, err)
+	require.True(t, contribution.IsZero())
+}
+
+func TestLockBonus(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	setLockBoost(t, k, ctx, "3")
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.ScoreHalfLifeEpochs = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	for _, wallet := range []sdk.AccAddress{wallets[0], wallets[0], wallets[1]} {
+		require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+	}
+	fundAccount(t, k, ctx, wallets[0], sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1000)))
+	invariant := keeper.LockBonusInvariant(k)
+	requireTotal := func(expected string) {
+		t.Helper()
+		total, err := k.GetTotalEffectiveContribution(ctx)
+		require.NoError(t, err)
+		require.Equal(t, math.LegacyMustNewDecFromStr(expected), total)
+		_, broken := invariant(ctx)
+		require.False(t, broken)
+	}
+
+	// Locks must stay at or above min_lock_amount.
+	_, err := ms.LockEGV(ctx, types.NewMsgLockEGV(wallets[0].String(), math.NewInt(5)))
+	require.ErrorIs(t, err, types.ErrLockBelowMinimum)
+
+	// A boost of 2 doubles the two earlier transactions and later ones.
+	_, err = ms.LockEGV(ctx, types.NewMsgLockEGV(wallets[0].String(), math.NewInt(500)))
+	require.NoError(t, err)
+	requireTotal("5")
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallets[0]))
+	requireTotal("7")
+
+	_, err = ms.UnlockEGV(ctx, types.NewMsgUnlockEGV(wallets[0].String(), math.NewInt(495)))
+	require.ErrorIs(t, err, types.ErrLockBelowMinimum)
+	_, err = ms.UnlockEGV(ctx, types.NewMsgUnlockEGV(wallets[0].String(), math.NewInt(250)))
+	require.NoError(t, err)
+	requireTotal("5.5")
+
+	// Changing the lock boost params recomputes the bonus.
+	params = getParams(t, k, ctx)
+	params.LockBoostSaturation = "250"
+	require.NoError(t, k.SetParams(ctx, params))
+	requireTotal("10")
+
+	// The decayed bonus decays with the scores: two half-lives later the
+	// network weighs (3 * 3 + 1) / 4.
+	params.ContributionScoreMode = types.ContributionScoreDecayed
+	require.NoError(t, k.SetParams(ctx, params))
+	requireTotal("10")
+	ctx = ctx.WithBlockHeight(40)
+	requireTotal("2.5")
+	contribution, err := k.GetEffectiveContribution(ctx, wallets[0])
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("2.25"), contribution)
+}
+
+func TestInvariantsWithoutParams(t *testing.T) {
+	// x/crisis runs the invariants before the rewards genesis has set params.
+	k, ctx := keepertest.RewardsKeeperWithoutParams(t)
+	_, err := k.GetParams(ctx)
+	require.ErrorIs(t, err, types.ErrParamsNotFound)
+
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) DelegateVotingPower(goCtx context.Context, msg *types.MsgDelegateVotingPower) (*types.MsgDelegateVotingPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := k.accAddress(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	delegatee, err := k.accAddress(msg.Delegatee)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.DelegateVotingPower(ctx, delegator, delegatee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateVotingPowerResponse{}, nil
+}
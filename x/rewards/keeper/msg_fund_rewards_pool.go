@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) FundRewardsPool(goCtx context.Context, msg *types.MsgFundRewardsPool) (*types.MsgFundRewardsPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := k.accAddress(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	campaignID, err := k.Keeper.FundRewardsPool(ctx, sender, msg.Amount, msg.Campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundRewardsPoolResponse{CampaignId: campaignID}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) LockEGV(goCtx context.Context, msg *types.MsgLockEGV) (*types.MsgLockEGVResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := k.accAddress(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount %q", msg.Amount)
+	}
+
+	locked, err := k.Keeper.LockEGV(ctx, owner, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockEGVResponse{Locked: locked.String()}, nil
+}
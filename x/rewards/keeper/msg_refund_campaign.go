@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) RefundCampaign(goCtx context.Context, msg *types.MsgRefundCampaign) (*types.MsgRefundCampaignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sponsor, err := k.accAddress(msg.Sponsor)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	amount, err := k.Keeper.RefundCampaign(ctx, sponsor, msg.CampaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundCampaignResponse{Amount: amount}, nil
+}
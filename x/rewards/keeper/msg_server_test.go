@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "zenoda/testutil/keeper"
@@ -22,3 +24,71 @@ func TestMsgServer(t *testing.T) {
 	require.NotNil(t, ctx)
 	require.NotEmpty(t, k)
 }
+
+func getParams(t testing.TB, k keeper.Keeper, ctx context.Context) types.Params {
+	t.Helper()
+	params, err := k.GetParams(sdk.UnwrapSDKContext(ctx))
+	require.NoError(t, err)
+	return params
+}
+
+func getPredefinedAddresses(t testing.TB, k keeper.Keeper, ctx context.Context) []sdk.AccAddress {
+	t.Helper()
+	wallets, err := k.GetPredefinedAddresses(sdk.UnwrapSDKContext(ctx))
+	require.NoError(t, err)
+	return wallets
+}
+
+func getInflationRate(t testing.TB, k keeper.Keeper, ctx context.Context) math.LegacyDec {
+	t.Helper()
+	rate, err := k.GetInflationRate(sdk.UnwrapSDKContext(ctx))
+	require.NoError(t, err)
+	return rate
+}
+
+// TestCustomAddressPrefix runs delegation, locks and vetoes on a chain whose
+// address codec does not use the SDK's global Bech32 prefix.
+func TestCustomAddressPrefix(t *testing.T) {
+	k, goCtx := keepertest.RewardsKeeperWithPrefix(t, "zenoda")
+	ms := keeper.NewMsgServerImpl(k)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(100)
+	setLockBoost(t, k, ctx, "3")
+	params := getParams(t, k, ctx)
+	wallets := params.PredefinedWallets
+
+	_, err := ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: wallets[0], Delegatee: wallets[1]})
+	require.NoError(t, err)
+	res, err := k.VotingDelegation(ctx, &types.QueryVotingDelegationRequest{Delegator: wallets[0]})
+	require.NoError(t, err)
+	require.Equal(t, wallets[1], res.Delegation.Delegatee)
+	to, err := k.VotingDelegationsTo(ctx, &types.QueryVotingDelegationsToRequest{Delegatee: wallets[1]})
+	require.NoError(t, err)
+	require.Equal(t, []types.VotingDelegation{types.NewVotingDelegation(wallets[0], wallets[1])}, to.Delegations)
+	_, err = ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: wallets[1], Delegatee: wallets[1]})
+	require.ErrorIs(t, err, types.ErrSelfDelegation)
+
+	owner, err := k.AddressCodec().StringToBytes(wallets[2])
+	require.NoError(t, err)
+	fundAccount(t, k, ctx, owner, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1000)))
+	_, err = ms.LockEGV(ctx, types.NewMsgLockEGV(wallets[2], math.NewInt(500)))
+	require.NoError(t, err)
+	unlockRes, err := ms.UnlockEGV(ctx, types.NewMsgUnlockEGV(wallets[2], math.NewInt(200)))
+	require.NoError(t, err)
+	lock, err := k.Lock(ctx, &types.QueryLockRequest{Address: wallets[2]})
+	require.NoError(t, err)
+	require.Equal(t, []types.EGVUnlock{types.NewEGVUnlock(unlockRes.UnlockId, wallets[2], math.NewInt(200), 110)}, lock.Unlocks)
+	_, broken := keeper.PositiveLocksInvariant(k)(ctx)
+	require.False(t, broken)
+
+	change, err := k.QueueParamChange(ctx, params)
+	require.NoError(t, err)
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: wallets[3], ChangeId: change.Id})
+	require.NoError(t, err)
+	change, err = k.GetQueuedParamChange(ctx, change.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{wallets[3]}, change.Vetoes)
+	participation, err := k.Participation(ctx, &types.QueryParticipationRequest{Address: wallets[3]})
+	require.NoError(t, err)
+	require.Equal(t, wallets[3], participation.Participation.Address)
+	require.Equal(t, uint64(1), participation.Participation.Vetoes)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) SetRewardWithdrawAddress(goCtx context.Context, msg *types.MsgSetRewardWithdrawAddress) (*types.MsgSetRewardWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	withdrawAddr, err := k.accAddress(msg.WithdrawAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.SetRewardWithdrawAddress(ctx, addr, withdrawAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRewardWithdrawAddressResponse{}, nil
+}
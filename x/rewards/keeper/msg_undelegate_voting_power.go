@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) UndelegateVotingPower(goCtx context.Context, msg *types.MsgUndelegateVotingPower) (*types.MsgUndelegateVotingPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := k.accAddress(msg.Delegator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.UndelegateVotingPower(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateVotingPowerResponse{}, nil
+}
@@ -4,14 +4,16 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
+	"zenoda/testutil/sample"
 	"zenoda/x/rewards/types"
 )
 
 func TestMsgUpdateParams(t *testing.T) {
 	k, ms, ctx := setupMsgServer(t)
-	params := types.DefaultParams()
+	params := sample.RewardsParams()
 	require.NoError(t, k.SetParams(ctx, params))
 	wctx := sdk.UnwrapSDKContext(ctx)
 
@@ -32,11 +34,145 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "empty params",
 			input: &types.MsgUpdateParams{
 				Authority: k.GetAuthority(),
 				Params:    types.Params{},
 			},
+			expErr:    true,
+			expErrMsg: "invalid inflation rate",
+		},
+		{
+			name: "inflation change too large",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    withInflationRate(params, "0.10"),
+			},
+			expErr:    true,
+			expErrMsg: "exceeds the maximum allowed per update",
+		},
+		{
+			name: "inflation rate above max bound",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := withInflationRate(params, "0.06")
+					p.MaxInflationRate = "0.055"
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "inflation rate outside of the allowed bounds",
+		},
+		{
+			name: "inflation change within guardrails",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    withInflationRate(params, "0.06"),
+			},
+			expErr: false,
+		},
+		{
+			name: "governance set change too large",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    withWallets(params, sample.AccAddress(), sample.AccAddress(), sample.AccAddress(), sample.AccAddress()),
+			},
+			expErr:    true,
+			expErrMsg: "replacing 4 of 10 governance wallets exceeds",
+		},
+		{
+			name: "blocked governance wallet",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    withWallets(params, authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()),
+			},
+			expErr:    true,
+			expErrMsg: "address is blocked or a module account",
+		},
+		{
+			name: "reward denom change",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := params
+					p.RewardDenom = "uzen"
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "reward denom cannot be changed after genesis",
+		},
+		{
+			name: "epoch length change",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := params
+					p.EpochLength = 100
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "epoch length cannot be changed after genesis",
+		},
+		{
+			name: "max inflation rate step too large",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := params
+					p.MaxInflationRate = "0.50"
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "max inflation rate change from 0.200000000000000000 to 0.500000000000000000 exceeds",
+		},
+		{
+			name: "max inflation rate change raised too far",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := params
+					p.MaxInflationRateChange = "0.05"
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "max inflation rate change from 0.020000000000000000 to 0.050000000000000000 exceeds",
+		},
+		{
+			name: "max inflation rate change raised within step limit",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := params
+					p.MaxInflationRateChange = "0.04"
+					return p
+				}(),
+			},
+			expErr: false,
+		},
+		{
+			name: "guardrails tightened within step limit",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: func() types.Params {
+					p := params
+					p.MaxInflationRate = "0.18"
+					p.MaxInflationRateChange = "0.01"
+					return p
+				}(),
+			},
+			expErr: false,
+		},
+		{
+			name: "governance set change within limit",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    withWallets(params, sample.AccAddress(), sample.AccAddress(), sample.AccAddress()),
+			},
 			expErr: false,
 		},
 		{
@@ -62,3 +198,15 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func withInflationRate(params types.Params, rate string) types.Params {
+	params.InflationRate = rate
+	return params
+}
+
+// withWallets replaces the first governance wallets of params.
+func withWallets(params types.Params, wallets ...string) types.Params {
+	params.PredefinedWallets = append([]string{}, params.PredefinedWallets...)
+	copy(params.PredefinedWallets, wallets)
+	return params
+}
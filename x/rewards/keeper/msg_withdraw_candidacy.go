@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) WithdrawCandidacy(goCtx context.Context, msg *types.MsgWithdrawCandidacy) (*types.MsgWithdrawCandidacyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	candidate, err := k.accAddress(msg.Candidate)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.WithdrawCandidacy(ctx, candidate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawCandidacyResponse{}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k msgServer) WithdrawVested(goCtx context.Context, msg *types.MsgWithdrawVested) (*types.MsgWithdrawVestedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	amount, err := k.Keeper.WithdrawVested(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawVestedResponse{Amount: amount.String()}, nil
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
-	"github.com/cosmos/cosmos-sdk/runtime"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 
 	"zenoda/x/rewards/types"
 )
@@ -20,14 +22,51 @@ import (
 // 	return params
 // }
 
-// SetParams set the params
+// SetParams validates and stores the params. Invalid params are never
+// persisted. Governance wallets are stored with the chain's address prefix.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	bz, err := k.cdc.Marshal(&params)
+	params, err := params.NormalizeWallets(k.addressCodec)
 	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if err := k.checkBlockedWallets(ctx, params.PredefinedWallets); err != nil {
+		return err
+	}
+	previous, err := k.params.Get(ctx)
+	hadParams := err == nil
+	if err := k.params.Set(ctx, params); err != nil {
 		return err
 	}
-	store.Set(types.ParamsKey, bz)
 
+	// The lock bonus is computed with the lock boost params.
+	if hadParams && lockBoostChanged(previous, params) {
+		return k.resetLockBonus(sdk.UnwrapSDKContext(ctx), params)
+	}
+	return nil
+}
+
+// lockBoostChanged reports whether the params the lock boost is computed
+// with differ.
+func lockBoostChanged(a, b types.Params) bool {
+	return a.LockBoostCurve != b.LockBoostCurve ||
+		a.LockBoostMax != b.LockBoostMax ||
+		a.LockBoostSaturation != b.LockBoostSaturation
+}
+
+// checkBlockedWallets rejects governance wallets that are blocked addresses
+// or module accounts.
+func (k Keeper) checkBlockedWallets(ctx context.Context, wallets []string) error {
+	for _, wallet := range wallets {
+		_, bz, err := bech32.DecodeAndConvert(wallet)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "invalid Bech32 address: %s", wallet)
+		}
+		if k.IsBlockedAddress(ctx, bz) {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "governance wallet %s: %s", wallet, types.ErrBlockedAddress)
+		}
+	}
 	return nil
 }
@@ -1,18 +1,82 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "zenoda/testutil/keeper"
+	"zenoda/testutil/sample"
 	"zenoda/x/rewards/types"
 )
 
 func TestGetParams(t *testing.T) {
 	k, ctx := keepertest.RewardsKeeper(t)
-	params := types.DefaultParams()
+	params := sample.RewardsParams()
 
 	require.NoError(t, k.SetParams(ctx, params))
-	require.EqualValues(t, params, k.GetParams(ctx))
+	require.EqualValues(t, params, getParams(t, k, ctx))
+}
+
+func TestSetParamsRejectsInvalidParams(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	params := sample.RewardsParams()
+	params.InflationRate = "not-a-rate"
+
+	require.ErrorIs(t, k.SetParams(ctx, params), types.ErrInvalidParams)
+	require.EqualValues(t, sample.RewardsParams(), getParams(t, k, ctx))
+}
+
+func TestSetParamsNormalizesWalletPrefix(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeperWithPrefix(t, "zenoda")
+	params := sample.RewardsParams()
+
+	stored := getParams(t, k, ctx)
+	require.Len(t, stored.PredefinedWallets, len(params.PredefinedWallets))
+	for i, wallet := range stored.PredefinedWallets {
+		require.True(t, strings.HasPrefix(wallet, "zenoda1"), wallet)
+
+		_, want, err := bech32.DecodeAndConvert(params.PredefinedWallets[i])
+		require.NoError(t, err)
+		got, err := k.AddressCodec().StringToBytes(wallet)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+
+	addrs, err := k.GetPredefinedAddresses(ctx)
+	require.NoError(t, err)
+	require.Len(t, addrs, len(params.PredefinedWallets))
+}
+
+func TestSetParamsRejectsForeignWalletPrefix(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeperWithPrefix(t, "zenoda")
+	before := getParams(t, k, ctx)
+
+	_, bz, err := bech32.DecodeAndConvert(before.PredefinedWallets[0])
+	require.NoError(t, err)
+	foreign, err := bech32.ConvertAndEncode("osmo", bz)
+	require.NoError(t, err)
+
+	params := sample.RewardsParams()
+	params.PredefinedWallets = []string{foreign}
+	require.ErrorIs(t, k.SetParams(ctx, params), types.ErrInvalidParams)
+	require.EqualValues(t, before, getParams(t, k, ctx))
+}
+
+func TestSetParamsRejectsModuleAccountWallet(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	moduleAccount := authtypes.NewEmptyModuleAccount(types.ModuleName, authtypes.Minter)
+	k.GetAccountKeeper().SetAccount(ctx, k.GetAccountKeeper().NewAccount(ctx, moduleAccount))
+
+	params := sample.RewardsParams()
+	params.PredefinedWallets = append([]string{}, params.PredefinedWallets...)
+	params.PredefinedWallets[0] = moduleAccount.GetAddress().String()
+
+	err := k.SetParams(ctx, params)
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	require.ErrorContains(t, err, types.ErrBlockedAddress.Error())
+	require.EqualValues(t, sample.RewardsParams(), getParams(t, k, ctx))
 }
@@ -0,0 +1,419 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- GOVERNANCE PARTICIPATION ----------------------
+
+// GetParticipation returns the participation record of an address, an empty
+// record if it has none yet.
+func (k Keeper) GetParticipation(ctx sdk.Context, addr sdk.AccAddress) (types.Participation, error) {
+	participation, err := k.participations.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		addrStr, err := k.addressString(addr)
+		if err != nil {
+			return participation, err
+		}
+		return types.NewParticipation(addrStr), nil
+	}
+	if err != nil {
+		return participation, errorsmod.Wrapf(err, "failed to retrieve participation of %s", addr)
+	}
+	return participation, nil
+}
+
+// SetParticipation stores the participation record of an address.
+func (k Keeper) SetParticipation(ctx sdk.Context, participation types.Participation) error {
+	addr, err := k.accAddress(participation.Address)
+	if err != nil {
+		return err
+	}
+	return k.participations.Set(ctx, addr, participation)
+}
+
+// GetAllParticipations returns every participation record.
+func (k Keeper) GetAllParticipations(ctx sdk.Context) (list []types.Participation, err error) {
+	err = k.participations.Walk(ctx, nil, func(_ sdk.AccAddress, participation types.Participation) (bool, error) {
+		list = append(list, participation)
+		return false, nil
+	})
+	return list, err
+}
+
+// SetEpochParticipation stores the participation of an address in an epoch.
+func (k Keeper) SetEpochParticipation(ctx sdk.Context, participation types.EpochParticipation) error {
+	addr, err := k.accAddress(participation.Address)
+	if err != nil {
+		return err
+	}
+	return k.epochParticipations.Set(ctx, collections.Join(participation.Epoch, addr), participation)
+}
+
+// GetEpochParticipations returns the participation of an address in each
+// retained epoch between start and end, inclusive, in which it took part.
+func (k Keeper) GetEpochParticipations(ctx sdk.Context, addr sdk.AccAddress, start, end uint64) (list []types.EpochParticipation, err error) {
+	oldest, err := k.OldestRetainedEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	start = max(start, oldest)
+	for epoch := start; epoch <= end; epoch++ {
+		participation, err := k.epochParticipations.Get(ctx, collections.Join(epoch, addr))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, participation)
+	}
+	return list, nil
+}
+
+// GetAllEpochParticipations returns the participation of every address in
+// every retained epoch.
+func (k Keeper) GetAllEpochParticipations(ctx sdk.Context) (list []types.EpochParticipation, err error) {
+	err = k.epochParticipations.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], participation types.EpochParticipation) (bool, error) {
+		list = append(list, participation)
+		return false, nil
+	})
+	return list, err
+}
+
+// SetProposalVote records that an address voted on an x/gov proposal.
+func (k Keeper) SetProposalVote(ctx sdk.Context, vote types.ProposalVote) error {
+	voter, err := k.accAddress(vote.Voter)
+	if err != nil {
+		return err
+	}
+	return k.proposalVotes.Set(ctx, collections.Join(vote.ProposalId, voter))
+}
+
+// GetAllProposalVotes returns the votes recorded for the x/gov proposals
+// still in their voting period.
+func (k Keeper) GetAllProposalVotes(ctx sdk.Context) (list []types.ProposalVote, err error) {
+	err = k.proposalVotes.Walk(ctx, nil, func(key collections.Pair[uint64, sdk.AccAddress]) (bool, error) {
+		voter, err := k.addressString(key.K2())
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.ProposalVote{ProposalId: key.K1(), Voter: voter})
+		return false, nil
+	})
+	return list, err
+}
+
+// RecordGovernanceVote records an x/gov vote. Votes of governance wallets
+// count towards their participation.
+func (k Keeper) RecordGovernanceVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) error {
+	if err := k.proposalVotes.Set(ctx, collections.Join(proposalID, voter)); err != nil {
+		return err
+	}
+	wallets, err := k.GetPredefinedAddresses(ctx)
+	if err != nil {
+		return err
+	}
+	if !k.isPredefinedAddress(voter, wallets) {
+		return nil
+	}
+	return k.recordParticipation(ctx, voter, 1, 0)
+}
+
+// RecordGovernanceVeto records a veto signed by a governance wallet in another
+// module's timelock, such as x/zenoda's queued params updates.
+func (k Keeper) RecordGovernanceVeto(ctx sdk.Context, voter sdk.AccAddress) error {
+	return k.recordParticipation(ctx, voter, 0, 1)
+}
+
+// recordParticipation adds governance actions taken by a wallet to its
+// record and to the current epoch, and resets its missed actions.
+func (k Keeper) recordParticipation(ctx sdk.Context, addr sdk.AccAddress, votes, vetoes uint64) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	participation, err := k.GetParticipation(ctx, addr)
+	if err != nil {
+		return err
+	}
+	participation.Votes += votes
+	participation.Vetoes += vetoes
+	participation.MissedActions = 0
+	if err := k.SetParticipation(ctx, participation); err != nil {
+		return err
+	}
+
+	epochParticipation, err := k.epochParticipations.Get(ctx, collections.Join(epoch, addr))
+	if errors.Is(err, collections.ErrNotFound) {
+		epochParticipation = types.EpochParticipation{Epoch: epoch, Address: participation.Address}
+	} else if err != nil {
+		return err
+	}
+	epochParticipation.Votes += votes
+	epochParticipation.Vetoes += vetoes
+	return k.SetEpochParticipation(ctx, epochParticipation)
+}
+
+// ProcessProposalParticipation runs when the voting period of an x/gov
+// proposal ends. Every governance wallet that did not vote on it, itself or
+// through its delegatee, misses an action; inactivity_penalty applies once a
+// wallet has missed max_missed_governance_actions in a row. It runs inside the
+// x/gov AfterProposalVotingPeriodEnded hook, where an error would fail
+// EndBlock, so a failed check is logged and leaves no writes behind.
+func (k Keeper) ProcessProposalParticipation(ctx sdk.Context, proposalID uint64) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.checkProposalParticipation(cacheCtx, proposalID); err != nil {
+		k.Logger().Error("Skipping failed governance participation check", "proposal_id", proposalID, "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParticipationFailed,
+				sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposalID, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+		return nil
+	}
+	write()
+	return nil
+}
+
+// checkProposalParticipation counts the missed actions of an x/gov proposal
+// and applies the inactivity penalties they lead to.
+func (k Keeper) checkProposalParticipation(ctx sdk.Context, proposalID uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	wallets, err := k.GetPredefinedAddresses(ctx)
+	if err != nil {
+		return err
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var inactive []types.Participation
+	for _, wallet := range wallets {
+		voted, err := k.votedOn(ctx, proposalID, wallet)
+		if err != nil {
+			return err
+		}
+		if voted {
+			continue
+		}
+
+		participation, err := k.GetParticipation(ctx, wallet)
+		if err != nil {
+			return err
+		}
+		participation.MissedActions++
+		if err := k.SetParticipation(ctx, participation); err != nil {
+			return err
+		}
+		if params.MaxMissedGovernanceActions > 0 && participation.MissedActions >= params.MaxMissedGovernanceActions {
+			inactive = append(inactive, participation)
+		}
+	}
+
+	for _, participation := range inactive {
+		if err := k.applyInactivityPenalty(ctx, participation, epoch); err != nil {
+			return err
+		}
+	}
+
+	return k.proposalVotes.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+}
+
+// votedOn reports whether a wallet or the address it delegated its voting
+// power to voted on a proposal.
+func (k Keeper) votedOn(ctx sdk.Context, proposalID uint64, wallet sdk.AccAddress) (bool, error) {
+	voted, err := k.proposalVotes.Has(ctx, collections.Join(proposalID, wallet))
+	if err != nil || voted {
+		return voted, err
+	}
+	delegation, err := k.GetVotingDelegation(ctx, wallet)
+	if errors.Is(err, types.ErrDelegationNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	delegatee, err := k.accAddress(delegation.Delegatee)
+	if err != nil {
+		return false, err
+	}
+	return k.proposalVotes.Has(ctx, collections.Join(proposalID, delegatee))
+}
+
+// applyInactivityPenalty applies inactivity_penalty to a wallet and resets its
+// missed actions. A removal that would leave fewer than
+// min_governance_set_size wallets suspends the wallet instead.
+func (k Keeper) applyInactivityPenalty(ctx sdk.Context, participation types.Participation, epoch uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	penalty := params.InactivityPenalty
+	if penalty == types.InactivityPenaltyRemoval {
+		addr, err := k.accAddress(participation.Address)
+		if err != nil {
+			return err
+		}
+		removed, err := k.removeGovernanceWallet(ctx, params, addr, epoch)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			penalty = types.InactivityPenaltySuspension
+		}
+	}
+
+	missed := participation.MissedActions
+	participation.Penalty = penalty
+	participation.PenaltyEndEpoch = 0
+	if penalty != types.InactivityPenaltyRemoval {
+		participation.PenaltyEndEpoch = epoch + params.InactivityPenaltyEpochs
+	}
+	participation.MissedActions = 0
+	if err := k.SetParticipation(ctx, participation); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInactivityPenalty,
+			sdk.NewAttribute(types.AttributeKeyAddress, participation.Address),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty),
+			sdk.NewAttribute(types.AttributeKeyMissedActions, strconv.FormatUint(missed, 10)),
+			sdk.NewAttribute(types.AttributeKeyPenaltyEndEpoch, strconv.FormatUint(participation.PenaltyEndEpoch, 10)),
+		),
+	)
+	return nil
+}
+
+// removeGovernanceWallet removes a wallet from predefined_wallets unless that
+// would take the set below min_governance_set_size.
+//
+// The removal skips the param_change_delay timelock on purpose: it enforces
+// inactivity rules governance already agreed on, and queuing it would let the
+// wallet veto its own removal. It passes the same checks as a governance
+// params update, and max_governance_set_change applies to all removals of an
+// epoch together, measured against the set as it stood before the first. It
+// runs inside the x/gov AfterProposalVotingPeriodEnded hook, so a removal
+// that fails them is logged and the wallet is suspended instead of failing
+// EndBlock.
+func (k Keeper) removeGovernanceWallet(ctx sdk.Context, params types.Params, addr sdk.AccAddress, epoch uint64) (bool, error) {
+	if uint64(len(params.PredefinedWallets)) <= params.MinGovernanceSetSize {
+		return false, nil
+	}
+	removals, err := k.GetGovernanceSetRemovals(ctx, epoch)
+	if err != nil {
+		return false, err
+	}
+
+	updated := params
+	updated.PredefinedWallets = make([]string, 0, len(params.PredefinedWallets))
+	for _, wallet := range params.PredefinedWallets {
+		bz, err := k.addressCodec.StringToBytes(wallet)
+		if err == nil && addr.Equals(sdk.AccAddress(bz)) {
+			removals.Wallets = append(removals.Wallets, wallet)
+			continue
+		}
+		updated.PredefinedWallets = append(updated.PredefinedWallets, wallet)
+	}
+	epochStart := params
+	epochStart.PredefinedWallets = append(append([]string{}, updated.PredefinedWallets...), removals.Wallets...)
+
+	addrStr, err := k.addressString(addr)
+	if err != nil {
+		return false, err
+	}
+	err = k.ValidateParamsUpdate(ctx, updated)
+	if err == nil {
+		err = checkGovernanceSetChange(epochStart, updated)
+	}
+	if err != nil {
+		k.Logger().Error("Failed to remove inactive governance wallet", "address", addrStr, "error", err)
+		return false, nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.SetParams(cacheCtx, updated); err != nil {
+		k.Logger().Error("Failed to remove inactive governance wallet", "address", addrStr, "error", err)
+		return false, nil
+	}
+	if err := k.SetGovernanceSetRemovals(cacheCtx, removals); err != nil {
+		return false, err
+	}
+	write()
+	return true, nil
+}
+
+// GetGovernanceSetRemovals returns the governance wallets removed for
+// inactivity in an epoch. Only the removals of the latest epoch with any are
+// kept, so earlier epochs return an empty list.
+func (k Keeper) GetGovernanceSetRemovals(ctx sdk.Context, epoch uint64) (types.GovernanceSetRemovals, error) {
+	removals, err := k.governanceSetRemovals.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && removals.Epoch != epoch) {
+		return types.GovernanceSetRemovals{Epoch: epoch}, nil
+	}
+	if err != nil {
+		return removals, errorsmod.Wrap(err, "failed to retrieve governance set removals")
+	}
+	return removals, nil
+}
+
+// SetGovernanceSetRemovals stores the governance wallets removed for
+// inactivity in an epoch, replacing those of earlier epochs.
+func (k Keeper) SetGovernanceSetRemovals(ctx sdk.Context, removals types.GovernanceSetRemovals) error {
+	if err := k.governanceSetRemovals.Set(ctx, removals); err != nil {
+		return errorsmod.Wrap(err, "failed to store governance set removals")
+	}
+	return nil
+}
+
+// IsSuspended reports whether a governance wallet is suspended for inactivity.
+func (k Keeper) IsSuspended(ctx sdk.Context, addr sdk.AccAddress) (bool, error) {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return false, err
+	}
+	participation, err := k.GetParticipation(ctx, addr)
+	if err != nil {
+		return false, err
+	}
+	return participation.Suspended(epoch), nil
+}
+
+// rewardShareFactor returns the part of its reward share a wallet keeps:
+// zero while suspended and 1 - inactivity_reward_reduction while its reward
+// share is reduced.
+func (k Keeper) rewardShareFactor(ctx sdk.Context, params types.Params, addr sdk.AccAddress, epoch uint64) (math.LegacyDec, error) {
+	participation, err := k.GetParticipation(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if !participation.PenaltyActive(epoch) {
+		return math.LegacyOneDec(), nil
+	}
+	if participation.Penalty == types.InactivityPenaltySuspension {
+		return math.LegacyZeroDec(), nil
+	}
+	reduction, err := params.GetInactivityRewardReductionAsDec()
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidParams, "invalid inactivity reward reduction: %s", err)
+	}
+	return math.LegacyOneDec().Sub(reduction), nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/testutil/sample"
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/types"
+)
+
+// endProposal ends the voting period of a proposal the given addresses voted on.
+func endProposal(t *testing.T, k keeper.Keeper, ctx sdk.Context, proposalID uint64, voters ...sdk.AccAddress) {
+	t.Helper()
+	for _, voter := range voters {
+		require.NoError(t, k.Hooks().AfterProposalVote(ctx, proposalID, voter))
+	}
+	require.NoError(t, k.Hooks().AfterProposalVotingPeriodEnded(ctx, proposalID))
+}
+
+func setInactivityPenalty(t *testing.T, k keeper.Keeper, ctx sdk.Context, penalty string) {
+	t.Helper()
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.MaxMissedGovernanceActions = 2
+	params.InactivityPenalty = penalty
+	params.InactivityPenaltyEpochs = 3
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+func TestParticipationTracking(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	setInactivityPenalty(t, k, ctx, types.InactivityPenaltyRewardReduction)
+	ctx = ctx.WithBlockHeight(25)
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	delegatee, err := sdk.AccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, err)
+	require.NoError(t, k.DelegateVotingPower(ctx, wallets[1], delegatee))
+
+	// wallets[0] votes itself, wallets[1] through its delegatee.
+	endProposal(t, k, ctx, 1, wallets[0], delegatee)
+
+	res, err := k.Participation(ctx, &types.QueryParticipationRequest{Address: wallets[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, types.Participation{Address: wallets[0].String(), Votes: 1}, res.Participation)
+	require.Equal(t, []types.EpochParticipation{{Epoch: 2, Address: wallets[0].String(), Votes: 1}}, res.Epochs)
+	require.False(t, res.Penalized)
+
+	participation, err := k.GetParticipation(ctx, wallets[1])
+	require.NoError(t, err)
+	require.Zero(t, participation.MissedActions)
+	participation, err = k.GetParticipation(ctx, wallets[2])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), participation.MissedActions)
+
+	// Votes are only kept while the proposal is open.
+	votes, err := k.GetAllProposalVotes(ctx)
+	require.NoError(t, err)
+	require.Empty(t, votes)
+}
+
+func TestInactivityRewardReduction(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	setInactivityPenalty(t, k, ctx, types.InactivityPenaltyRewardReduction)
+	ctx = ctx.WithBlockHeight(25)
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	endProposal(t, k, ctx, 1, wallets[0])
+	endProposal(t, k, ctx, 2, wallets[0])
+
+	res, err := k.Participation(ctx, &types.QueryParticipationRequest{Address: wallets[1].String()})
+	require.NoError(t, err)
+	require.True(t, res.Penalized)
+	require.Equal(t, types.InactivityPenaltyRewardReduction, res.Participation.Penalty)
+	require.Equal(t, uint64(5), res.Participation.PenaltyEndEpoch)
+	require.Zero(t, res.Participation.MissedActions)
+
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallets[0]))
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallets[1]))
+	shares, err := k.GetRewardShares(ctx)
+	require.NoError(t, err)
+	require.Len(t, shares, 2)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), shares[0].Share)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.25"), shares[1].Share)
+
+	// The reduction is lifted after inactivity_penalty_epochs.
+	ctx = ctx.WithBlockHeight(50)
+	shares, err = k.GetRewardShares(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), shares[1].Share)
+}
+
+func TestInactivitySuspension(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	setInactivityPenalty(t, k, ctx, types.InactivityPenaltySuspension)
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	endProposal(t, k, ctx, 1, wallets[0])
+	endProposal(t, k, ctx, 2, wallets[0])
+
+	suspended, err := k.IsSuspended(ctx, wallets[1])
+	require.NoError(t, err)
+	require.True(t, suspended)
+
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallets[0]))
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallets[1]))
+	shares, err := k.GetRewardShares(ctx)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	require.Equal(t, wallets[0], shares[0].Address)
+
+	change, err := k.QueueParamChange(ctx, getParams(t, k, ctx))
+	require.NoError(t, err)
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: wallets[1].String(), ChangeId: change.Id})
+	require.ErrorIs(t, err, types.ErrWalletSuspended)
+
+	// Vetoes count as participation.
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: wallets[0].String(), ChangeId: change.Id})
+	require.NoError(t, err)
+	participation, err := k.GetParticipation(ctx, wallets[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), participation.Votes)
+	require.Equal(t, uint64(1), participation.Vetoes)
+}
+
+func TestInactivityRemoval(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	setInactivityPenalty(t, k, ctx, types.InactivityPenaltyRemoval)
+
+	params := getParams(t, k, ctx)
+	params.MinGovernanceSetSize = 9
+	require.NoError(t, k.SetParams(ctx, params))
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	endProposal(t, k, ctx, 1, wallets[:8]...)
+	endProposal(t, k, ctx, 2, wallets[:8]...)
+
+	// Only one wallet fits above min_governance_set_size; the other is suspended.
+	params = getParams(t, k, ctx)
+	require.Len(t, params.PredefinedWallets, 9)
+	require.NotContains(t, params.PredefinedWallets, wallets[8].String())
+
+	participation, err := k.GetParticipation(ctx, wallets[8])
+	require.NoError(t, err)
+	require.Equal(t, types.InactivityPenaltyRemoval, participation.Penalty)
+	participation, err = k.GetParticipation(ctx, wallets[9])
+	require.NoError(t, err)
+	require.Equal(t, types.InactivityPenaltySuspension, participation.Penalty)
+}
+
+func TestInactivityRemovalChurnLimit(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	setInactivityPenalty(t, k, ctx, types.InactivityPenaltyRemoval)
+	ctx = ctx.WithBlockHeight(25)
+
+	// Ten wallets with a 0.34 max_governance_set_change allow three removals
+	// per epoch, even when they come from separate proposals.
+	wallets := getPredefinedAddresses(t, k, ctx)
+	endProposal(t, k, ctx, 1, wallets[:8]...)
+	endProposal(t, k, ctx, 2, wallets[:8]...)
+	endProposal(t, k, ctx, 3, wallets[:6]...)
+	endProposal(t, k, ctx, 4, wallets[:6]...)
+
+	params := getParams(t, k, ctx)
+	require.Len(t, params.PredefinedWallets, 7)
+	for _, wallet := range []sdk.AccAddress{wallets[6], wallets[8], wallets[9]} {
+		participation, err := k.GetParticipation(ctx, wallet)
+		require.NoError(t, err)
+		require.Equal(t, types.InactivityPenaltyRemoval, participation.Penalty)
+		require.NotContains(t, params.PredefinedWallets, wallet.String())
+	}
+	participation, err := k.GetParticipation(ctx, wallets[7])
+	require.NoError(t, err)
+	require.Equal(t, types.InactivityPenaltySuspension, participation.Penalty)
+	require.Contains(t, params.PredefinedWallets, wallets[7].String())
+	removals, err := k.GetGovernanceSetRemovals(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, removals.Wallets, 3)
+
+	// The limit starts over in the next epoch.
+	ctx = ctx.WithBlockHeight(35)
+	endProposal(t, k, ctx, 5, wallets[:5]...)
+	endProposal(t, k, ctx, 6, wallets[:5]...)
+	params = getParams(t, k, ctx)
+	require.Len(t, params.PredefinedWallets, 5)
+	require.NotContains(t, params.PredefinedWallets, wallets[5].String())
+	require.NotContains(t, params.PredefinedWallets, wallets[7].String())
+}
+
+func TestFailedParticipationCheckDoesNotFailEndBlock(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	setInactivityPenalty(t, k, ctx, types.InactivityPenaltyRewardReduction)
+	ctx = ctx.WithBlockHeight(25).WithEventManager(sdk.NewEventManager())
+
+	// An undecodable participation record of the last wallet makes the check
+	// fail after the missed actions of the others were counted.
+	wallets := getPredefinedAddresses(t, k, ctx)
+	last := wallets[len(wallets)-1]
+	rewardsStore(t, ctx).Set(append(types.ParticipationKeyPrefix.Bytes(), last...), []byte{0xff})
+
+	require.NoError(t, k.Hooks().AfterProposalVotingPeriodEnded(ctx, 1))
+	participation, err := k.GetParticipation(ctx, wallets[0])
+	require.NoError(t, err)
+	require.Zero(t, participation.MissedActions)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeParticipationFailed, ctx.EventManager().Events()[0].Type)
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) Candidates(goCtx context.Context, req *types.QueryCandidatesRequest) (*types.QueryCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	candidates, pageRes, err := query.CollectionPaginate(goCtx, k.candidates, req.Pagination,
+		func(_ sdk.AccAddress, candidate types.Candidate) (types.Candidate, error) {
+			return candidate, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandidatesResponse{Candidates: candidates, Pagination: pageRes}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) ContributionScore(goCtx context.Context, req *types.QueryContributionScoreRequest) (*types.QueryContributionScoreResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	score, err := k.GetContributionScore(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	total, err := k.GetTotalContributionScore(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContributionScoreResponse{
+		Score:                 score.String(),
+		TotalScore:            total.String(),
+		ContributionScoreMode: params.ContributionScoreMode,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) Councils(goCtx context.Context, req *types.QueryCouncilsRequest) (*types.QueryCouncilsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	councils, pageRes, err := query.CollectionPaginate(goCtx, k.councils, req.Pagination,
+		func(_ uint64, council types.Council) (types.Council, error) {
+			return council, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCouncilsResponse{Councils: councils, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Council(goCtx context.Context, req *types.QueryCouncilRequest) (*types.QueryCouncilResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	council, err := k.GetCouncil(ctx, req.Term)
+	if errors.Is(err, types.ErrCouncilNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCouncilResponse{Council: council}, nil
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) CurrentInflation(goCtx context.Context, req *types.QueryCurrentInflationRequest) (*types.QueryCurrentInflationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	rate, err := k.GetInflationRate(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCurrentInflationResponse{
+		Schedule:          params.InflationSchedule,
+		Epoch:             epoch,
+		InflationRate:     rate.String(),
+		BaseInflationRate: params.InflationRate,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) EpochContributions(goCtx context.Context, req *types.QueryEpochContributionsRequest) (*types.QueryEpochContributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	end, err := k.resolveEpochRange(ctx, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	contributions, total, err := k.GetEpochContributions(ctx, addr, req.StartEpoch, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochContributionsResponse{Contributions: contributions, Total: total}, nil
+}
+
+func (k Keeper) EpochTotals(goCtx context.Context, req *types.QueryEpochTotalsRequest) (*types.QueryEpochTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	end, err := k.resolveEpochRange(ctx, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	totals, total, err := k.GetEpochTotals(ctx, req.StartEpoch, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochTotalsResponse{Totals: totals, Total: total}, nil
+}
+
+// resolveEpochRange returns the last epoch of a queried range, where zero
+// stands for the current epoch, and checks the range is not reversed.
+func (k Keeper) resolveEpochRange(ctx sdk.Context, start, end uint64) (uint64, error) {
+	if end == 0 {
+		current, err := k.GetCurrentEpoch(ctx)
+		if err != nil {
+			return 0, status.Error(codes.Internal, err.Error())
+		}
+		end = current
+	}
+	if start > end {
+		return 0, status.Errorf(codes.InvalidArgument, "start epoch %d is after end epoch %d", start, end)
+	}
+	return end, nil
+}
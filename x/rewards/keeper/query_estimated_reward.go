@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) EstimatedReward(goCtx context.Context, req *types.QueryEstimatedRewardRequest) (*types.QueryEstimatedRewardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	shares, err := k.GetRewardShares(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	inflation, err := k.GetInflationReward(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pool := k.GetRewardPool(ctx).Add(inflation)
+	burn, err := k.GetRewardBurn(ctx, pool)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	denom := k.GetRewardDenom(ctx)
+	pool = pool.Sub(sdk.NewCoin(denom, burn))
+
+	share, uncappedShare := math.LegacyZeroDec(), math.LegacyZeroDec()
+	for _, s := range shares {
+		if s.Address.Equals(addr) {
+			share, uncappedShare = s.Share, s.UncappedShare
+			break
+		}
+	}
+
+	rewards := types.ShareOfCoins(pool, share)
+	uncappedRewards := types.ShareOfCoins(pool, uncappedShare)
+	return &types.QueryEstimatedRewardResponse{
+		Reward:          rewards.AmountOf(denom).String(),
+		UncappedReward:  uncappedRewards.AmountOf(denom).String(),
+		Share:           share.String(),
+		UncappedShare:   uncappedShare.String(),
+		Rewards:         rewards,
+		UncappedRewards: uncappedRewards,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) Lock(goCtx context.Context, req *types.QueryLockRequest) (*types.QueryLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	locked, err := k.GetLocked(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	boost, err := k.GetLockBoost(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	unlocks, err := k.GetEGVUnlocks(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLockResponse{
+		Locked:  locked.String(),
+		Boost:   boost.String(),
+		Unlocks: unlocks,
+	}, nil
+}
+
+func (k Keeper) Locks(goCtx context.Context, req *types.QueryLocksRequest) (*types.QueryLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	locks, pageRes, err := query.CollectionPaginate(goCtx, k.locks, req.Pagination,
+		func(addr sdk.AccAddress, locked math.Int) (types.EGVLock, error) {
+			owner, err := k.addressString(addr)
+			if err != nil {
+				return types.EGVLock{}, err
+			}
+			return types.NewEGVLock(owner, locked), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLocksResponse{Locks: locks, Pagination: pageRes}, nil
+}
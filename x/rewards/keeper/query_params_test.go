@@ -6,12 +6,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "zenoda/testutil/keeper"
+	"zenoda/testutil/sample"
 	"zenoda/x/rewards/types"
 )
 
 func TestParamsQuery(t *testing.T) {
 	keeper, ctx := keepertest.RewardsKeeper(t)
-	params := types.DefaultParams()
+	params := sample.RewardsParams()
 	require.NoError(t, keeper.SetParams(ctx, params))
 
 	response, err := keeper.Params(ctx, &types.QueryParamsRequest{})
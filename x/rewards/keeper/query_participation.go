@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) Participation(goCtx context.Context, req *types.QueryParticipationRequest) (*types.QueryParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	end, err := k.resolveEpochRange(ctx, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	participation, err := k.GetParticipation(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	epochs, err := k.GetEpochParticipations(ctx, addr, req.StartEpoch, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParticipationResponse{
+		Participation: participation,
+		Epochs:        epochs,
+		Penalized:     participation.PenaltyActive(epoch),
+	}, nil
+}
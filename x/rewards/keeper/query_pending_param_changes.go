@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) PendingParamChanges(goCtx context.Context, req *types.QueryPendingParamChangesRequest) (*types.QueryPendingParamChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	changes, pageRes, err := query.CollectionPaginate(goCtx, k.queuedParamChanges, req.Pagination,
+		func(_ uint64, change types.QueuedParamChange) (types.QueuedParamChange, error) {
+			return change, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingParamChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
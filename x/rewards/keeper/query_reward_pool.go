@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) RewardPool(goCtx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRewardPoolResponse{Pool: k.GetRewardPool(ctx)}, nil
+}
+
+func (k Keeper) EpochRewards(goCtx context.Context, req *types.QueryEpochRewardsRequest) (*types.QueryEpochRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	end, err := k.resolveEpochRange(ctx, req.StartEpoch, req.EndEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, total, err := k.GetEpochRewards(ctx, req.StartEpoch, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochRewardsResponse{Rewards: rewards, Total: total}, nil
+}
+
+func (k Keeper) AddressRewards(goCtx context.Context, req *types.QueryAddressRewardsRequest) (*types.QueryAddressRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rewards, err := k.GetAddressRewards(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressRewardsResponse{Rewards: rewards}, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) Tally(goCtx context.Context, req *types.QueryTallyRequest) (*types.QueryTallyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// x/gov keeps the votes of a proposal until its voting period ends.
+	var votes []*govv1.Vote
+	pagination := &query.PageRequest{}
+	for {
+		res, err := k.govQuery.Votes(goCtx, &govv1.QueryVotesRequest{ProposalId: req.ProposalId, Pagination: pagination})
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, res.Votes...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	voters := make([]sdk.AccAddress, len(votes))
+	for i, vote := range votes {
+		voter, err := k.addressCodec.StringToBytes(vote.Voter)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		voters[i] = voter
+	}
+	weights, err := k.TallyVotingPower(ctx, voters)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	counts := map[govv1.VoteOption]math.LegacyDec{
+		govv1.OptionYes:        math.LegacyZeroDec(),
+		govv1.OptionAbstain:    math.LegacyZeroDec(),
+		govv1.OptionNo:         math.LegacyZeroDec(),
+		govv1.OptionNoWithVeto: math.LegacyZeroDec(),
+	}
+	res := &types.QueryTallyResponse{Votes: make([]types.TallyVote, len(votes))}
+	for i, vote := range votes {
+		res.Votes[i] = types.TallyVote{Voter: vote.Voter, Weight: weights[i].String()}
+		// A weighted vote splits the voter's weight over its options.
+		for _, option := range vote.Options {
+			share, err := math.LegacyNewDecFromStr(option.Weight)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if count, ok := counts[option.Option]; ok {
+				counts[option.Option] = count.Add(weights[i].Mul(share))
+			}
+		}
+	}
+	res.YesCount = counts[govv1.OptionYes].String()
+	res.AbstainCount = counts[govv1.OptionAbstain].String()
+	res.NoCount = counts[govv1.OptionNo].String()
+	res.NoWithVetoCount = counts[govv1.OptionNoWithVeto].String()
+
+	return res, nil
+}
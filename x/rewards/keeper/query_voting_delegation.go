@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) VotingDelegation(goCtx context.Context, req *types.QueryVotingDelegationRequest) (*types.QueryVotingDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegator, err := k.accAddress(req.Delegator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegation, err := k.GetVotingDelegation(ctx, delegator)
+	if errors.Is(err, types.ErrDelegationNotFound) {
+		return nil, status.Error(codes.NotFound, "no voting delegation")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotingDelegationResponse{Delegation: delegation}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) VotingDelegationsTo(goCtx context.Context, req *types.QueryVotingDelegationsToRequest) (*types.QueryVotingDelegationsToResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	delegatee, err := k.accAddress(req.Delegatee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(goCtx, k.delegatorsOf, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (types.VotingDelegation, error) {
+			delegator, err := k.addressString(key.K2())
+			if err != nil {
+				return types.VotingDelegation{}, err
+			}
+			return types.NewVotingDelegation(delegator, req.Delegatee), nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](delegatee),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotingDelegationsToResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
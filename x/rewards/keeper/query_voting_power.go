@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/rewards/types"
+)
+
+func (k Keeper) VotingPower(goCtx context.Context, req *types.QueryVotingPowerRequest) (*types.QueryVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := k.accAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	own, delegated, err := k.GetVotingPower(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryVotingPowerResponse{
+		OwnPower:             own.String(),
+		DelegatedPower:       delegated.String(),
+		TotalPower:           own.Add(delegated).String(),
+		VotingWeightFunction: params.VotingWeightFunction,
+	}
+	delegation, err := k.GetVotingDelegation(ctx, addr)
+	switch {
+	case err == nil:
+		res.Delegatee = delegation.Delegatee
+	case !errors.Is(err, types.ErrDelegationNotFound):
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
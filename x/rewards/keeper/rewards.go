@@ -1,71 +1,383 @@
 package keeper
 
 import (
+	"errors"
+	"strconv"
+
 	"zenoda/x/rewards/types"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DistributeRewards distributes rewards based on transaction volume and predefined governance wallets.
-func (k Keeper) DistributeRewards(ctx sdk.Context) {
-	// Retrieve parameters
-	params := k.GetParams(ctx)
+// GetRewardPool returns the reward pool: the balance of the rewards pool
+// module account in every denom, waiting for the next distribution. EGV
+// inflation, fee-share proceeds and top-ups are all paid into it.
+func (k Keeper) GetRewardPool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.RewardsModuleName))
+}
+
+// GetInflationReward returns the EGV inflation a distribution mints into the
+// reward pool, inflation_rate * total_supply, reduced so that the supply
+// stays within max_supply.
+func (k Keeper) GetInflationReward(ctx sdk.Context) (sdk.Coin, error) {
+	inflationRate, err := k.GetInflationRate(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	amount, err := k.GetMintableAmount(ctx, inflationRate.MulInt(k.GetTotalSupply(ctx).Amount).TruncateInt())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(k.GetRewardDenom(ctx), amount), nil
+}
 
-	// Fetch and parse the inflation rate
-	inflationRate, err := params.GetInflationRateAsDec()
+// GetRewardShares returns the share of the reward pool each predefined
+// governance wallet with a contribution receives. Contribution is the
+// transaction count or the decayed contribution score, following the
+// contribution score mode, multiplied by the wallet's lock boost. Shares are
+// capped at max_share_per_address. Wallets suspended for inactivity get no
+// share and the capped share of a wallet under a reward reduction is cut by
+// inactivity_reward_reduction, the rest staying in the pool.
+func (k Keeper) GetRewardShares(ctx sdk.Context) (shares []types.RewardShare, err error) {
+	// Retrieve parameters
+	params, err := k.GetParams(ctx)
 	if err != nil {
-		k.Logger().Error("Invalid inflation rate in params", "error", err)
-		return
+		return nil, err
+	}
+	maxShare, err := params.GetMaxSharePerAddressAsDec()
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidParams, "invalid max share per address: %s", err)
 	}
 
-	// Get total supply of EGV tokens
-	totalSupply := k.GetTotalSupply(ctx).Amount
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// Get total network transactions
-	totalTx := k.GetTotalTransactions(ctx)
-	if totalTx == 0 {
-		k.Logger().Info("No transactions recorded on the network; skipping rewards distribution")
-		return
+	// Get total network contribution
+	totalContribution, err := k.GetTotalEffectiveContribution(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !totalContribution.IsPositive() {
+		return nil, nil
 	}
 
-	// Iterate over predefined governance addresses and distribute rewards
+	var factors []math.LegacyDec
+
 	for _, walletAddr := range params.PredefinedWallets {
 		// Convert wallet address to AccAddress
-		addr, err := sdk.AccAddressFromBech32(walletAddr)
+		bz, err := k.addressCodec.StringToBytes(walletAddr)
 		if err != nil {
 			k.Logger().Error("Invalid predefined wallet address", "address", walletAddr, "error", err)
 			continue
 		}
+		addr := sdk.AccAddress(bz)
+
+		// Get contribution for the wallet address
+		contribution, err := k.GetEffectiveContribution(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		if !contribution.IsPositive() {
+			continue
+		}
 
-		// Get transaction count for the wallet address
-		individualTx := k.GetTransactionCount(ctx, addr)
-		if individualTx == 0 {
-			k.Logger().Info("No transactions for wallet; skipping reward", "address", addr.String())
+		// Suspended wallets get no share; reduced ones lose part of it below
+		factor, err := k.rewardShareFactor(ctx, params, addr, epoch)
+		if err != nil {
+			return nil, err
+		}
+		if factor.IsZero() {
 			continue
 		}
+		factors = append(factors, factor)
+
+		shares = append(shares, types.RewardShare{
+			Address:       addr,
+			Contribution:  contribution,
+			UncappedShare: contribution.Quo(totalContribution),
+		})
+	}
+
+	types.ApplyShareCap(shares, maxShare)
+	for i := range shares {
+		shares[i].Share = shares[i].Share.Mul(factors[i])
+	}
+	return shares, nil
+}
+
+// DistributeEpochRewards mints the EGV inflation, inflation_rate *
+// total_supply up to max_supply, into the reward pool and distributes the
+// pool at each epoch boundary. Each wallet receives
+// (individual_address_contribution / total_network_contribution) of every
+// denom in the pool, capped at max_share_per_address with the excess shared
+// out among the other wallets. Without governance wallet contributions
+// nothing is minted and the pool is kept for the next epoch.
+func (k Keeper) DistributeEpochRewards(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeight() <= 0 || uint64(ctx.BlockHeight())%params.EpochLength != 0 {
+		return nil
+	}
+
+	shares, err := k.GetRewardShares(ctx)
+	if err != nil {
+		return err
+	}
+	if len(shares) == 0 {
+		return nil
+	}
+	if err := k.mintInflationReward(ctx); err != nil {
+		return err
+	}
+	if k.GetRewardPool(ctx).IsZero() {
+		return nil
+	}
+	return k.distributeRewardPool(ctx, shares)
+}
+
+// mintInflationReward mints the EGV inflation, capped at max_supply, into the
+// reward pool.
+func (k Keeper) mintInflationReward(ctx sdk.Context) error {
+	inflation, err := k.GetInflationReward(ctx)
+	if err != nil {
+		return err
+	}
+	if !inflation.IsPositive() {
+		return nil
+	}
+	if err := k.MintEGV(ctx, inflation.Amount); err != nil {
+		return err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.RewardsModuleName, sdk.NewCoins(inflation))
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to add EGV inflation to the reward pool")
+	}
+	return nil
+}
 
-		// Calculate reward using the formula:
-		// (individual_address_transactions / total_network_transactions) * (inflation_rate * total_supply)
-		reward := inflationRate.MulInt(totalSupply).
-			Mul(math.LegacyNewDec(int64(individualTx))).
-			Quo(math.LegacyNewDec(int64(totalTx))).
-			TruncateInt()
+// distributeRewardPool burns burn_fraction of the EGV in the reward pool and
+// pays every denom of the rest out by the given shares to each wallet's
+// reward withdraw address. reward_vesting_fraction of
+// the EGV part is held in a vesting position released over
+// reward_vesting_duration blocks instead. Rounding dust stays in the pool.
+func (k Keeper) distributeRewardPool(ctx sdk.Context, shares []types.RewardShare) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	vestingFraction, err := params.GetRewardVestingFractionAsDec()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidParams, "invalid reward vesting fraction: %s", err)
+	}
+
+	burn, err := k.GetRewardBurn(ctx, k.GetRewardPool(ctx))
+	if err != nil {
+		return err
+	}
+	if burn.IsPositive() {
+		if err := k.BurnEGV(ctx, types.RewardsModuleName, burn); err != nil {
+			return err
+		}
+	}
+
+	pool := k.GetRewardPool(ctx)
+	for _, share := range shares {
+		addr := share.Address
+		addrStr, err := k.addressString(addr)
+		if err != nil {
+			return err
+		}
+		reward := types.ShareOfCoins(pool, share.Share)
+		uncappedReward := types.ShareOfCoins(pool, share.UncappedShare)
 
 		if reward.IsZero() {
-			k.Logger().Info("Calculated reward is zero; skipping distribution", "address", addr.String())
+			k.Logger().Info("Calculated reward is zero; skipping distribution", "address", addrStr)
 			continue
 		}
 
-		// Send reward to the address
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx, types.ModuleName, addr, sdk.NewCoins(sdk.NewCoin(types.EGVDenom, reward)),
-		)
+		// Send reward to the address's withdraw address
+		withdrawAddr, err := k.GetRewardWithdrawAddress(ctx, addr)
 		if err != nil {
-			k.Logger().Error("Failed to send reward", "address", addr.String(), "error", err)
-			continue
+			return err
+		}
+		withdrawAddrStr, err := k.addressString(withdrawAddr)
+		if err != nil {
+			return err
+		}
+
+		// Split the EGV part of the reward into a vesting and a liquid part
+		vestingAmount := vestingFraction.MulInt(reward.AmountOf(params.RewardDenom)).TruncateInt()
+		liquid := reward.Sub(sdk.NewCoin(params.RewardDenom, vestingAmount))
+
+		if !liquid.IsZero() {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsModuleName, withdrawAddr, liquid)
+			if err != nil {
+				k.Logger().Error("Failed to send reward", "address", addrStr, "withdraw_address", withdrawAddrStr, "error", err)
+				continue
+			}
+		}
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyRecipient, addrStr),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddrStr),
+			sdk.NewAttribute(types.AttributeKeyAmount, reward.String()),
+			sdk.NewAttribute(types.AttributeKeyUncappedAmount, uncappedReward.String()),
+			sdk.NewAttribute(types.AttributeKeyShare, share.Share.String()),
+			sdk.NewAttribute(types.AttributeKeyUncappedShare, share.UncappedShare.String()),
+			sdk.NewAttribute(types.AttributeKeyVestingAmount, vestingAmount.String()),
+		}
+		if vestingAmount.IsPositive() {
+			position, err := k.CreateVestingPosition(ctx, withdrawAddr, vestingAmount)
+			if err != nil {
+				return err
+			}
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyVestingPositionID, strconv.FormatUint(position.Id, 10)))
+		}
+
+		if err := k.recordRewards(ctx, addr, reward); err != nil {
+			return err
 		}
 
-		k.Logger().Info("Reward distributed successfully", "address", addr.String(), "reward", reward.String())
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDistributeReward, attributes...))
+
+		k.Logger().Info("Reward distributed successfully", "address", addrStr, "reward", reward.String())
+	}
+
+	return nil
+}
+
+// ---------------------- REWARD HISTORY ----------------------
+
+// recordRewards adds a distributed reward to the current epoch's totals and
+// to the address's lifetime totals, per denom.
+func (k Keeper) recordRewards(ctx sdk.Context, addr sdk.AccAddress, reward sdk.Coins) error {
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
 	}
+	for _, coin := range reward {
+		epochKey := collections.Join(epoch, coin.Denom)
+		total, err := k.epochRewards.Get(ctx, epochKey)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(err, "failed to retrieve epoch %d rewards", epoch)
+		}
+		if total.IsNil() {
+			total = math.ZeroInt()
+		}
+		if err := k.epochRewards.Set(ctx, epochKey, total.Add(coin.Amount)); err != nil {
+			return errorsmod.Wrapf(err, "failed to store epoch %d rewards", epoch)
+		}
+
+		addressKey := collections.Join(addr, coin.Denom)
+		received, err := k.addressRewards.Get(ctx, addressKey)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(err, "failed to retrieve rewards of %s", addr)
+		}
+		if received.IsNil() {
+			received = math.ZeroInt()
+		}
+		if err := k.addressRewards.Set(ctx, addressKey, received.Add(coin.Amount)); err != nil {
+			return errorsmod.Wrapf(err, "failed to store rewards of %s", addr)
+		}
+	}
+	return nil
+}
+
+// GetEpochRewards returns the rewards distributed per denom in each epoch
+// from start to end inclusive, clipped to the retention window, together with
+// their sum.
+func (k Keeper) GetEpochRewards(ctx sdk.Context, start, end uint64) (list []types.EpochReward, total sdk.Coins, err error) {
+	oldest, err := k.OldestRetainedEpoch(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	start = max(start, oldest)
+	if start > end {
+		return nil, total, nil
+	}
+
+	ranger := new(collections.Range[collections.Pair[uint64, string]]).
+		StartInclusive(collections.Join(start, "")).
+		EndExclusive(collections.Join(end+1, ""))
+	err = k.epochRewards.Walk(ctx, ranger, func(key collections.Pair[uint64, string], amount math.Int) (bool, error) {
+		coin := sdk.NewCoin(key.K2(), amount)
+		if n := len(list); n == 0 || list[n-1].Epoch != key.K1() {
+			list = append(list, types.EpochReward{Epoch: key.K1()})
+		}
+		list[len(list)-1].Rewards = list[len(list)-1].Rewards.Add(coin)
+		total = total.Add(coin)
+		return false, nil
+	})
+	return list, total, err
+}
+
+// GetAddressRewards returns the rewards paid out per denom to an address.
+func (k Keeper) GetAddressRewards(ctx sdk.Context, addr sdk.AccAddress) (rewards sdk.Coins, err error) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, string](addr)
+	err = k.addressRewards.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		rewards = rewards.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	return rewards, err
+}
+
+// SetEpochReward stores the rewards distributed per denom in an epoch.
+func (k Keeper) SetEpochReward(ctx sdk.Context, reward types.EpochReward) error {
+	for _, coin := range reward.Rewards {
+		if err := k.epochRewards.Set(ctx, collections.Join(reward.Epoch, coin.Denom), coin.Amount); err != nil {
+			return errorsmod.Wrapf(err, "failed to store epoch %d rewards", reward.Epoch)
+		}
+	}
+	return nil
+}
+
+// GetAllEpochRewards returns the rewards distributed in every retained epoch.
+func (k Keeper) GetAllEpochRewards(ctx sdk.Context) (list []types.EpochReward, err error) {
+	err = k.epochRewards.Walk(ctx, nil, func(key collections.Pair[uint64, string], amount math.Int) (bool, error) {
+		if n := len(list); n == 0 || list[n-1].Epoch != key.K1() {
+			list = append(list, types.EpochReward{Epoch: key.K1()})
+		}
+		list[len(list)-1].Rewards = list[len(list)-1].Rewards.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	return list, err
+}
+
+// SetAddressRewards stores the rewards paid out per denom to an address.
+func (k Keeper) SetAddressRewards(ctx sdk.Context, reward types.AddressReward) error {
+	addr, err := k.accAddress(reward.Address)
+	if err != nil {
+		return err
+	}
+	for _, coin := range reward.Rewards {
+		if err := k.addressRewards.Set(ctx, collections.Join(addr, coin.Denom), coin.Amount); err != nil {
+			return errorsmod.Wrapf(err, "failed to store rewards of %s", reward.Address)
+		}
+	}
+	return nil
+}
+
+// GetAllAddressRewards returns the rewards paid out to every address.
+func (k Keeper) GetAllAddressRewards(ctx sdk.Context) (list []types.AddressReward, err error) {
+	var last sdk.AccAddress
+	err = k.addressRewards.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		if len(list) == 0 || !last.Equals(key.K1()) {
+			address, err := k.addressString(key.K1())
+			if err != nil {
+				return true, err
+			}
+			list = append(list, types.AddressReward{Address: address})
+			last = key.K1()
+		}
+		list[len(list)-1].Rewards = list[len(list)-1].Rewards.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	return list, err
 }
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/types"
+)
+
+// distributeEpochRewards runs DistributeEpochRewards at the last block of the
+// epoch ctx is in and returns the context at that height.
+func distributeEpochRewards(t *testing.T, k keeper.Keeper, ctx sdk.Context) sdk.Context {
+	t.Helper()
+	epochLength := int64(getParams(t, k, ctx).EpochLength)
+	if height := ctx.BlockHeight(); height <= 0 || height%epochLength != 0 {
+		ctx = ctx.WithBlockHeight((height/epochLength + 1) * epochLength)
+	}
+	require.NoError(t, k.DistributeEpochRewards(ctx))
+	return ctx
+}
+
+func TestDistributeRewardsShareCap(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+
+	params := getParams(t, k, ctx)
+	params.MaxSharePerAddress = "0.5"
+	require.NoError(t, k.SetParams(ctx, params))
+
+	supply := sdk.NewCoins(sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(100_000)))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, supply))
+
+	// A dominant wallet with 8 of 10 transactions and two small ones.
+	wallets := getPredefinedAddresses(t, k, ctx)
+	for i, count := range []int{8, 1, 1} {
+		for n := 0; n < count; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, wallets[i]))
+		}
+	}
+
+	// The pool is 5% of the supply; the dominant wallet is held at half of it.
+	res, err := k.EstimatedReward(ctx, &types.QueryEstimatedRewardRequest{Address: wallets[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEstimatedRewardResponse{
+		Reward:          "2500",
+		UncappedReward:  "4000",
+		Share:           "0.500000000000000000",
+		UncappedShare:   "0.800000000000000000",
+		Rewards:         sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 2500)),
+		UncappedRewards: sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 4000)),
+	}, res)
+	res, err = k.EstimatedReward(ctx, &types.QueryEstimatedRewardRequest{Address: wallets[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, "1250", res.Reward)
+	require.Equal(t, "500", res.UncappedReward)
+
+	ctx = distributeEpochRewards(t, k, ctx)
+	for i, expected := range []int64{2500, 1250, 1250} {
+		require.Equal(t, math.NewInt(expected), k.GetBankKeeper().GetBalance(ctx, wallets[i], types.DefaultRewardDenom).Amount)
+	}
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeDistributeReward {
+			continue
+		}
+		if attr, _ := event.GetAttribute(types.AttributeKeyRecipient); attr.Value == wallets[0].String() {
+			amount, _ := event.GetAttribute(types.AttributeKeyAmount)
+			uncapped, _ := event.GetAttribute(types.AttributeKeyUncappedAmount)
+			require.Equal(t, "2500egv", amount.Value)
+			require.Equal(t, "4000egv", uncapped.Value)
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestDistributeRewardsMultiDenom(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+
+	supply := sdk.NewCoins(sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(100_000)))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, supply))
+
+	// The pool already holds fee-share proceeds and an IBC top-up.
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin(ibcDenom, 400))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, topUp))
+	require.NoError(t, k.GetBankKeeper().SendCoinsFromModuleToModule(ctx, types.ModuleName, types.RewardsModuleName, topUp))
+
+	res, err := k.RewardPool(ctx, &types.QueryRewardPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, topUp, res.Pool)
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	for i, count := range []int{3, 1} {
+		for n := 0; n < count; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, wallets[i]))
+		}
+	}
+
+	// Every denom is split 3:1, EGV inflation included.
+	ctx = distributeEpochRewards(t, k, ctx)
+	expected := []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 3750), sdk.NewInt64Coin("stake", 750), sdk.NewInt64Coin(ibcDenom, 300)),
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 1250), sdk.NewInt64Coin("stake", 250), sdk.NewInt64Coin(ibcDenom, 100)),
+	}
+	for i, rewards := range expected {
+		require.Equal(t, rewards, k.GetBankKeeper().GetAllBalances(ctx, wallets[i]))
+
+		addressRes, err := k.AddressRewards(ctx, &types.QueryAddressRewardsRequest{Address: wallets[i].String()})
+		require.NoError(t, err)
+		require.Equal(t, rewards, addressRes.Rewards)
+	}
+	require.True(t, k.GetRewardPool(ctx).IsZero())
+
+	epochRes, err := k.EpochRewards(ctx, &types.QueryEpochRewardsRequest{})
+	require.NoError(t, err)
+	total := expected[0].Add(expected[1]...)
+	require.Equal(t, []types.EpochReward{{Epoch: 1, Rewards: total}}, epochRes.Rewards)
+	require.Equal(t, total, epochRes.Total)
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- DECAYED CONTRIBUTION SCORE ----------------------
+
+// addContributionScore adds one transaction to the decayed score of an
+// address and to the network score. Scores are only decayed when they are
+// touched: the stored value is brought forward to the current epoch first.
+func (k Keeper) addContributionScore(ctx sdk.Context, addr sdk.AccAddress) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := k.contributionScores.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(err, "failed to retrieve contribution score of %s", addr)
+	}
+	score, err := stored.DecayedTo(epoch, params.ScoreHalfLifeEpochs)
+	if err != nil {
+		return err
+	}
+	if err := k.contributionScores.Set(ctx, addr, types.NewContributionScore(score.Add(math.LegacyOneDec()), epoch)); err != nil {
+		return errorsmod.Wrapf(err, "failed to store contribution score of %s", addr)
+	}
+
+	// Every score decays by the same factor, so the network score can be
+	// kept as a single lazily decayed value.
+	storedTotal, err := k.totalContributionScore.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(err, "failed to retrieve total contribution score")
+	}
+	total, err := storedTotal.DecayedTo(epoch, params.ScoreHalfLifeEpochs)
+	if err != nil {
+		return err
+	}
+	if err := k.totalContributionScore.Set(ctx, types.NewContributionScore(total.Add(math.LegacyOneDec()), epoch)); err != nil {
+		return errorsmod.Wrap(err, "failed to store total contribution score")
+	}
+	return nil
+}
+
+// GetContributionScore returns the decayed score of an address in the
+// current epoch.
+func (k Keeper) GetContributionScore(ctx sdk.Context, addr sdk.AccAddress) (math.LegacyDec, error) {
+	stored, err := k.contributionScores.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, errorsmod.Wrapf(err, "failed to retrieve contribution score of %s", addr)
+	}
+	return k.decayToCurrentEpoch(ctx, stored)
+}
+
+// GetTotalContributionScore returns the decayed score of the whole network in
+// the current epoch.
+func (k Keeper) GetTotalContributionScore(ctx sdk.Context) (math.LegacyDec, error) {
+	stored, err := k.totalContributionScore.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, errorsmod.Wrap(err, "failed to retrieve total contribution score")
+	}
+	return k.decayToCurrentEpoch(ctx, stored)
+}
+
+// SetContributionScore stores the score of an address as of the epoch it was
+// last updated in.
+func (k Keeper) SetContributionScore(ctx sdk.Context, score types.AddressContributionScore) error {
+	addr, err := k.accAddress(score.Address)
+	if err != nil {
+		return err
+	}
+	return k.contributionScores.Set(ctx, addr, score.Score)
+}
+
+// GetAllContributionScores returns the stored, undecayed score of every
+// address.
+func (k Keeper) GetAllContributionScores(ctx sdk.Context) (list []types.AddressContributionScore, err error) {
+	err = k.contributionScores.Walk(ctx, nil, func(addr sdk.AccAddress, score types.ContributionScore) (bool, error) {
+		address, err := k.addressString(addr)
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.AddressContributionScore{Address: address, Score: score})
+		return false, nil
+	})
+	return list, err
+}
+
+// SetStoredTotalContributionScore stores the network score as of the epoch it
+// was last updated in.
+func (k Keeper) SetStoredTotalContributionScore(ctx sdk.Context, score types.ContributionScore) error {
+	return k.totalContributionScore.Set(ctx, score)
+}
+
+// GetStoredTotalContributionScore returns the stored, undecayed network score
+// and whether one was stored.
+func (k Keeper) GetStoredTotalContributionScore(ctx sdk.Context) (types.ContributionScore, bool, error) {
+	score, err := k.totalContributionScore.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ContributionScore{}, false, nil
+	}
+	if err != nil {
+		return types.ContributionScore{}, false, errorsmod.Wrap(err, "failed to retrieve total contribution score")
+	}
+	return score, true, nil
+}
+
+// decayToCurrentEpoch decays a stored score to the current epoch without
+// writing it back.
+func (k Keeper) decayToCurrentEpoch(ctx sdk.Context, stored types.ContributionScore) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return stored.DecayedTo(epoch, params.ScoreHalfLifeEpochs)
+}
+
+// ---------------------- CONTRIBUTION ----------------------
+
+// GetContribution returns what rewards and voting weights are computed from
+// for an address: its lifetime transaction count or its decayed score,
+// following the contribution score mode.
+func (k Keeper) GetContribution(ctx sdk.Context, addr sdk.AccAddress) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if params.ContributionScoreMode == types.ContributionScoreDecayed {
+		return k.GetContributionScore(ctx, addr)
+	}
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(k.GetTransactionCount(ctx, addr))), nil
+}
+
+// GetTotalContribution returns the network counterpart of GetContribution.
+func (k Keeper) GetTotalContribution(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if params.ContributionScoreMode == types.ContributionScoreDecayed {
+		return k.GetTotalContributionScore(ctx)
+	}
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(k.GetTotalTransactions(ctx))), nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/types"
+)
+
+func TestDecayedContributionScore(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.ScoreHalfLifeEpochs = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	early, late := wallets[0], wallets[1]
+
+	// The early wallet is busy in epoch 0, the late one in epoch 4.
+	for i := 0; i < 4; i++ {
+		require.NoError(t, k.IncrementTransactionCount(ctx.WithBlockHeight(5), early))
+	}
+	ctx = ctx.WithBlockHeight(45)
+	require.NoError(t, k.IncrementTransactionCount(ctx, late))
+
+	// Two half-lives later the early wallet's 4 transactions weigh 1.
+	score, err := k.GetContributionScore(ctx, early)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), score)
+	total, err := k.GetTotalContributionScore(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), total)
+
+	// One epoch later every score has decayed by the same factor.
+	score, err = k.GetContributionScore(ctx.WithBlockHeight(55), late)
+	require.NoError(t, err)
+	require.Equal(t, "0.707106781186547525", score.String())
+
+	// Lifetime mode weighs the early wallet four times as much.
+	weight, err := k.GetVotingWeight(ctx, early)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(4), weight)
+
+	params.ContributionScoreMode = types.ContributionScoreDecayed
+	require.NoError(t, k.SetParams(ctx, params))
+	earlyWeight, err := k.GetVotingWeight(ctx, early)
+	require.NoError(t, err)
+	lateWeight, err := k.GetVotingWeight(ctx, late)
+	require.NoError(t, err)
+	require.Equal(t, earlyWeight, lateWeight)
+
+	res, err := k.ContributionScore(ctx, &types.QueryContributionScoreRequest{Address: early.String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryContributionScoreResponse{
+		Score:                 "1.000000000000000000",
+		TotalScore:            "2.000000000000000000",
+		ContributionScoreMode: types.ContributionScoreDecayed,
+	}, res)
+}
+
+func TestDecayedRewardDistribution(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 10
+	params.ScoreHalfLifeEpochs = 1
+	params.ContributionScoreMode = types.ContributionScoreDecayed
+	require.NoError(t, k.SetParams(ctx, params))
+
+	supply := sdk.NewCoins(sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(100_000)))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, supply))
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	early, late := wallets[0], wallets[1]
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.IncrementTransactionCount(ctx.WithBlockHeight(5), early))
+	}
+	ctx = ctx.WithBlockHeight(25)
+	require.NoError(t, k.IncrementTransactionCount(ctx, late))
+
+	// Scores are 0.75 and 1: the late wallet earns more despite fewer transactions.
+	ctx = distributeEpochRewards(t, k, ctx)
+	earlyReward := k.GetBankKeeper().GetBalance(ctx, early, types.DefaultRewardDenom).Amount
+	lateReward := k.GetBankKeeper().GetBalance(ctx, late, types.DefaultRewardDenom).Amount
+	require.Equal(t, math.NewInt(2142), earlyReward)
+	require.Equal(t, math.NewInt(2857), lateReward)
+}
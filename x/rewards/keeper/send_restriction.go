@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- SOULBOUND EGV ----------------------
+
+// SendRestrictionFn is registered with x/bank and runs on every transfer.
+// While soulbound_egv is set it rejects EGV transfers between user accounts.
+// Transfers from or to module accounts (minting, reward payouts, burns,
+// governance deposits) and transfers to egv_transfer_allowlist recipients go
+// through.
+func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	params, err := k.GetParams(sdk.UnwrapSDKContext(ctx))
+	if errors.Is(err, types.ErrParamsNotFound) {
+		// Nothing is restricted before the rewards genesis has run.
+		return toAddr, nil
+	}
+	if err != nil {
+		return toAddr, err
+	}
+	if !params.SoulboundEgv || amt.AmountOf(params.RewardDenom).IsZero() {
+		return toAddr, nil
+	}
+
+	if k.IsBlockedAddress(ctx, fromAddr) || k.IsBlockedAddress(ctx, toAddr) {
+		return toAddr, nil
+	}
+	if isEGVTransferAllowlisted(params, toAddr) {
+		return toAddr, nil
+	}
+
+	return toAddr, errorsmod.Wrapf(
+		types.ErrEGVNonTransferable,
+		"%s cannot be sent from %s to %s", amt.AmountOf(params.RewardDenom), fromAddr, toAddr,
+	)
+}
+
+// isEGVTransferAllowlisted reports whether the address is on the EGV transfer
+// allowlist. Addresses are compared by bytes so any Bech32 prefix matches.
+func isEGVTransferAllowlisted(params types.Params, addr sdk.AccAddress) bool {
+	for _, allowed := range params.EgvTransferAllowlist {
+		_, bz, err := bech32.DecodeAndConvert(allowed)
+		if err == nil && addr.Equals(sdk.AccAddress(bz)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/types"
+)
+
+func TestSoulboundEGV(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	// Minting creates the rewards module account.
+	require.NoError(t, k.MintEGV(ctx, math.NewInt(100)))
+
+	wallets := getPredefinedAddresses(t, k, ctx)
+	from, to, allowed := wallets[0], wallets[1], wallets[2]
+	module := authtypes.NewModuleAddress(types.ModuleName)
+	egv := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 10))
+	stake := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	// EGV is transferable unless soulbound_egv is set.
+	_, err := k.SendRestrictionFn(ctx, from, to, egv)
+	require.NoError(t, err)
+
+	params := getParams(t, k, ctx)
+	params.SoulboundEgv = true
+	params.EgvTransferAllowlist = []string{allowed.String()}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = k.SendRestrictionFn(ctx, from, to, egv)
+	require.ErrorIs(t, err, types.ErrEGVNonTransferable)
+	_, err = k.SendRestrictionFn(ctx, from, to, egv.Add(stake...))
+	require.ErrorIs(t, err, types.ErrEGVNonTransferable)
+
+	// Other denoms, module flows and allowlisted recipients are not restricted.
+	_, err = k.SendRestrictionFn(ctx, from, to, stake)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, module, to, egv)
+	require.NoError(t, err)
+	_, err = k.SendRestrictionFn(ctx, from, module, egv)
+	require.NoError(t, err)
+	newTo, err := k.SendRestrictionFn(ctx, from, allowed, egv)
+	require.NoError(t, err)
+	require.Equal(t, allowed, newTo)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- SUPPLY CAP AND BURN ----------------------
+
+// GetBurnedSupply returns the EGV burned from reward distributions so far.
+func (k Keeper) GetBurnedSupply(ctx sdk.Context) (math.Int, error) {
+	burned, err := k.burnedSupply.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	if err != nil {
+		return math.Int{}, errorsmod.Wrap(err, "failed to retrieve burned supply")
+	}
+	return burned, nil
+}
+
+// SetBurnedSupply stores the EGV burned from reward distributions so far.
+func (k Keeper) SetBurnedSupply(ctx sdk.Context, burned math.Int) error {
+	if err := k.burnedSupply.Set(ctx, burned); err != nil {
+		return errorsmod.Wrap(err, "failed to store burned supply")
+	}
+	return nil
+}
+
+// GetMintableAmount returns how much of amount can be minted without taking
+// the EGV supply above max_supply.
+func (k Keeper) GetMintableAmount(ctx sdk.Context, amount math.Int) (math.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	maxSupply, err := params.GetMaxSupplyAsInt()
+	if err != nil {
+		return math.Int{}, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+	if maxSupply.IsZero() {
+		return amount, nil
+	}
+	room := maxSupply.Sub(k.GetTotalSupply(ctx).Amount)
+	if !room.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+	return math.MinInt(amount, room), nil
+}
+
+// MintEGV mints EGV to the rewards module account. Minting above max_supply
+// fails.
+func (k Keeper) MintEGV(ctx sdk.Context, amount math.Int) error {
+	mintable, err := k.GetMintableAmount(ctx, amount)
+	if err != nil {
+		return err
+	}
+	if mintable.LT(amount) {
+		return errorsmod.Wrapf(types.ErrSupplyCapExceeded, "minting %s would exceed the cap by %s", amount, amount.Sub(mintable))
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(k.GetRewardDenom(ctx), amount))); err != nil {
+		return err
+	}
+	if err := k.SetTotalSupply(ctx, k.GetTotalSupply(ctx)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintInflation,
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// BurnEGV burns EGV held by a module account of the rewards module and adds
+// it to the burned supply.
+func (k Keeper) BurnEGV(ctx sdk.Context, fromModule string, amount math.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(k.GetRewardDenom(ctx), amount))
+	if fromModule != types.ModuleName {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, fromModule, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	burned, err := k.GetBurnedSupply(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.SetBurnedSupply(ctx, burned.Add(amount)); err != nil {
+		return err
+	}
+	if err := k.SetTotalSupply(ctx, k.GetTotalSupply(ctx)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnRewards,
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// GetRewardBurn returns the EGV burn_fraction takes from a reward pool.
+func (k Keeper) GetRewardBurn(ctx sdk.Context, pool sdk.Coins) (math.Int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	burnFraction, err := params.GetBurnFractionAsDec()
+	if err != nil {
+		return math.Int{}, errorsmod.Wrapf(types.ErrInvalidParams, "invalid burn fraction: %s", err)
+	}
+	return burnFraction.MulInt(pool.AmountOf(params.RewardDenom)).TruncateInt(), nil
+}
+
+// GetSupplyInfo returns the EGV minted, burned, in existence and in
+// circulation, that is not held by the rewards module accounts or locked, and
+// the supply cap.
+func (k Keeper) GetSupplyInfo(ctx sdk.Context) (*types.QuerySupplyInfoResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	burned, err := k.GetBurnedSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := k.GetTotalSupply(ctx).Amount
+	circulating := supply
+	for _, moduleName := range []string{types.ModuleName, types.RewardsModuleName, types.VestingModuleName, types.CampaignModuleName, types.LockModuleName} {
+		moduleAddr := k.accountKeeper.GetModuleAddress(moduleName)
+		circulating = circulating.Sub(k.bankKeeper.GetBalance(ctx, moduleAddr, params.RewardDenom).Amount)
+	}
+
+	return &types.QuerySupplyInfoResponse{
+		Minted:      supply.Add(burned).String(),
+		Burned:      burned.String(),
+		Supply:      supply.String(),
+		Circulating: circulating.String(),
+		Cap:         params.MaxSupply,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/types"
+)
+
+func TestSupplyCap(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	require.NoError(t, k.MintEGV(ctx, math.NewInt(100_000)))
+
+	// Only 1000 of the 5000 EGV inflation fit under the cap.
+	params := getParams(t, k, ctx)
+	params.MaxSupply = "101000"
+	require.NoError(t, k.SetParams(ctx, params))
+
+	wallet := getPredefinedAddresses(t, k, ctx)[0]
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+
+	res, err := k.EstimatedReward(ctx, &types.QueryEstimatedRewardRequest{Address: wallet.String()})
+	require.NoError(t, err)
+	require.Equal(t, "1000", res.Reward)
+
+	ctx = distributeEpochRewards(t, k, ctx)
+	require.Equal(t, math.NewInt(1000), k.GetBankKeeper().GetBalance(ctx, wallet, types.DefaultRewardDenom).Amount)
+	require.Equal(t, math.NewInt(101_000), k.GetTotalSupply(ctx).Amount)
+
+	// At the cap minting stops.
+	ctx = distributeEpochRewards(t, k, ctx)
+	require.Equal(t, math.NewInt(101_000), k.GetTotalSupply(ctx).Amount)
+	require.ErrorIs(t, k.MintEGV(ctx, math.OneInt()), types.ErrSupplyCapExceeded)
+}
+
+func TestRewardBurn(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	require.NoError(t, k.MintEGV(ctx, math.NewInt(100_000)))
+
+	params := getParams(t, k, ctx)
+	params.BurnFraction = "0.2"
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Other denoms in the pool are not burned.
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, topUp))
+	require.NoError(t, k.GetBankKeeper().SendCoinsFromModuleToModule(ctx, types.ModuleName, types.RewardsModuleName, topUp))
+
+	wallet := getPredefinedAddresses(t, k, ctx)[0]
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+
+	res, err := k.EstimatedReward(ctx, &types.QueryEstimatedRewardRequest{Address: wallet.String()})
+	require.NoError(t, err)
+	require.Equal(t, "4000", res.Reward)
+
+	// A fifth of the 5000 EGV inflation is burned.
+	ctx = distributeEpochRewards(t, k, ctx)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 4000), sdk.NewInt64Coin("stake", 500)), k.GetBankKeeper().GetAllBalances(ctx, wallet))
+
+	info, err := k.SupplyInfo(ctx, &types.QuerySupplyInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySupplyInfoResponse{
+		Minted:      "105000",
+		Burned:      "1000",
+		Supply:      "104000",
+		Circulating: "4000", // the 100000 minted up front are still held by the module
+		Cap:         "0",
+	}, info)
+
+	// Locked and unlocking EGV is not circulating either.
+	_, err = k.LockEGV(ctx, wallet, math.NewInt(1500))
+	require.NoError(t, err)
+	_, err = k.UnlockEGV(ctx, wallet, math.NewInt(500))
+	require.NoError(t, err)
+	info, err = k.SupplyInfo(ctx, &types.QuerySupplyInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "104000", info.Supply)
+	require.Equal(t, "2500", info.Circulating)
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- TIMELOCK SETTINGS ----------------------
+
+// GetParamChangeDelay returns the number of blocks a governance params update
+// waits in the timelock queue.
+func (k Keeper) GetParamChangeDelay(ctx sdk.Context) (uint64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return params.ParamChangeDelay, nil
+}
+
+// VetoThresholdReached reports whether the given vetoes from governance
+// wallets are enough to cancel a queued params update. Vetoes from addresses
+// that are no longer governance wallets are ignored.
+func (k Keeper) VetoThresholdReached(ctx sdk.Context, vetoes []string) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	threshold, err := params.GetVetoThresholdAsDec()
+	if err != nil {
+		return false, errorsmod.Wrapf(types.ErrInvalidParams, "invalid veto threshold: %s", err)
+	}
+
+	wallets, err := k.GetPredefinedAddresses(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(wallets) == 0 {
+		return false, nil
+	}
+
+	count := 0
+	for _, veto := range vetoes {
+		addr, err := k.accAddress(veto)
+		if err != nil {
+			continue
+		}
+		if k.isPredefinedAddress(addr, wallets) {
+			count++
+		}
+	}
+
+	return math.LegacyNewDec(int64(count)).GTE(threshold.MulInt64(int64(len(wallets)))), nil
+}
+
+// ---------------------- TIMELOCK QUEUE ----------------------
+
+// SetQueuedParamChange stores a params update in the timelock queue and moves
+// the queue sequence past its id.
+func (k Keeper) SetQueuedParamChange(ctx sdk.Context, change types.QueuedParamChange) error {
+	if err := k.queuedParamChanges.Set(ctx, change.Id, change); err != nil {
+		return errorsmod.Wrapf(err, "failed to store queued params change %d", change.Id)
+	}
+
+	next, err := k.queuedParamChangeSeq.Peek(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to retrieve queued params change sequence")
+	}
+	if change.Id >= next {
+		return k.queuedParamChangeSeq.Set(ctx, change.Id+1)
+	}
+	return nil
+}
+
+// GetQueuedParamChange returns a queued params update by id.
+func (k Keeper) GetQueuedParamChange(ctx sdk.Context, id uint64) (types.QueuedParamChange, error) {
+	change, err := k.queuedParamChanges.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return change, errorsmod.Wrapf(types.ErrParamChangeNotFound, "id %d", id)
+	}
+	if err != nil {
+		return change, errorsmod.Wrapf(err, "failed to retrieve queued params change %d", id)
+	}
+	return change, nil
+}
+
+// RemoveQueuedParamChange removes a params update from the timelock queue.
+func (k Keeper) RemoveQueuedParamChange(ctx sdk.Context, id uint64) error {
+	if err := k.queuedParamChanges.Remove(ctx, id); err != nil {
+		return errorsmod.Wrapf(err, "failed to remove queued params change %d", id)
+	}
+	return nil
+}
+
+// GetAllQueuedParamChanges returns every params update in the timelock queue.
+func (k Keeper) GetAllQueuedParamChanges(ctx sdk.Context) (list []types.QueuedParamChange, err error) {
+	err = k.queuedParamChanges.Walk(ctx, nil, func(_ uint64, change types.QueuedParamChange) (bool, error) {
+		list = append(list, change)
+		return false, nil
+	})
+	return list, err
+}
+
+// QueueParamChange validates governance supplied params against the active
+// params and places them in the timelock queue.
+func (k Keeper) QueueParamChange(ctx sdk.Context, params types.Params) (types.QueuedParamChange, error) {
+	if err := k.ValidateParamsUpdate(ctx, params); err != nil {
+		return types.QueuedParamChange{}, err
+	}
+
+	delay, err := k.GetParamChangeDelay(ctx)
+	if err != nil {
+		return types.QueuedParamChange{}, err
+	}
+	id, err := k.queuedParamChangeSeq.Next(ctx)
+	if err != nil {
+		return types.QueuedParamChange{}, errorsmod.Wrap(err, "failed to retrieve queued params change sequence")
+	}
+	change := types.QueuedParamChange{
+		Id:            id,
+		Params:        params,
+		ExecuteHeight: ctx.BlockHeight() + int64(delay),
+	}
+	if err := k.SetQueuedParamChange(ctx, change); err != nil {
+		return types.QueuedParamChange{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangeQueued,
+			sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(change.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecuteHeight, strconv.FormatInt(change.ExecuteHeight, 10)),
+		),
+	)
+
+	return change, nil
+}
+
+// VetoParamChange records a governance wallet's veto against a queued params
+// update and cancels the update once the veto threshold is reached.
+func (k Keeper) VetoParamChange(ctx sdk.Context, id uint64, voter sdk.AccAddress) (bool, error) {
+	change, err := k.GetQueuedParamChange(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	wallets, err := k.GetPredefinedAddresses(ctx)
+	if err != nil {
+		return false, err
+	}
+	voterStr, err := k.addressString(voter)
+	if err != nil {
+		return false, err
+	}
+	if !k.isPredefinedAddress(voter, wallets) {
+		return false, errorsmod.Wrap(types.ErrNotGovernanceWallet, voterStr)
+	}
+	suspended, err := k.IsSuspended(ctx, voter)
+	if err != nil {
+		return false, err
+	}
+	if suspended {
+		return false, errorsmod.Wrap(types.ErrWalletSuspended, voterStr)
+	}
+	for _, veto := range change.Vetoes {
+		if veto == voterStr {
+			return false, errorsmod.Wrap(types.ErrAlreadyVetoed, voterStr)
+		}
+	}
+
+	change.Vetoes = append(change.Vetoes, voterStr)
+	if err := k.recordParticipation(ctx, voter, 0, 1); err != nil {
+		return false, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangeVetoed,
+			sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voterStr),
+			sdk.NewAttribute(types.AttributeKeyVetoes, strconv.Itoa(len(change.Vetoes))),
+		),
+	)
+
+	reached, err := k.VetoThresholdReached(ctx, change.Vetoes)
+	if err != nil {
+		return false, err
+	}
+	if reached {
+		if err := k.RemoveQueuedParamChange(ctx, id); err != nil {
+			return false, err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChangeCancelled,
+				sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(id, 10)),
+			),
+		)
+		return true, nil
+	}
+
+	return false, k.SetQueuedParamChange(ctx, change)
+}
+
+// ProcessQueuedParamChanges applies every queued params update whose delay
+// has elapsed. Each update is applied in a cached context: one that no longer
+// passes validation or fails part way is dropped without leaving any of its
+// writes behind.
+func (k Keeper) ProcessQueuedParamChanges(ctx sdk.Context) error {
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if ctx.BlockHeight() < change.ExecuteHeight {
+			continue
+		}
+
+		if err := k.RemoveQueuedParamChange(ctx, change.Id); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.UpdateParams(cacheCtx, change.Params); err != nil {
+			k.Logger().Error("Dropping queued params change", "id", change.Id, "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParamChangeFailed,
+					sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(change.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChangeExecuted,
+				sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(change.Id, 10)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"zenoda/testutil/sample"
+	"zenoda/x/rewards/types"
+)
+
+func TestParamChangeTimelock(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10)
+	params := sample.RewardsParams()
+	params.ParamChangeDelay = types.MinParamChangeDelay
+	require.NoError(t, k.SetParams(ctx, params))
+	executeHeight := 10 + int64(types.MinParamChangeDelay)
+
+	updated := params
+	updated.MaxMissedGovernanceActions = 100
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: updated})
+	require.NoError(t, err)
+
+	response, err := k.PendingParamChanges(ctx, &types.QueryPendingParamChangesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 1)
+	require.Equal(t, executeHeight, response.Changes[0].ExecuteHeight)
+
+	// Nothing is applied before the delay elapses.
+	require.NoError(t, k.ProcessQueuedParamChanges(ctx.WithBlockHeight(executeHeight-1)))
+	require.Equal(t, params.MaxMissedGovernanceActions, getParams(t, k, ctx).MaxMissedGovernanceActions)
+
+	require.NoError(t, k.ProcessQueuedParamChanges(ctx.WithBlockHeight(executeHeight)))
+	require.Equal(t, uint64(100), getParams(t, k, ctx).MaxMissedGovernanceActions)
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+func TestParamChangeDelayMinimum(t *testing.T) {
+	params := sample.RewardsParams()
+	params.ParamChangeDelay = 0
+	require.Error(t, params.Validate())
+	params.ParamChangeDelay = types.MinParamChangeDelay - 1
+	require.Error(t, params.Validate())
+	params.ParamChangeDelay = types.MinParamChangeDelay
+	require.NoError(t, params.Validate())
+}
+
+func TestFailedParamChangeIsDropped(t *testing.T) {
+	k, _, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	params := getParams(t, k, ctx)
+
+	invalid := params
+	invalid.MaxMissedGovernanceActions = 100
+	invalid.ParamChangeDelay = 0
+	require.NoError(t, k.SetQueuedParamChange(ctx, types.QueuedParamChange{Id: 0, Params: invalid, ExecuteHeight: 10}))
+
+	// The failing change neither halts the block nor leaves writes behind.
+	require.NoError(t, k.ProcessQueuedParamChanges(ctx))
+	require.Equal(t, params, getParams(t, k, ctx))
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	var failed bool
+	for _, event := range ctx.EventManager().Events() {
+		failed = failed || event.Type == types.EventTypeParamChangeFailed
+	}
+	require.True(t, failed)
+}
+
+func TestVetoParamChange(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := getParams(t, k, ctx)
+
+	change, err := k.QueueParamChange(ctx, params)
+	require.NoError(t, err)
+
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: k.GetAuthority(), ChangeId: change.Id})
+	require.ErrorIs(t, err, types.ErrNotGovernanceWallet)
+
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: params.PredefinedWallets[0], ChangeId: change.Id + 1})
+	require.ErrorIs(t, err, types.ErrParamChangeNotFound)
+
+	// Ten governance wallets with a 0.67 threshold need seven vetoes.
+	for i, wallet := range params.PredefinedWallets[:7] {
+		res, err := ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: wallet, ChangeId: change.Id})
+		require.NoError(t, err)
+		require.Equal(t, i == 6, res.Cancelled)
+
+		if i == 0 {
+			_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: wallet, ChangeId: change.Id})
+			require.ErrorIs(t, err, types.ErrAlreadyVetoed)
+		}
+	}
+
+	_, err = k.GetQueuedParamChange(ctx, change.Id)
+	require.ErrorIs(t, err, types.ErrParamChangeNotFound)
+}
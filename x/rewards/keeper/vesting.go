@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- REWARD VESTING ----------------------
+
+// SetVestingPosition stores a reward vesting position and moves the position
+// id sequence past its id.
+func (k Keeper) SetVestingPosition(ctx sdk.Context, position types.VestingPosition) error {
+	addr, err := k.accAddress(position.Address)
+	if err != nil {
+		return err
+	}
+	if err := k.vestingPositions.Set(ctx, collections.Join(addr, position.Id), position); err != nil {
+		return errorsmod.Wrapf(err, "failed to store vesting position %d", position.Id)
+	}
+
+	next, err := k.vestingPositionSeq.Peek(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to retrieve vesting position sequence")
+	}
+	if position.Id >= next {
+		return k.vestingPositionSeq.Set(ctx, position.Id+1)
+	}
+	return nil
+}
+
+// GetVestingPositions returns the reward vesting positions of an address.
+func (k Keeper) GetVestingPositions(ctx sdk.Context, addr sdk.AccAddress) (list []types.VestingPosition, err error) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr)
+	err = k.vestingPositions.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, uint64], position types.VestingPosition) (bool, error) {
+		list = append(list, position)
+		return false, nil
+	})
+	return list, err
+}
+
+// GetAllVestingPositions returns every reward vesting position.
+func (k Keeper) GetAllVestingPositions(ctx sdk.Context) (list []types.VestingPosition, err error) {
+	err = k.vestingPositions.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], position types.VestingPosition) (bool, error) {
+		list = append(list, position)
+		return false, nil
+	})
+	return list, err
+}
+
+// CreateVestingPosition moves amount from the reward pool to the
+// vesting module account and opens a position releasing it linearly to addr
+// over reward_vesting_duration blocks.
+func (k Keeper) CreateVestingPosition(ctx sdk.Context, addr sdk.AccAddress, amount math.Int) (types.VestingPosition, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.VestingPosition{}, err
+	}
+	id, err := k.vestingPositionSeq.Next(ctx)
+	if err != nil {
+		return types.VestingPosition{}, errorsmod.Wrap(err, "failed to retrieve vesting position sequence")
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx, types.RewardsModuleName, types.VestingModuleName, sdk.NewCoins(sdk.NewCoin(k.GetRewardDenom(ctx), amount)),
+	)
+	if err != nil {
+		return types.VestingPosition{}, err
+	}
+
+	owner, err := k.addressString(addr)
+	if err != nil {
+		return types.VestingPosition{}, err
+	}
+	position := types.NewVestingPosition(id, owner, amount, ctx.BlockHeight(), params.RewardVestingDuration)
+	if err := k.SetVestingPosition(ctx, position); err != nil {
+		return types.VestingPosition{}, err
+	}
+	return position, nil
+}
+
+// GetVestingSummary returns the amounts of an address's positions that are
+// not vested yet and that are vested but not withdrawn yet.
+func (k Keeper) GetVestingSummary(ctx sdk.Context, addr sdk.AccAddress) (positions []types.VestingPosition, vesting, withdrawable math.Int, err error) {
+	vesting, withdrawable = math.ZeroInt(), math.ZeroInt()
+	positions, err = k.GetVestingPositions(ctx, addr)
+	if err != nil {
+		return nil, vesting, withdrawable, err
+	}
+	for _, position := range positions {
+		unvested, err := position.Unvested(ctx.BlockHeight())
+		if err != nil {
+			return nil, vesting, withdrawable, err
+		}
+		available, err := position.Withdrawable(ctx.BlockHeight())
+		if err != nil {
+			return nil, vesting, withdrawable, err
+		}
+		vesting = vesting.Add(unvested)
+		withdrawable = withdrawable.Add(available)
+	}
+	return positions, vesting, withdrawable, nil
+}
+
+// WithdrawVested pays out the vested part of every position of an address
+// that has not been withdrawn yet. Fully paid out positions are removed.
+func (k Keeper) WithdrawVested(ctx sdk.Context, addr sdk.AccAddress) (math.Int, error) {
+	addrStr, err := k.addressString(addr)
+	if err != nil {
+		return math.Int{}, err
+	}
+	positions, err := k.GetVestingPositions(ctx, addr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	total := math.ZeroInt()
+	for _, position := range positions {
+		available, err := position.Withdrawable(ctx.BlockHeight())
+		if err != nil {
+			return math.Int{}, err
+		}
+		if !available.IsPositive() {
+			continue
+		}
+		total = total.Add(available)
+
+		key := collections.Join(addr, position.Id)
+		if ctx.BlockHeight() >= position.EndHeight {
+			if err := k.vestingPositions.Remove(ctx, key); err != nil {
+				return math.Int{}, errorsmod.Wrapf(err, "failed to remove vesting position %d", position.Id)
+			}
+			continue
+		}
+		withdrawn, _ := math.NewIntFromString(position.Withdrawn)
+		position.Withdrawn = withdrawn.Add(available).String()
+		if err := k.vestingPositions.Set(ctx, key, position); err != nil {
+			return math.Int{}, errorsmod.Wrapf(err, "failed to store vesting position %d", position.Id)
+		}
+	}
+
+	if total.IsZero() {
+		return math.Int{}, errorsmod.Wrap(types.ErrNothingVested, addrStr)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.VestingModuleName, addr, sdk.NewCoins(sdk.NewCoin(k.GetRewardDenom(ctx), total)),
+	)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawVested,
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyAmount, total.String()),
+		),
+	)
+	return total, nil
+}
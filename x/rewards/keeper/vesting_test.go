@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"zenoda/x/rewards/types"
+)
+
+func TestRewardVesting(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(100)
+
+	params := getParams(t, k, ctx)
+	params.EpochLength = 100
+	params.RewardVestingFraction = "0.4"
+	params.RewardVestingDuration = 100
+	require.NoError(t, k.SetParams(ctx, params))
+
+	supply := sdk.NewCoins(sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(100_000)))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, supply))
+
+	// The whole 5000 EGV pool goes to one wallet: 3000 liquid, 2000 vesting.
+	wallet := getPredefinedAddresses(t, k, ctx)[0]
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+	ctx = distributeEpochRewards(t, k, ctx)
+	require.Equal(t, math.NewInt(3000), k.GetBankKeeper().GetBalance(ctx, wallet, types.DefaultRewardDenom).Amount)
+
+	vestingAddr := k.GetAccountKeeper().GetModuleAddress(types.VestingModuleName)
+	require.Equal(t, math.NewInt(2000), k.GetBankKeeper().GetBalance(ctx, vestingAddr, types.DefaultRewardDenom).Amount)
+
+	_, err := ms.WithdrawVested(ctx, types.NewMsgWithdrawVested(wallet.String()))
+	require.ErrorIs(t, err, types.ErrNothingVested)
+
+	// Half way through the vesting period half of the position is withdrawable.
+	ctx = ctx.WithBlockHeight(150)
+	res, err := k.VestingRewards(ctx, &types.QueryVestingRewardsRequest{Address: wallet.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Positions, 1)
+	require.Equal(t, int64(200), res.Positions[0].EndHeight)
+	require.Equal(t, "1000", res.Vesting)
+	require.Equal(t, "1000", res.Withdrawable)
+
+	withdrawRes, err := ms.WithdrawVested(ctx, types.NewMsgWithdrawVested(wallet.String()))
+	require.NoError(t, err)
+	require.Equal(t, "1000", withdrawRes.Amount)
+	require.Equal(t, math.NewInt(4000), k.GetBankKeeper().GetBalance(ctx, wallet, types.DefaultRewardDenom).Amount)
+
+	_, err = ms.WithdrawVested(ctx, types.NewMsgWithdrawVested(wallet.String()))
+	require.ErrorIs(t, err, types.ErrNothingVested)
+
+	// Once fully vested the rest is paid out and the position removed.
+	ctx = ctx.WithBlockHeight(250)
+	withdrawRes, err = ms.WithdrawVested(ctx, types.NewMsgWithdrawVested(wallet.String()))
+	require.NoError(t, err)
+	require.Equal(t, "1000", withdrawRes.Amount)
+	require.Equal(t, math.NewInt(5000), k.GetBankKeeper().GetBalance(ctx, wallet, types.DefaultRewardDenom).Amount)
+	require.True(t, k.GetBankKeeper().GetBalance(ctx, vestingAddr, types.DefaultRewardDenom).IsZero())
+
+	positions, err := k.GetAllVestingPositions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, positions)
+}
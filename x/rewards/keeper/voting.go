@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- VOTING POWER DELEGATION ----------------------
+
+// SetVotingDelegation stores a delegation and indexes it by delegatee,
+// replacing any earlier delegation made by the same delegator.
+func (k Keeper) SetVotingDelegation(ctx sdk.Context, delegation types.VotingDelegation) error {
+	if err := delegation.Validate(k.addressCodec); err != nil {
+		return err
+	}
+	delegator, err := k.accAddress(delegation.Delegator)
+	if err != nil {
+		return err
+	}
+	delegatee, err := k.accAddress(delegation.Delegatee)
+	if err != nil {
+		return err
+	}
+
+	if err := k.RemoveVotingDelegation(ctx, delegator); err != nil && !errorsmod.IsOf(err, types.ErrDelegationNotFound) {
+		return err
+	}
+
+	if err := k.votingDelegations.Set(ctx, delegator, delegation); err != nil {
+		return errorsmod.Wrapf(err, "failed to store voting delegation of %s", delegator)
+	}
+	if err := k.delegatorsOf.Set(ctx, collections.Join(delegatee, delegator)); err != nil {
+		return errorsmod.Wrapf(err, "failed to index voting delegation of %s", delegator)
+	}
+	return nil
+}
+
+// GetVotingDelegation returns the delegation made by a delegator.
+func (k Keeper) GetVotingDelegation(ctx sdk.Context, delegator sdk.AccAddress) (types.VotingDelegation, error) {
+	delegation, err := k.votingDelegations.Get(ctx, delegator)
+	if errors.Is(err, collections.ErrNotFound) {
+		delegatorStr, err := k.addressString(delegator)
+		if err != nil {
+			return delegation, err
+		}
+		return delegation, errorsmod.Wrap(types.ErrDelegationNotFound, delegatorStr)
+	}
+	if err != nil {
+		return delegation, errorsmod.Wrapf(err, "failed to retrieve voting delegation of %s", delegator)
+	}
+	return delegation, nil
+}
+
+// RemoveVotingDelegation removes the delegation made by a delegator.
+func (k Keeper) RemoveVotingDelegation(ctx sdk.Context, delegator sdk.AccAddress) error {
+	delegation, err := k.GetVotingDelegation(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	delegatee, err := k.accAddress(delegation.Delegatee)
+	if err != nil {
+		return err
+	}
+
+	if err := k.votingDelegations.Remove(ctx, delegator); err != nil {
+		return errorsmod.Wrapf(err, "failed to remove voting delegation of %s", delegator)
+	}
+	if err := k.delegatorsOf.Remove(ctx, collections.Join(delegatee, delegator)); err != nil {
+		return errorsmod.Wrapf(err, "failed to remove voting delegation index of %s", delegator)
+	}
+	return nil
+}
+
+// GetAllVotingDelegations returns every voting power delegation.
+func (k Keeper) GetAllVotingDelegations(ctx sdk.Context) (list []types.VotingDelegation, err error) {
+	err = k.votingDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, delegation types.VotingDelegation) (bool, error) {
+		list = append(list, delegation)
+		return false, nil
+	})
+	return list, err
+}
+
+// GetDelegatorsOf returns the addresses that delegated their voting power to a delegatee.
+func (k Keeper) GetDelegatorsOf(ctx sdk.Context, delegatee sdk.AccAddress) (delegators []sdk.AccAddress, err error) {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](delegatee)
+	err = k.delegatorsOf.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		delegators = append(delegators, key.K2())
+		return false, nil
+	})
+	return delegators, err
+}
+
+// DelegateVotingPower hands the voting power of a delegator to a delegatee.
+// Only governance wallets and election candidates have a transaction count to
+// delegate, so other delegators are rejected.
+func (k Keeper) DelegateVotingPower(ctx sdk.Context, delegator, delegatee sdk.AccAddress) error {
+	if delegator.Equals(delegatee) {
+		return types.ErrSelfDelegation
+	}
+
+	delegatorStr, err := k.addressString(delegator)
+	if err != nil {
+		return err
+	}
+	tracked, err := k.tracksTransactions(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if !tracked {
+		return errorsmod.Wrap(types.ErrNoVotingWeight, delegatorStr)
+	}
+	delegateeStr, err := k.addressString(delegatee)
+	if err != nil {
+		return err
+	}
+	if err := k.SetVotingDelegation(ctx, types.NewVotingDelegation(delegatorStr, delegateeStr)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateVotingPower,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+			sdk.NewAttribute(types.AttributeKeyDelegatee, delegateeStr),
+		),
+	)
+	return nil
+}
+
+// UndelegateVotingPower returns the voting power of a delegator to itself.
+func (k Keeper) UndelegateVotingPower(ctx sdk.Context, delegator sdk.AccAddress) error {
+	delegatorStr, err := k.addressString(delegator)
+	if err != nil {
+		return err
+	}
+	if err := k.RemoveVotingDelegation(ctx, delegator); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateVotingPower,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+		),
+	)
+	return nil
+}
+
+// ---------------------- VOTING POWER ----------------------
+
+// GetVotingWeight returns the voting weight of an address's own contribution
+// under the voting weight function selected in params. The contribution is
+// the transaction count or the decayed score, following the contribution
+// score mode, multiplied by the address's lock boost.
+func (k Keeper) GetVotingWeight(ctx sdk.Context, addr sdk.AccAddress) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	votingWeightCap, err := params.GetVotingWeightCapAsDec()
+	if err != nil {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidParams, "invalid voting weight cap: %s", err)
+	}
+	contribution, err := k.GetEffectiveContribution(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	total, err := k.GetTotalEffectiveContribution(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return types.VotingWeight(params.VotingWeightFunction, votingWeightCap, contribution, total)
+}
+
+// GetVotingPower returns the address's own voting weight, which is zero when
+// it has been delegated away, and the weight delegated to it. Delegation is a
+// single hop: a delegatee passes on its own weight only. The weighting
+// function is applied to every address separately, so delegating never
+// changes the weight a delegator contributes.
+func (k Keeper) GetVotingPower(ctx sdk.Context, addr sdk.AccAddress) (own, delegated math.LegacyDec, err error) {
+	own, delegated = math.LegacyZeroDec(), math.LegacyZeroDec()
+	_, err = k.GetVotingDelegation(ctx, addr)
+	switch {
+	case errors.Is(err, types.ErrDelegationNotFound):
+		if own, err = k.GetVotingWeight(ctx, addr); err != nil {
+			return own, delegated, err
+		}
+	case err != nil:
+		return own, delegated, err
+	}
+	delegators, err := k.GetDelegatorsOf(ctx, addr)
+	if err != nil {
+		return own, delegated, err
+	}
+	for _, delegator := range delegators {
+		weight, err := k.GetVotingWeight(ctx, delegator)
+		if err != nil {
+			return own, delegated, err
+		}
+		delegated = delegated.Add(weight)
+	}
+	return own, delegated, nil
+}
+
+// TallyVotingPower returns the weight each voter carries in a
+// contribution-weighted tally. A voter's weight is its own voting weight plus
+// the weights of the addresses that delegated to it, except delegators that
+// voted themselves: their weight stays with their own vote.
+//
+// The tally is query-only (Query/Tally). x/gov keeps its bonded-stake tally
+// and has no hook for a custom one, so these weights never decide a proposal.
+func (k Keeper) TallyVotingPower(ctx sdk.Context, voters []sdk.AccAddress) ([]math.LegacyDec, error) {
+	voted := make(map[string]bool, len(voters))
+	for _, voter := range voters {
+		voterStr, err := k.addressString(voter)
+		if err != nil {
+			return nil, err
+		}
+		voted[voterStr] = true
+	}
+
+	weights := make([]math.LegacyDec, len(voters))
+	for i, voter := range voters {
+		weight, err := k.GetVotingWeight(ctx, voter)
+		if err != nil {
+			return nil, err
+		}
+		delegators, err := k.GetDelegatorsOf(ctx, voter)
+		if err != nil {
+			return nil, err
+		}
+		for _, delegator := range delegators {
+			delegatorStr, err := k.addressString(delegator)
+			if err != nil {
+				return nil, err
+			}
+			if voted[delegatorStr] {
+				continue
+			}
+			delegatedWeight, err := k.GetVotingWeight(ctx, delegator)
+			if err != nil {
+				return nil, err
+			}
+			weight = weight.Add(delegatedWeight)
+		}
+		weights[i] = weight
+	}
+	return weights, nil
+}
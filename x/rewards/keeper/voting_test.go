@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/types"
+)
+
+func TestVotingPowerDelegation(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	wallets := getPredefinedAddresses(t, k, ctx)
+	alice, bob, carol := wallets[0], wallets[1], wallets[2]
+
+	// alice: 3, bob: 2, carol: 1 counted transactions.
+	for i, addr := range []sdk.AccAddress{alice, bob, carol} {
+		for n := 0; n < 3-i; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, addr))
+		}
+	}
+
+	_, err := ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: alice.String(), Delegatee: alice.String()})
+	require.ErrorIs(t, err, types.ErrSelfDelegation)
+	_, err = ms.UndelegateVotingPower(ctx, &types.MsgUndelegateVotingPower{Delegator: alice.String()})
+	require.ErrorIs(t, err, types.ErrDelegationNotFound)
+
+	// Only governance wallets and candidates have a transaction count to delegate.
+	outsider := sdk.AccAddress("outsider____________")
+	_, err = ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: outsider.String(), Delegatee: alice.String()})
+	require.ErrorIs(t, err, types.ErrNoVotingWeight)
+	_, err = ms.RegisterCandidate(ctx, &types.MsgRegisterCandidate{Candidate: outsider.String()})
+	require.NoError(t, err)
+	_, err = ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: outsider.String(), Delegatee: alice.String()})
+	require.NoError(t, err)
+	_, err = ms.UndelegateVotingPower(ctx, &types.MsgUndelegateVotingPower{Delegator: outsider.String()})
+	require.NoError(t, err)
+
+	_, err = ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: alice.String(), Delegatee: bob.String()})
+	require.NoError(t, err)
+	_, err = ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: bob.String(), Delegatee: carol.String()})
+	require.NoError(t, err)
+
+	power, err := k.VotingPower(ctx, &types.QueryVotingPowerRequest{Address: bob.String()})
+	require.NoError(t, err)
+	require.Equal(t, "0.000000000000000000", power.OwnPower)
+	require.Equal(t, "3.000000000000000000", power.DelegatedPower)
+	require.Equal(t, carol.String(), power.Delegatee)
+
+	// Delegation is not transitive: carol only receives bob's own power.
+	power, err = k.VotingPower(ctx, &types.QueryVotingPowerRequest{Address: carol.String()})
+	require.NoError(t, err)
+	require.Equal(t, "1.000000000000000000", power.OwnPower)
+	require.Equal(t, "2.000000000000000000", power.DelegatedPower)
+	require.Equal(t, "3.000000000000000000", power.TotalPower)
+	require.Equal(t, types.VotingWeightLinear, power.VotingWeightFunction)
+
+	// A delegator that votes itself keeps its own power.
+	requireTally(t, []int64{5, 1}, k, ctx, bob, carol)
+	requireTally(t, []int64{3, 2, 1}, k, ctx, alice, bob, carol)
+
+	// Redelegating moves the delegation to the new delegatee.
+	_, err = ms.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{Delegator: alice.String(), Delegatee: carol.String()})
+	require.NoError(t, err)
+	to, err := k.VotingDelegationsTo(ctx, &types.QueryVotingDelegationsToRequest{Delegatee: carol.String()})
+	require.NoError(t, err)
+	require.Len(t, to.Delegations, 2)
+	delegators, err := k.GetDelegatorsOf(ctx, bob)
+	require.NoError(t, err)
+	require.Empty(t, delegators)
+
+	_, err = ms.UndelegateVotingPower(ctx, &types.MsgUndelegateVotingPower{Delegator: alice.String()})
+	require.NoError(t, err)
+	_, err = k.VotingDelegation(ctx, &types.QueryVotingDelegationRequest{Delegator: alice.String()})
+	require.Error(t, err)
+	delegations, err := k.GetAllVotingDelegations(ctx)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+}
+
+func requireTally(t *testing.T, expected []int64, k keeper.Keeper, ctx sdk.Context, voters ...sdk.AccAddress) {
+	t.Helper()
+	weights, err := k.TallyVotingPower(ctx, voters)
+	require.NoError(t, err)
+	require.Len(t, weights, len(expected))
+	for i, weight := range weights {
+		require.Equal(t, math.LegacyNewDec(expected[i]), weight)
+	}
+}
+
+// TestVotingWeightCurves simulates one heavy spammer voting against nine
+// regular contributors and shows how each weighting function moves the outcome.
+func TestVotingWeightCurves(t *testing.T) {
+	k, _, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	wallets := getPredefinedAddresses(t, k, ctx)
+	spammer, contributors := wallets[0], wallets[1:]
+
+	for n := 0; n < 1000; n++ {
+		require.NoError(t, k.IncrementTransactionCount(ctx, spammer))
+	}
+	for _, contributor := range contributors {
+		for n := 0; n < 30; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, contributor))
+		}
+	}
+
+	tests := []struct {
+		function string
+		no       string // spammer
+		yes      string // contributors
+		passes   bool
+	}{
+		// 1000 against 9 * 30
+		{function: types.VotingWeightLinear, no: "1000", yes: "270", passes: false},
+		// sqrt(1000) against 9 * sqrt(30)
+		{function: types.VotingWeightQuadratic, no: "31.622776601683793320", yes: "49.295030175464950215", passes: true},
+		// 10% of 1270 network transactions against 9 * 30
+		{function: types.VotingWeightCapped, no: "127", yes: "270", passes: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.function, func(t *testing.T) {
+			params := getParams(t, k, ctx)
+			params.VotingWeightFunction = tc.function
+			require.NoError(t, k.SetParams(ctx, params))
+
+			weights, err := k.TallyVotingPower(ctx, wallets)
+			require.NoError(t, err)
+
+			no, yes := weights[0], math.LegacyZeroDec()
+			for _, weight := range weights[1:] {
+				yes = yes.Add(weight)
+			}
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.no), no)
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.yes), yes)
+			require.Equal(t, tc.passes, yes.GT(no))
+		})
+	}
+}
+
+// govVotes serves the x/gov votes of a single proposal.
+type govVotes struct {
+	proposalID uint64
+	votes      []*govv1.Vote
+}
+
+func (g *govVotes) Votes(_ context.Context, req *govv1.QueryVotesRequest) (*govv1.QueryVotesResponse, error) {
+	if req.ProposalId != g.proposalID {
+		return &govv1.QueryVotesResponse{Pagination: &query.PageResponse{}}, nil
+	}
+	// One vote per page exercises the pagination.
+	start := 0
+	if req.Pagination != nil && len(req.Pagination.Key) > 0 {
+		start = int(req.Pagination.Key[0])
+	}
+	res := &govv1.QueryVotesResponse{Votes: g.votes[start : start+1], Pagination: &query.PageResponse{}}
+	if start+1 < len(g.votes) {
+		res.Pagination.NextKey = []byte{byte(start + 1)}
+	}
+	return res, nil
+}
+
+func TestTallyQuery(t *testing.T) {
+	gov := &govVotes{proposalID: 1}
+	k, ctx := keepertest.RewardsKeeperWithGov(t, gov)
+	wallets := getPredefinedAddresses(t, k, ctx)
+	alice, bob, carol, dave := wallets[0], wallets[1], wallets[2], wallets[3]
+
+	// alice: 3, bob: 2, carol: 1, dave: 4 counted transactions.
+	for i, wallet := range []sdk.AccAddress{alice, bob, carol, dave} {
+		for n := 0; n < []int{3, 2, 1, 4}[i]; n++ {
+			require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+		}
+	}
+	// carol delegates to alice and does not vote; bob delegates to alice but
+	// votes himself.
+	require.NoError(t, k.DelegateVotingPower(ctx, carol, alice))
+	require.NoError(t, k.DelegateVotingPower(ctx, bob, alice))
+
+	gov.votes = []*govv1.Vote{
+		{ProposalId: 1, Voter: alice.String(), Options: govv1.NewNonSplitVoteOption(govv1.OptionYes)},
+		{ProposalId: 1, Voter: bob.String(), Options: govv1.NewNonSplitVoteOption(govv1.OptionNo)},
+		{ProposalId: 1, Voter: dave.String(), Options: govv1.WeightedVoteOptions{
+			{Option: govv1.OptionYes, Weight: "0.25"},
+			{Option: govv1.OptionNoWithVeto, Weight: "0.75"},
+		}},
+	}
+
+	res, err := k.Tally(ctx, &types.QueryTallyRequest{ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.TallyVote{
+		{Voter: alice.String(), Weight: math.LegacyNewDec(4).String()},
+		{Voter: bob.String(), Weight: math.LegacyNewDec(2).String()},
+		{Voter: dave.String(), Weight: math.LegacyNewDec(4).String()},
+	}, res.Votes)
+	require.Equal(t, math.LegacyNewDec(5).String(), res.YesCount)
+	require.Equal(t, math.LegacyZeroDec().String(), res.AbstainCount)
+	require.Equal(t, math.LegacyNewDec(2).String(), res.NoCount)
+	require.Equal(t, math.LegacyNewDec(3).String(), res.NoWithVetoCount)
+
+	res, err = k.Tally(ctx, &types.QueryTallyRequest{ProposalId: 2})
+	require.NoError(t, err)
+	require.Empty(t, res.Votes)
+	require.Equal(t, math.LegacyZeroDec().String(), res.YesCount)
+}
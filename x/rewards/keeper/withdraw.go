@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// ---------------------- REWARD WITHDRAW ADDRESS ----------------------
+
+// SetRewardWithdrawAddress sets the address the rewards of an account are
+// paid to. Setting the account itself removes the withdraw address. Blocked
+// addresses and module accounts cannot receive rewards.
+func (k Keeper) SetRewardWithdrawAddress(ctx sdk.Context, addr, withdrawAddr sdk.AccAddress) error {
+	addrStr, err := k.addressString(addr)
+	if err != nil {
+		return err
+	}
+	withdrawAddrStr, err := k.addressString(withdrawAddr)
+	if err != nil {
+		return err
+	}
+	if k.IsBlockedAddress(ctx, withdrawAddr) {
+		return errorsmod.Wrap(types.ErrBlockedAddress, withdrawAddrStr)
+	}
+
+	if addr.Equals(withdrawAddr) {
+		if err := k.rewardWithdrawAddresses.Remove(ctx, addr); err != nil {
+			return errorsmod.Wrapf(err, "failed to remove reward withdraw address of %s", addr)
+		}
+	} else if err := k.rewardWithdrawAddresses.Set(ctx, addr, withdrawAddr); err != nil {
+		return errorsmod.Wrapf(err, "failed to store reward withdraw address of %s", addr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRewardWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddrStr),
+		),
+	)
+	return nil
+}
+
+// GetRewardWithdrawAddress returns the address the rewards of an account are
+// paid to: its withdraw address if one is set, the account itself otherwise.
+func (k Keeper) GetRewardWithdrawAddress(ctx sdk.Context, addr sdk.AccAddress) (sdk.AccAddress, error) {
+	withdrawAddr, err := k.rewardWithdrawAddresses.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return addr, nil
+	}
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to retrieve reward withdraw address of %s", addr)
+	}
+	return withdrawAddr, nil
+}
+
+// GetAllRewardWithdrawAddresses returns every configured reward withdraw address.
+func (k Keeper) GetAllRewardWithdrawAddresses(ctx sdk.Context) (list []types.RewardWithdrawAddress, err error) {
+	err = k.rewardWithdrawAddresses.Walk(ctx, nil, func(addr, withdrawAddr sdk.AccAddress) (bool, error) {
+		addrStr, err := k.addressString(addr)
+		if err != nil {
+			return true, err
+		}
+		withdrawAddrStr, err := k.addressString(withdrawAddr)
+		if err != nil {
+			return true, err
+		}
+		list = append(list, types.NewRewardWithdrawAddress(addrStr, withdrawAddrStr))
+		return false, nil
+	})
+	return list, err
+}
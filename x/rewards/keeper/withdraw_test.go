@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"zenoda/x/rewards/types"
+)
+
+func TestRewardWithdrawAddress(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	wallet := getPredefinedAddresses(t, k, ctx)[0]
+	treasury := sdk.AccAddress([]byte("treasury____________"))
+
+	res, err := k.RewardWithdrawAddress(ctx, &types.QueryRewardWithdrawAddressRequest{Address: wallet.String()})
+	require.NoError(t, err)
+	require.Equal(t, wallet.String(), res.WithdrawAddress)
+
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	_, err = ms.SetRewardWithdrawAddress(ctx, types.NewMsgSetRewardWithdrawAddress(wallet.String(), feeCollector.String()))
+	require.ErrorIs(t, err, types.ErrBlockedAddress)
+
+	_, err = ms.SetRewardWithdrawAddress(ctx, types.NewMsgSetRewardWithdrawAddress(wallet.String(), treasury.String()))
+	require.NoError(t, err)
+	res, err = k.RewardWithdrawAddress(ctx, &types.QueryRewardWithdrawAddressRequest{Address: wallet.String()})
+	require.NoError(t, err)
+	require.Equal(t, treasury.String(), res.WithdrawAddress)
+
+	// Rewards go to the treasury, not to the governance wallet.
+	supply := sdk.NewCoins(sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(100_000)))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, supply))
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+	ctx = distributeEpochRewards(t, k, ctx)
+	require.True(t, k.GetBankKeeper().GetBalance(ctx, wallet, types.DefaultRewardDenom).IsZero())
+	require.Equal(t, math.NewInt(5000), k.GetBankKeeper().GetBalance(ctx, treasury, types.DefaultRewardDenom).Amount)
+
+	all, err := k.GetAllRewardWithdrawAddresses(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.RewardWithdrawAddress{types.NewRewardWithdrawAddress(wallet.String(), treasury.String())}, all)
+
+	// Setting the wallet itself removes the withdraw address.
+	_, err = ms.SetRewardWithdrawAddress(ctx, types.NewMsgSetRewardWithdrawAddress(wallet.String(), wallet.String()))
+	require.NoError(t, err)
+	all, err = k.GetAllRewardWithdrawAddresses(ctx)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
@@ -0,0 +1,245 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/store"
+	math "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/types"
+)
+
+// Keys written by consensus version 1. They were built by hand without a
+// length prefix.
+const (
+	legacyTransactionCountKey = "transaction_count"
+	legacyTotalTxKey          = "total_transactions"
+	legacyTotalSupplyKey      = "TotalSupply"
+	legacyParamsKey           = "p_rewards"
+)
+
+// MigrateStore moves the params, the transaction counts, the total network
+// transactions and the stored EGV supply from their hand built v1 keys to
+// the collections schema of v2. The params get the same wallet
+// normalization and checks as a params update: wallets are re-encoded with
+// the address codec and checkWallets rejects blocked ones.
+func MigrateStore(
+	ctx sdk.Context,
+	storeService store.KVStoreService,
+	cdc codec.BinaryCodec,
+	addressCodec address.Codec,
+	checkWallets func(context.Context, []string) error,
+) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	params := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	transactionCounts := collections.NewMap(sb, types.TransactionCountKeyPrefix, "transaction_counts", sdk.AccAddressKey, collections.Uint64Value)
+	totalTransactions := collections.NewItem(sb, types.TotalTransactionsKey, "total_transactions", collections.Uint64Value)
+	totalSupply := collections.NewItem(sb, types.TotalSupplyKey, "total_supply", codec.CollValue[sdk.Coin](cdc))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	if bz := store.Get([]byte(legacyParamsKey)); bz != nil {
+		var p types.Params
+		if err := cdc.Unmarshal(bz, &p); err != nil {
+			return err
+		}
+		p, err := withDefaults(p).NormalizeWallets(addressCodec)
+		if err != nil {
+			return fmt.Errorf("invalid v1 params: %w", err)
+		}
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("invalid v1 params: %w", err)
+		}
+		if err := checkWallets(ctx, p.PredefinedWallets); err != nil {
+			return fmt.Errorf("invalid v1 params: %w", err)
+		}
+		if err := params.Set(ctx, p); err != nil {
+			return err
+		}
+		store.Delete([]byte(legacyParamsKey))
+	}
+
+	if err := migrateTransactionCounts(ctx, store, transactionCounts); err != nil {
+		return err
+	}
+
+	if bz := store.Get([]byte(legacyTotalTxKey)); bz != nil {
+		if err := totalTransactions.Set(ctx, sdk.BigEndianToUint64(bz)); err != nil {
+			return err
+		}
+		store.Delete([]byte(legacyTotalTxKey))
+	}
+
+	if bz := store.Get([]byte(legacyTotalSupplyKey)); bz != nil {
+		var supply sdk.Coin
+		if err := cdc.Unmarshal(bz, &supply); err != nil {
+			return err
+		}
+		if err := totalSupply.Set(ctx, supply); err != nil {
+			return err
+		}
+		store.Delete([]byte(legacyTotalSupplyKey))
+	}
+
+	return nil
+}
+
+// withDefaults fills the params fields that v1 stores may lack and that have
+// no valid zero value with their defaults.
+func withDefaults(p types.Params) types.Params {
+	defaults := types.DefaultParams()
+	if p.MinInflationRate == "" {
+		p.MinInflationRate = defaults.MinInflationRate
+	}
+	if p.MaxInflationRate == "" {
+		// v1 accepted any rate up to 1; keep a higher running rate valid.
+		p.MaxInflationRate = defaults.MaxInflationRate
+		rate, err := math.LegacyNewDecFromStr(p.InflationRate)
+		if err == nil && rate.GT(math.LegacyMustNewDecFromStr(p.MaxInflationRate)) {
+			p.MaxInflationRate = p.InflationRate
+		}
+	}
+	if p.MaxInflationRateChange == "" {
+		p.MaxInflationRateChange = defaults.MaxInflationRateChange
+	}
+	if p.EpochLength == 0 {
+		p.EpochLength = defaults.EpochLength
+	}
+	if p.ParamChangeDelay == 0 {
+		p.ParamChangeDelay = defaults.ParamChangeDelay
+	} else if p.ParamChangeDelay < types.MinParamChangeDelay {
+		// v1 accepted any delay; keep a short one as short as allowed.
+		p.ParamChangeDelay = types.MinParamChangeDelay
+	}
+	if p.VetoThreshold == "" {
+		p.VetoThreshold = defaults.VetoThreshold
+	}
+	if p.VotingWeightFunction == "" {
+		p.VotingWeightFunction = defaults.VotingWeightFunction
+	}
+	if p.VotingWeightCap == "" {
+		p.VotingWeightCap = defaults.VotingWeightCap
+	}
+	if p.CouncilSize == 0 {
+		p.CouncilSize = defaults.CouncilSize
+	}
+	if p.MinCandidateBalance == "" {
+		p.MinCandidateBalance = defaults.MinCandidateBalance
+	}
+	if p.MinGovernanceSetSize == 0 {
+		p.MinGovernanceSetSize = defaults.MinGovernanceSetSize
+	}
+	if p.MaxGovernanceSetSize == 0 {
+		// Keep a larger v1 wallet set valid.
+		p.MaxGovernanceSetSize = defaults.MaxGovernanceSetSize
+		if size := uint64(len(p.PredefinedWallets)); size > p.MaxGovernanceSetSize {
+			p.MaxGovernanceSetSize = size
+		}
+	}
+	if p.MaxGovernanceSetChange == "" {
+		p.MaxGovernanceSetChange = defaults.MaxGovernanceSetChange
+	}
+	if p.ContributionScoreMode == "" {
+		p.ContributionScoreMode = defaults.ContributionScoreMode
+	}
+	if p.ScoreHalfLifeEpochs == 0 {
+		p.ScoreHalfLifeEpochs = defaults.ScoreHalfLifeEpochs
+	}
+	if p.MaxSharePerAddress == "" {
+		p.MaxSharePerAddress = defaults.MaxSharePerAddress
+	}
+	if p.RewardVestingFraction == "" {
+		p.RewardVestingFraction = defaults.RewardVestingFraction
+	}
+	if p.RewardVestingDuration == 0 {
+		p.RewardVestingDuration = defaults.RewardVestingDuration
+	}
+	if p.FeeShare == "" {
+		p.FeeShare = defaults.FeeShare
+	}
+	if p.MaxSupply == "" {
+		p.MaxSupply = defaults.MaxSupply
+	}
+	if p.BurnFraction == "" {
+		p.BurnFraction = defaults.BurnFraction
+	}
+	if p.InflationSchedule == "" {
+		p.InflationSchedule = defaults.InflationSchedule
+	}
+	if p.InflationHalvingEpochs == 0 {
+		p.InflationHalvingEpochs = defaults.InflationHalvingEpochs
+	}
+	if p.InflationDecayRate == "" {
+		p.InflationDecayRate = defaults.InflationDecayRate
+	}
+	if p.InflationFloor == "" {
+		p.InflationFloor = defaults.InflationFloor
+	}
+	if p.RewardDenom == "" {
+		p.RewardDenom = defaults.RewardDenom
+	}
+	if p.InactivityPenalty == "" {
+		p.InactivityPenalty = defaults.InactivityPenalty
+	}
+	if p.InactivityRewardReduction == "" {
+		p.InactivityRewardReduction = defaults.InactivityRewardReduction
+	}
+	if p.InactivityPenaltyEpochs == 0 {
+		p.InactivityPenaltyEpochs = defaults.InactivityPenaltyEpochs
+	}
+	if p.LockUnbondingPeriod == 0 {
+		p.LockUnbondingPeriod = defaults.LockUnbondingPeriod
+	}
+	if p.LockBoostCurve == "" {
+		p.LockBoostCurve = defaults.LockBoostCurve
+	}
+	if p.LockBoostMax == "" {
+		p.LockBoostMax = defaults.LockBoostMax
+	}
+	if p.LockBoostSaturation == "" {
+		p.LockBoostSaturation = defaults.LockBoostSaturation
+	}
+	if p.MinLockAmount == "" {
+		p.MinLockAmount = defaults.MinLockAmount
+	}
+	return p
+}
+
+// migrateTransactionCounts rewrites every "transaction_count" || address
+// entry into the transaction counts map.
+func migrateTransactionCounts(
+	ctx sdk.Context,
+	store storetypes.KVStore,
+	transactionCounts collections.Map[sdk.AccAddress, uint64],
+) error {
+	legacyStore := prefix.NewStore(store, []byte(legacyTransactionCountKey))
+	iterator := storetypes.KVStorePrefixIterator(legacyStore, []byte{})
+
+	var addrs [][]byte
+	counts := make(map[string]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		addr := iterator.Key()
+		addrs = append(addrs, addr)
+		counts[string(addr)] = sdk.BigEndianToUint64(iterator.Value())
+	}
+	iterator.Close()
+
+	for _, addr := range addrs {
+		if err := transactionCounts.Set(ctx, sdk.AccAddress(addr), counts[string(addr)]); err != nil {
+			return err
+		}
+		legacyStore.Delete(addr)
+	}
+	return nil
+}
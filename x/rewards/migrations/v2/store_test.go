@@ -0,0 +1,156 @@
+package v2_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	math "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "zenoda/x/rewards/migrations/v2"
+	"zenoda/x/rewards/types"
+)
+
+var addressCodec = addresscodec.NewBech32Codec(types.LegacyAccountAddressPrefix)
+
+func allowWallets(context.Context, []string) error { return nil }
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+	params := types.DefaultParams()
+	supply := sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(10000))
+
+	// Write the v1 layout.
+	store.Set([]byte("p_rewards"), cdc.MustMarshal(&params))
+	store.Set(append([]byte("transaction_count"), alice...), sdk.Uint64ToBigEndian(7))
+	store.Set(append([]byte("transaction_count"), bob...), sdk.Uint64ToBigEndian(3))
+	store.Set([]byte("total_transactions"), sdk.Uint64ToBigEndian(25))
+	store.Set([]byte("TotalSupply"), cdc.MustMarshal(&supply))
+	store.Set([]byte("pending_inflation_change"), []byte("untouched"))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc, addressCodec, allowWallets))
+
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	counts := collections.NewMap(sb, types.TransactionCountKeyPrefix, "transaction_counts", sdk.AccAddressKey, collections.Uint64Value)
+	total := collections.NewItem(sb, types.TotalTransactionsKey, "total_transactions", collections.Uint64Value)
+	supplyItem := collections.NewItem(sb, types.TotalSupplyKey, "total_supply", codec.CollValue[sdk.Coin](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	gotParams, err := paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, gotParams)
+
+	count, err := counts.Get(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), count)
+	count, err = counts.Get(ctx, bob)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+
+	gotTotal, err := total.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), gotTotal)
+
+	gotSupply, err := supplyItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, supply, gotSupply)
+
+	// The v1 keys are gone and unrelated keys are left alone.
+	require.False(t, store.Has([]byte("p_rewards")))
+	require.False(t, store.Has(append([]byte("transaction_count"), alice...)))
+	require.False(t, store.Has([]byte("total_transactions")))
+	require.False(t, store.Has([]byte("TotalSupply")))
+	require.Equal(t, []byte("untouched"), store.Get([]byte("pending_inflation_change")))
+}
+
+func TestMigrateStoreFillsParamDefaults(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	// v1 params only knew the inflation rate and the governance wallets.
+	legacy := types.Params{InflationRate: "0.07", PredefinedWallets: types.DefaultParams().PredefinedWallets}
+	ctx.KVStore(storeKey).Set([]byte("p_rewards"), cdc.MustMarshal(&legacy))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc, addressCodec, allowWallets))
+
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err := sb.Build()
+	require.NoError(t, err)
+
+	params, err := paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate())
+	require.Equal(t, "0.07", params.InflationRate)
+	require.Equal(t, types.DefaultParams().EpochLength, params.EpochLength)
+	require.Equal(t, types.DefaultParams().ParamChangeDelay, params.ParamChangeDelay)
+	require.Equal(t, types.VotingWeightLinear, params.VotingWeightFunction)
+	// v1 and fresh chains both count amounts in whole EGV.
+	require.Equal(t, "100", params.MinCandidateBalance)
+	require.Equal(t, "500", params.LockBoostSaturation)
+	require.Equal(t, "10", params.MinLockAmount)
+
+	// A v1 rate above the default max inflation rate stays valid.
+	legacy.InflationRate = "0.35"
+	ctx.KVStore(storeKey).Set([]byte("p_rewards"), cdc.MustMarshal(&legacy))
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc, addressCodec, allowWallets))
+	params, err = paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "0.35", params.InflationRate)
+	require.Equal(t, "0.35", params.MaxInflationRate)
+
+	// Params that stay invalid abort the migration.
+	legacy.InflationRate = "2"
+	ctx.KVStore(storeKey).Set([]byte("p_rewards"), cdc.MustMarshal(&legacy))
+	require.Error(t, v2.MigrateStore(ctx, storeService, cdc, addressCodec, allowWallets))
+}
+
+func TestMigrateStoreChecksWallets(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	legacy := types.DefaultParams()
+	ctx.KVStore(storeKey).Set([]byte("p_rewards"), cdc.MustMarshal(&legacy))
+
+	// Blocked wallets abort the migration.
+	blocked := errors.New("blocked wallet")
+	err := v2.MigrateStore(ctx, storeService, cdc, addressCodec, func(context.Context, []string) error { return blocked })
+	require.ErrorIs(t, err, blocked)
+
+	// Legacy wallets are re-encoded with the chain's prefix.
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc, addresscodec.NewBech32Codec("zenoda"), allowWallets))
+
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	_, err = sb.Build()
+	require.NoError(t, err)
+	params, err := paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Len(t, params.PredefinedWallets, len(legacy.PredefinedWallets))
+	for _, wallet := range params.PredefinedWallets {
+		require.True(t, strings.HasPrefix(wallet, "zenoda1"), wallet)
+	}
+}
@@ -17,6 +17,145 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "PendingInflationChange",
+					Use:       "pending-inflation-change",
+					Short:     "Shows the inflation rate change scheduled for the next epoch boundary",
+				},
+				{
+					RpcMethod: "PendingParamChanges",
+					Use:       "pending-param-changes",
+					Short:     "Lists the params updates waiting in the timelock queue",
+				},
+				{
+					RpcMethod:      "VotingPower",
+					Use:            "voting-power [address]",
+					Short:          "Shows the own and delegated voting power of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Tally",
+					Use:            "tally [proposal-id]",
+					Short:          "Shows the contribution-weighted tally of the votes on an x/gov proposal in its voting period",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "VotingDelegation",
+					Use:            "voting-delegation [delegator]",
+					Short:          "Shows the voting power delegation made by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod:      "VotingDelegationsTo",
+					Use:            "voting-delegations-to [delegatee]",
+					Short:          "Lists the voting power delegations made to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegatee"}},
+				},
+				{
+					RpcMethod: "Candidates",
+					Use:       "candidates",
+					Short:     "Lists the addresses registered for governance wallet elections",
+				},
+				{
+					RpcMethod: "Councils",
+					Use:       "councils",
+					Short:     "Lists the history of elected governance wallet sets",
+				},
+				{
+					RpcMethod:      "Council",
+					Use:            "council [term]",
+					Short:          "Shows the governance wallet set elected in a term",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "term"}},
+				},
+				{
+					RpcMethod:      "EpochContributions",
+					Use:            "epoch-contributions [address]",
+					Short:          "Shows the transactions of an address per epoch over an epoch range",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "EpochTotals",
+					Use:       "epoch-totals",
+					Short:     "Shows the network transactions per epoch over an epoch range",
+				},
+				{
+					RpcMethod:      "ContributionScore",
+					Use:            "contribution-score [address]",
+					Short:          "Shows the decayed contribution score of an address and of the network",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "EstimatedReward",
+					Use:            "estimated-reward [address]",
+					Short:          "Shows the reward an address would receive if rewards were distributed now",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "RewardWithdrawAddress",
+					Use:            "reward-withdraw-address [address]",
+					Short:          "Shows the address the rewards of an account are paid to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "VestingRewards",
+					Use:            "vesting-rewards [address]",
+					Short:          "Shows the reward vesting positions of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "RewardPool",
+					Use:       "reward-pool",
+					Short:     "Shows the undistributed reward pool in every denom",
+				},
+				{
+					RpcMethod: "EpochRewards",
+					Use:       "epoch-rewards",
+					Short:     "Shows the rewards distributed per denom over an epoch range",
+				},
+				{
+					RpcMethod:      "AddressRewards",
+					Use:            "address-rewards [address]",
+					Short:          "Shows the rewards paid out per denom to an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Campaigns",
+					Use:       "campaigns",
+					Short:     "List the sponsored incentive campaigns",
+				},
+				{
+					RpcMethod:      "Campaign",
+					Use:            "campaign [id]",
+					Short:          "Shows a sponsored incentive campaign and its contributions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "SupplyInfo",
+					Use:       "supply-info",
+					Short:     "Shows the EGV minted, burned and circulating supply and the supply cap",
+				},
+				{
+					RpcMethod: "CurrentInflation",
+					Use:       "current-inflation",
+					Short:     "Shows the effective inflation rate of the current epoch",
+				},
+				{
+					RpcMethod:      "Participation",
+					Use:            "participation [address]",
+					Short:          "Shows the governance participation of an address per epoch over an epoch range",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Lock",
+					Use:            "lock [address]",
+					Short:          "Shows the EGV an address has locked, its contribution boost and its pending unlocks",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Locks",
+					Use:       "locks",
+					Short:     "Lists the EGV locked by every address",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +167,68 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod:      "VetoParamChange",
+					Use:            "veto-param-change [change-id]",
+					Short:          "Veto a params update waiting in the timelock queue",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "change_id"}},
+				},
+				{
+					RpcMethod:      "DelegateVotingPower",
+					Use:            "delegate-voting-power [delegatee]",
+					Short:          "Delegate your contribution-weighted voting power to another address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegatee"}},
+				},
+				{
+					RpcMethod: "UndelegateVotingPower",
+					Use:       "undelegate-voting-power",
+					Short:     "Take back your delegated voting power",
+				},
+				{
+					RpcMethod: "RegisterCandidate",
+					Use:       "register-candidate",
+					Short:     "Stand for election as a governance wallet",
+				},
+				{
+					RpcMethod: "WithdrawCandidacy",
+					Use:       "withdraw-candidacy",
+					Short:     "Withdraw from governance wallet elections",
+				},
+				{
+					RpcMethod:      "SetRewardWithdrawAddress",
+					Use:            "set-reward-withdraw-address [withdraw-address]",
+					Short:          "Set the address your rewards are paid to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "withdraw_address"}},
+				},
+				{
+					RpcMethod: "WithdrawVested",
+					Use:       "withdraw-vested",
+					Short:     "Withdraw your vested rewards",
+				},
+				{
+					RpcMethod:      "FundRewardsPool",
+					Use:            "fund-rewards-pool [amount]",
+					Short:          "Pay coins into the reward pool, or into a new campaign with --campaign",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RefundCampaign",
+					Use:            "refund-campaign [campaign-id]",
+					Short:          "Refund the unspent funds of an ended campaign you sponsored",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "campaign_id"}},
+				},
+				{
+					RpcMethod:      "LockEGV",
+					Use:            "lock-egv [amount]",
+					Short:          "Lock EGV to boost your contribution weight for rewards and governance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "UnlockEGV",
+					Use:            "unlock-egv [amount]",
+					Short:          "Unlock EGV, returned to you after the unbonding period",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
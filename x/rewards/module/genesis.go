@@ -12,98 +12,333 @@ import (
 
 // InitGenesis initializes the module's state from the provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	ctx.Logger().Info("🚀 Initializing rewards module genesis...")
-
-	// // Get the rewards module account address
-	// moduleAddr := k.GetAccountKeeper().GetModuleAddress(types.ModuleName)
-
-	// // Retrieve the account from the store using the GetAccount method
-	// account := k.GetAccountKeeper().GetAccount(ctx, moduleAddr)
+	// Encode the governance wallets with the chain's address prefix
+	params, err := genState.Params.NormalizeWallets(k.AddressCodec())
+	if err != nil {
+		panic(fmt.Sprintf("invalid predefined wallets in genesis: %s", err))
+	}
+	genState.Params = params
 
-	// // Check if the account exists
-	// if account == nil {
-	// 	ctx.Logger().Info("❌ Rewards module account does not exist, creating...")
-	// 	// Create and set the rewards module account
-	// 	account = k.GetAccountKeeper().NewAccountWithAddress(ctx, moduleAddr)
-	// 	k.GetAccountKeeper().SetAccount(ctx, account) // Set the newly created account
-	// 	ctx.Logger().Info("✅ Created rewards module account", "address", moduleAddr.String())
-	// } else {
-	// 	ctx.Logger().Info("✅ Rewards module account already exists", "address", moduleAddr.String())
-	// }
+	// Register the EGV denom metadata so wallets and explorers can display it
+	if genState.DenomMetadata.Base != "" {
+		k.GetBankKeeper().SetDenomMetaData(ctx, genState.DenomMetadata)
+	}
 
-	// // Check again to ensure the module account is registered before minting
-	// if !k.GetAccountKeeper().HasAccount(ctx, moduleAddr) {
-	// 	panic("module account rewards does not exist after creation")
-	// }
+	// Store params first so that minting is checked against max_supply
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
 
-	// // Verify the module account address
-	// ctx.Logger().Info("Module account address for minting", "address", moduleAddr.String())
+	// Give every predefined wallet its initial EGV. The distribution runs
+	// only on a chain without any EGV yet: an exported state already holds
+	// the distributed balances in x/bank, which is initialized first.
+	if k.GetTotalSupply(ctx).IsZero() {
+		initialAmount := sdk.NewCoin(genState.Params.RewardDenom, math.NewInt(types.InitialWalletBalance))
+		totalSupply := math.NewInt(int64(len(genState.Params.PredefinedWallets))).Mul(initialAmount.Amount)
+		if err := k.MintEGV(ctx, totalSupply); err != nil {
+			panic(err)
+		}
+		for _, addressStr := range genState.Params.PredefinedWallets {
+			address, err := k.AddressCodec().StringToBytes(addressStr)
+			if err != nil {
+				panic(fmt.Sprintf("invalid address in genesis: %s", err))
+			}
+			if err := k.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(initialAmount)); err != nil {
+				panic(err)
+			}
+		}
+	} else if err := k.SetTotalSupply(ctx, k.GetTotalSupply(ctx)); err != nil {
+		panic(err)
+	}
 
-	// Directly create a new module account using the module name "rewards" (from keys.go)
-	moduleAddr := sdk.AccAddress(types.ModuleName) // "rewards" should be used from keys.go
-	// Create the new module account using NewAccountWithAddress
-	account := k.GetAccountKeeper().NewAccountWithAddress(ctx, moduleAddr)
+	// Restore the EGV burned so far
+	if genState.BurnedSupply != "" {
+		burned, ok := math.NewIntFromString(genState.BurnedSupply)
+		if !ok {
+			panic(fmt.Sprintf("invalid burned supply in genesis: %s", genState.BurnedSupply))
+		}
+		if burned.IsPositive() {
+			if err := k.SetBurnedSupply(ctx, burned); err != nil {
+				panic(err)
+			}
+		}
+	}
 
-	// Set the newly created account in the store
-	k.GetAccountKeeper().SetAccount(ctx, account)
+	// Store any inflation change still waiting for activation
+	if genState.PendingInflationChange != nil {
+		if err := k.SetPendingInflationChange(ctx, *genState.PendingInflationChange); err != nil {
+			panic(err)
+		}
+	}
 
-	// Log the creation of the module account
-	ctx.Logger().Info("✅ Created rewards module account", moduleAddr)
-	ctx.Logger().Info("✅ Created rewards module account", "address", moduleAddr.String())
+	// Restore the timelock queue
+	for _, change := range genState.QueuedParamChanges {
+		if err := k.SetQueuedParamChange(ctx, change); err != nil {
+			panic(err)
+		}
+	}
 
-	// Retrieve the account from the store using GetAccount
-	account = k.GetAccountKeeper().GetAccount(ctx, moduleAddr)
+	// Restore voting power delegations
+	for _, delegation := range genState.VotingDelegations {
+		if err := k.SetVotingDelegation(ctx, delegation); err != nil {
+			panic(err)
+		}
+	}
 
-	// Log account details to verify
-	ctx.Logger().Info("Account retrieved", "address", moduleAddr.String(), "account details", account)
+	// Restore election candidates and the council history
+	for _, candidate := range genState.Candidates {
+		if err := k.SetCandidate(ctx, candidate); err != nil {
+			panic(err)
+		}
+	}
+	for _, council := range genState.Councils {
+		if err := k.SetCouncil(ctx, council); err != nil {
+			panic(err)
+		}
+	}
 
-	// Define the initial amount each predefined wallet will receive
-	initialAmount := sdk.NewCoin(types.EGVDenom, math.NewInt(1000)) // Each gets 1000 EGV
-	totalSupply := math.NewInt(int64(len(genState.Params.PredefinedWallets))).Mul(initialAmount.Amount)
+	// Restore reward withdraw addresses
+	for _, entry := range genState.RewardWithdrawAddresses {
+		addr, err := k.AddressCodec().StringToBytes(entry.Address)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAddr, err := k.AddressCodec().StringToBytes(entry.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.SetRewardWithdrawAddress(ctx, addr, withdrawAddr); err != nil {
+			panic(err)
+		}
+	}
 
-	// Ensure that the module account exists before minting
-	ctx.Logger().Info("Checking if the module account exists before minting", "moduleAccountExists", k.GetAccountKeeper().HasAccount(ctx, moduleAddr))
+	// Restore reward vesting positions
+	for _, position := range genState.VestingPositions {
+		if err := k.SetVestingPosition(ctx, position); err != nil {
+			panic(err)
+		}
+	}
 
-	// Explicitly log the module address before minting
-	ctx.Logger().Info("Attempting to mint coins for the module account", "moduleAddress", moduleAddr.String())
+	// Restore sponsored campaigns and their contributions
+	for _, campaign := range genState.Campaigns {
+		if err := k.SetCampaign(ctx, campaign); err != nil {
+			panic(err)
+		}
+	}
+	for _, contribution := range genState.CampaignContributions {
+		if err := k.SetCampaignContribution(ctx, contribution); err != nil {
+			panic(err)
+		}
+	}
 
-	// Mint tokens only once for the total supply
-	err := k.GetBankKeeper().MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.EGVDenom, totalSupply)))
-	if err != nil {
-		ctx.Logger().Error("❌ Failed to mint initial EGV tokens", "error", err)
-		panic(err)
+	// Restore governance participation
+	for _, participation := range genState.Participations {
+		if err := k.SetParticipation(ctx, participation); err != nil {
+			panic(err)
+		}
+	}
+	for _, participation := range genState.EpochParticipations {
+		if err := k.SetEpochParticipation(ctx, participation); err != nil {
+			panic(err)
+		}
+	}
+	for _, vote := range genState.ProposalVotes {
+		if err := k.SetProposalVote(ctx, vote); err != nil {
+			panic(err)
+		}
 	}
 
-	ctx.Logger().Info("💰 Minted total EGV tokens for initial distribution", "amount", totalSupply)
+	// Restore the contribution counters and scores before the locks, which
+	// move the lock bonus by them
+	for _, count := range genState.TransactionCounts {
+		if err := k.SetTransactionCount(ctx, count); err != nil {
+			panic(err)
+		}
+	}
+	if genState.TotalTransactions > 0 {
+		if err := k.SetTotalTransactions(ctx, genState.TotalTransactions); err != nil {
+			panic(err)
+		}
+	}
+	for _, count := range genState.EpochTransactionCounts {
+		if err := k.SetEpochTransactionCount(ctx, count); err != nil {
+			panic(err)
+		}
+	}
+	for _, total := range genState.EpochTotalTransactions {
+		if err := k.SetEpochTotalTransactions(ctx, total); err != nil {
+			panic(err)
+		}
+	}
+	for _, score := range genState.ContributionScores {
+		if err := k.SetContributionScore(ctx, score); err != nil {
+			panic(err)
+		}
+	}
+	if genState.TotalContributionScore != nil {
+		if err := k.SetStoredTotalContributionScore(ctx, *genState.TotalContributionScore); err != nil {
+			panic(err)
+		}
+	}
 
-	// Distribute to predefined wallets
-	for _, addressStr := range genState.Params.PredefinedWallets {
-		address, err := sdk.AccAddressFromBech32(addressStr)
-		if err != nil {
-			ctx.Logger().Error("❌ Invalid predefined wallet address", "address", addressStr, "error", err)
-			panic(fmt.Sprintf("invalid address in genesis: %s", err))
+	// Restore the reward history
+	for _, reward := range genState.EpochRewards {
+		if err := k.SetEpochReward(ctx, reward); err != nil {
+			panic(err)
 		}
+	}
+	for _, reward := range genState.AddressRewards {
+		if err := k.SetAddressRewards(ctx, reward); err != nil {
+			panic(err)
+		}
+	}
 
-		// Send tokens from module account to predefined wallet
-		err = k.GetBankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, sdk.NewCoins(initialAmount))
+	// Restore EGV locks and unlocks
+	for _, lock := range genState.Locks {
+		if err := k.SetEGVLock(ctx, lock); err != nil {
+			panic(err)
+		}
+	}
+	for _, unlock := range genState.Unlocks {
+		if err := k.SetEGVUnlock(ctx, unlock); err != nil {
+			panic(err)
+		}
+	}
+	// The exported lock bonuses replace the ones the locks added up to: they
+	// carry the rounding of every update since the locks were taken.
+	if genState.LockBonus != "" {
+		bonus, err := math.LegacyNewDecFromStr(genState.LockBonus)
 		if err != nil {
-			ctx.Logger().Error("❌ Failed to send EGV tokens to address", "address", addressStr, "error", err)
+			panic(fmt.Sprintf("invalid lock bonus in genesis: %s", genState.LockBonus))
+		}
+		if err := k.SetLockBonus(ctx, bonus); err != nil {
+			panic(err)
+		}
+	}
+	if genState.LockScoreBonus != nil {
+		if err := k.SetLockScoreBonus(ctx, *genState.LockScoreBonus); err != nil {
 			panic(err)
 		}
-
-		ctx.Logger().Info("✅ Distributed initial EGV tokens", "address", addressStr, "amount", initialAmount.Amount)
 	}
 
-	// Store total supply in the keeper
-	k.SetTotalSupply(ctx, sdk.NewCoin(types.EGVDenom, totalSupply))
-
-	ctx.Logger().Info("✅ Rewards module genesis successfully initialized")
+	if genState.GovernanceSetRemovals != nil {
+		if err := k.SetGovernanceSetRemovals(ctx, *genState.GovernanceSetRemovals); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the module's state.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
-	genesis.Params = k.GetParams(ctx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Params = params
+	genesis.DenomMetadata, _ = k.GetBankKeeper().GetDenomMetaData(ctx, params.RewardDenom)
+	change, found, err := k.GetPendingInflationChange(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		genesis.PendingInflationChange = &change
+	}
+	if genesis.QueuedParamChanges, err = k.GetAllQueuedParamChanges(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.VotingDelegations, err = k.GetAllVotingDelegations(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.Candidates, err = k.GetAllCandidates(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.Councils, err = k.GetAllCouncils(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.RewardWithdrawAddresses, err = k.GetAllRewardWithdrawAddresses(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.VestingPositions, err = k.GetAllVestingPositions(ctx); err != nil {
+		panic(err)
+	}
+	burned, err := k.GetBurnedSupply(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.BurnedSupply = burned.String()
+	if genesis.Campaigns, err = k.GetAllCampaigns(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.CampaignContributions, err = k.GetAllCampaignContributions(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.Participations, err = k.GetAllParticipations(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.EpochParticipations, err = k.GetAllEpochParticipations(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.ProposalVotes, err = k.GetAllProposalVotes(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.Locks, err = k.GetAllEGVLocks(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.Unlocks, err = k.GetAllEGVUnlocks(ctx); err != nil {
+		panic(err)
+	}
+	lockBonus, found, err := k.GetStoredLockBonus(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		genesis.LockBonus = lockBonus.String()
+	}
+	lockScoreBonus, found, err := k.GetStoredLockScoreBonus(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		genesis.LockScoreBonus = &lockScoreBonus
+	}
+	if genesis.TransactionCounts, err = k.GetAllTransactionCounts(ctx); err != nil {
+		panic(err)
+	}
+	genesis.TotalTransactions = k.GetTotalTransactions(ctx)
+	if genesis.EpochTransactionCounts, err = k.GetAllEpochTransactionCounts(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.EpochTotalTransactions, err = k.GetAllEpochTotalTransactions(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.ContributionScores, err = k.GetAllContributionScores(ctx); err != nil {
+		panic(err)
+	}
+	totalScore, found, err := k.GetStoredTotalContributionScore(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		genesis.TotalContributionScore = &totalScore
+	}
+	if genesis.EpochRewards, err = k.GetAllEpochRewards(ctx); err != nil {
+		panic(err)
+	}
+	if genesis.AddressRewards, err = k.GetAllAddressRewards(ctx); err != nil {
+		panic(err)
+	}
+	epoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		panic(err)
+	}
+	removals, err := k.GetGovernanceSetRemovals(ctx, epoch)
+	if err != nil {
+		panic(err)
+	}
+	if len(removals.Wallets) > 0 {
+		genesis.GovernanceSetRemovals = &removals
+	}
 
 	return genesis
 }
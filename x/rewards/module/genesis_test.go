@@ -5,15 +5,18 @@ import (
 
 	keepertest "zenoda/testutil/keeper"
 	"zenoda/testutil/nullify"
+	"zenoda/testutil/sample"
 	rewards "zenoda/x/rewards/module"
 	"zenoda/x/rewards/types"
 
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params: sample.RewardsParams(),
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
@@ -28,3 +31,125 @@ func TestGenesis(t *testing.T) {
 
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisDenomMetadata(t *testing.T) {
+	genesisState := *types.DefaultGenesis()
+
+	k, ctx := keepertest.RewardsKeeper(t)
+	rewards.InitGenesis(ctx, k, genesisState)
+
+	metadata, found := k.GetBankKeeper().GetDenomMetaData(ctx, types.DefaultRewardDenom)
+	require.True(t, found)
+	require.Equal(t, types.DefaultDenomMetadata(), metadata)
+
+	got := rewards.ExportGenesis(ctx, k)
+	require.Equal(t, genesisState.DenomMetadata, got.DenomMetadata)
+}
+
+func TestGenesisRewardDenom(t *testing.T) {
+	genesisState := types.GenesisState{Params: sample.RewardsParams()}
+	genesisState.Params.RewardDenom = "zen"
+
+	k, ctx := keepertest.RewardsKeeper(t)
+	rewards.InitGenesis(ctx, k, genesisState)
+
+	require.Equal(t, "zen", k.GetRewardDenom(ctx))
+	require.Equal(t, "zen", k.GetTotalSupply(ctx).Denom)
+	require.Equal(t, int64(10*types.InitialWalletBalance), k.GetTotalSupply(ctx).Amount.Int64())
+	require.True(t, k.GetBankKeeper().GetSupply(ctx, types.DefaultRewardDenom).IsZero())
+}
+
+func TestGenesisRewardWithdrawAddressesUseChainPrefix(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeperWithPrefix(t, "zenoda")
+	addr, err := k.AddressCodec().BytesToString(sdk.AccAddress("account_____________"))
+	require.NoError(t, err)
+	withdrawAddr, err := k.AddressCodec().BytesToString(sdk.AccAddress("withdraw____________"))
+	require.NoError(t, err)
+
+	genesisState := types.GenesisState{
+		Params: sample.RewardsParams(),
+		RewardWithdrawAddresses: []types.RewardWithdrawAddress{
+			{Address: addr, WithdrawAddress: withdrawAddr},
+		},
+	}
+	rewards.InitGenesis(ctx, k, genesisState)
+
+	got, err := k.GetRewardWithdrawAddress(ctx, sdk.AccAddress("account_____________"))
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress("withdraw____________"), got)
+}
+
+func TestGenesisImportDoesNotMintAgain(t *testing.T) {
+	genesisState := types.GenesisState{Params: sample.RewardsParams()}
+	genesisState.Params.MaxSupply = math.NewInt(10 * types.InitialWalletBalance).String()
+
+	k, ctx := keepertest.RewardsKeeper(t)
+	rewards.InitGenesis(ctx, k, genesisState)
+	supply := k.GetTotalSupply(ctx)
+
+	// Importing the exported state over the distributed balances mints
+	// nothing, so it stays within max_supply.
+	exported := rewards.ExportGenesis(ctx, k)
+	require.NotPanics(t, func() { rewards.InitGenesis(ctx, k, *exported) })
+	require.Equal(t, supply, k.GetTotalSupply(ctx))
+}
+
+func TestGenesisContributionAndRewardHistory(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	addr, err := k.AddressCodec().BytesToString(sdk.AccAddress("contributor_________"))
+	require.NoError(t, err)
+	rewards10 := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultRewardDenom, 10))
+
+	genesisState := types.GenesisState{
+		Params:                 sample.RewardsParams(),
+		TransactionCounts:      []types.TransactionCount{{Address: addr, Count: 7}},
+		TotalTransactions:      12,
+		EpochTransactionCounts: []types.EpochTransactionCount{{Epoch: 0, Address: addr, Count: 7}},
+		EpochTotalTransactions: []types.EpochContribution{{Epoch: 0, Count: 12}},
+		ContributionScores: []types.AddressContributionScore{
+			{Address: addr, Score: types.NewContributionScore(math.LegacyNewDec(7), 0)},
+		},
+		TotalContributionScore: &types.ContributionScore{Score: math.LegacyNewDec(12).String()},
+		EpochRewards:           []types.EpochReward{{Epoch: 0, Rewards: rewards10}},
+		AddressRewards:         []types.AddressReward{{Address: addr, Rewards: rewards10}},
+		LockBonus:              math.LegacyNewDecWithPrec(35, 1).String(),
+		LockScoreBonus:         &types.ContributionScore{Score: math.LegacyNewDecWithPrec(25, 1).String()},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	rewards.InitGenesis(ctx, k, genesisState)
+	require.Equal(t, uint64(7), k.GetTransactionCount(ctx, sdk.AccAddress("contributor_________")))
+	require.Equal(t, uint64(12), k.GetTotalTransactions(ctx))
+
+	got := rewards.ExportGenesis(ctx, k)
+	require.Equal(t, genesisState.TransactionCounts, got.TransactionCounts)
+	require.Equal(t, genesisState.TotalTransactions, got.TotalTransactions)
+	require.Equal(t, genesisState.EpochTransactionCounts, got.EpochTransactionCounts)
+	require.Equal(t, genesisState.EpochTotalTransactions, got.EpochTotalTransactions)
+	require.Equal(t, genesisState.ContributionScores, got.ContributionScores)
+	require.Equal(t, genesisState.TotalContributionScore, got.TotalContributionScore)
+	require.Equal(t, genesisState.EpochRewards, got.EpochRewards)
+	require.Equal(t, genesisState.AddressRewards, got.AddressRewards)
+	require.Equal(t, genesisState.LockBonus, got.LockBonus)
+	require.Equal(t, genesisState.LockScoreBonus, got.LockScoreBonus)
+}
+
+func TestGenesisRestoresSequences(t *testing.T) {
+	params := sample.RewardsParams()
+	genesisState := types.GenesisState{
+		Params:             params,
+		QueuedParamChanges: []types.QueuedParamChange{{Id: 4, Params: params, ExecuteHeight: 100}},
+		Councils:           []types.Council{{Term: 2, Members: params.PredefinedWallets}},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.RewardsKeeper(t)
+	rewards.InitGenesis(ctx, k, genesisState)
+
+	term, err := k.GetCouncilCount(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), term)
+	change, err := k.QueueParamChange(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), change.Id)
+}
@@ -15,6 +15,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
@@ -119,10 +121,17 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -142,18 +151,38 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+func (am AppModule) BeginBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := am.keeper.CollectFeeShare(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.DistributeEpochRewards(ctx); err != nil {
+		return err
+	}
+	return am.keeper.SettleCampaigns(ctx)
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
-	return nil
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := am.keeper.ProcessQueuedParamChanges(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ApplyPendingInflationChange(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessElection(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.CompleteUnlocks(ctx); err != nil {
+		return err
+	}
+	return am.keeper.PruneContributionHistory(ctx)
 }
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -183,13 +212,16 @@ type ModuleInputs struct {
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
+	GovKeeper     *govkeeper.Keeper
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	RewardsKeeper keeper.Keeper
-	Module        appmodule.AppModule
+	RewardsKeeper     keeper.Keeper
+	Module            appmodule.AppModule
+	SendRestrictionFn banktypes.SendRestrictionFn
+	GovHooks          govtypes.GovHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -205,6 +237,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority.String(),
 		in.BankKeeper,
 		in.AccountKeeper,
+		govkeeper.NewQueryServer(in.GovKeeper),
 	)
 	m := NewAppModule(
 		in.Cdc,
@@ -213,5 +246,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountKeeper,
 	)
 
-	return ModuleOutputs{RewardsKeeper: k, Module: m}
+	return ModuleOutputs{
+		RewardsKeeper:     k,
+		Module:            m,
+		SendRestrictionFn: k.SendRestrictionFn,
+		GovHooks:          govtypes.GovHooksWrapper{GovHooks: k.Hooks()},
+	}
 }
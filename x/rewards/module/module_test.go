@@ -0,0 +1,39 @@
+package rewards_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	rewards "zenoda/x/rewards/module"
+	"zenoda/x/rewards/types"
+)
+
+func TestBeginBlockMintsEpochInflation(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	am := rewards.NewAppModule(nil, k, k.GetBankKeeper(), k.GetAccountKeeper())
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.EpochLength = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	supply := sdk.NewCoins(sdk.NewCoin(types.DefaultRewardDenom, math.NewInt(100_000)))
+	require.NoError(t, k.GetBankKeeper().MintCoins(ctx, types.ModuleName, supply))
+
+	wallet, err := sdk.AccAddressFromBech32(params.PredefinedWallets[0])
+	require.NoError(t, err)
+	require.NoError(t, k.IncrementTransactionCount(ctx, wallet))
+
+	// Nothing is minted inside an epoch.
+	require.NoError(t, am.BeginBlock(ctx.WithBlockHeight(9)))
+	require.Equal(t, math.NewInt(100_000), k.GetTotalSupply(ctx).Amount)
+
+	// At the epoch boundary 5% of the supply is minted and paid out.
+	require.NoError(t, am.BeginBlock(ctx.WithBlockHeight(10)))
+	require.Equal(t, math.NewInt(105_000), k.GetTotalSupply(ctx).Amount)
+	require.Equal(t, math.NewInt(5000), k.GetBankKeeper().GetBalance(ctx, wallet, types.DefaultRewardDenom).Amount)
+}
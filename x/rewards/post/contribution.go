@@ -0,0 +1,74 @@
+package post
+
+import (
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/types"
+)
+
+// ContributionDecorator records the contributions of every successful
+// transaction once it has run: one transaction for each distinct signer, and
+// each message towards the running campaigns it matches.
+type ContributionDecorator struct {
+	cdc    codec.Codec
+	keeper keeper.Keeper
+}
+
+// NewContributionDecorator creates a new ContributionDecorator.
+func NewContributionDecorator(cdc codec.Codec, k keeper.Keeper) ContributionDecorator {
+	return ContributionDecorator{cdc: cdc, keeper: k}
+}
+
+// PostHandle implements sdk.PostDecorator. Bookkeeping never fails a
+// transaction that has already succeeded: errors are logged and the partial
+// writes dropped.
+func (d ContributionDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || simulate {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// Gentxs are delivered at InitChain before the rewards genesis has set
+	// the params; there is nothing to record them against yet.
+	if _, err := d.keeper.GetParams(ctx); errors.Is(err, types.ErrParamsNotFound) {
+		return next(ctx, tx, simulate, success)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := d.recordContributions(cacheCtx, tx); err != nil {
+		d.keeper.Logger().Error("Failed to record contributions", "error", err)
+	} else {
+		write()
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// recordContributions counts the transaction once for each distinct signer
+// and each message towards the campaigns it matches.
+func (d ContributionDecorator) recordContributions(ctx sdk.Context, tx sdk.Tx) error {
+	counted := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		signers, _, err := d.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return err
+		}
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		for _, signer := range signers {
+			addr := sdk.AccAddress(signer)
+			if !counted[string(addr)] {
+				counted[string(addr)] = true
+				if err := d.keeper.IncrementTransactionCount(ctx, addr); err != nil {
+					return err
+				}
+			}
+			if err := d.keeper.RecordMsgContribution(ctx, addr, msgTypeURL); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
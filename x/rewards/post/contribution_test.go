@@ -0,0 +1,101 @@
+package post_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	keepertest "zenoda/testutil/keeper"
+	"zenoda/x/rewards/keeper"
+	"zenoda/x/rewards/post"
+	"zenoda/x/rewards/types"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func newPostHandler(t *testing.T, k keeper.Keeper) sdk.PostHandler {
+	t.Helper()
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          k.AddressCodec(),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		},
+	})
+	require.NoError(t, err)
+	return sdk.ChainPostDecorators(post.NewContributionDecorator(codec.NewProtoCodec(registry), k))
+}
+
+func TestContributionDecorator(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	handler := newPostHandler(t, k)
+
+	wallets, err := k.GetPredefinedAddresses(ctx)
+	require.NoError(t, err)
+	wallet := wallets[0]
+	campaign := types.NewCampaign(1, wallet.String(), types.CampaignSpec{StartEpoch: 0, EndEpoch: 1}, nil)
+	require.NoError(t, k.SetCampaign(ctx, campaign))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	send := banktypes.NewMsgSend(wallet, wallets[1], coins)
+	tx := mockTx{msgs: []sdk.Msg{send, send}}
+
+	// Failed and simulated transactions are not recorded.
+	_, err = handler(ctx, tx, false, false)
+	require.NoError(t, err)
+	_, err = handler(ctx, tx, true, true)
+	require.NoError(t, err)
+	require.Zero(t, k.GetTransactionCount(ctx, wallet))
+
+	// A transaction counts once per signer; every message counts towards the
+	// campaign.
+	_, err = handler(ctx, tx, false, true)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetTransactionCount(ctx, wallet))
+	contributions, err := k.GetCampaignContributions(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []types.CampaignContribution{{CampaignId: 1, Address: wallet.String(), Count: 2}}, contributions)
+}
+
+func TestContributionDecoratorWithoutParams(t *testing.T) {
+	// Gentxs run at InitChain before the rewards genesis has set params.
+	k, ctx := keepertest.RewardsKeeperWithoutParams(t)
+	handler := newPostHandler(t, k)
+
+	from := sdk.AccAddress([]byte("from________________"))
+	send := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	_, err := handler(ctx, mockTx{msgs: []sdk.Msg{send}}, false, true)
+	require.NoError(t, err)
+	require.Zero(t, k.GetTransactionCount(ctx, from))
+}
+
+func TestContributionDecoratorBookkeepingError(t *testing.T) {
+	k, ctx := keepertest.RewardsKeeper(t)
+	handler := newPostHandler(t, k)
+
+	wallets, err := k.GetPredefinedAddresses(ctx)
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	valid := banktypes.NewMsgSend(wallets[0], wallets[1], coins)
+	invalid := &banktypes.MsgSend{FromAddress: "invalid", ToAddress: wallets[1].String(), Amount: coins}
+
+	// A bookkeeping error does not fail the succeeded transaction, and the
+	// contributions recorded before it are dropped.
+	_, err = handler(ctx, mockTx{msgs: []sdk.Msg{valid, invalid}}, false, true)
+	require.NoError(t, err)
+	require.Zero(t, k.GetTransactionCount(ctx, wallets[0]))
+}
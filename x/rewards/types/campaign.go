@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the epoch range and the message filter.
+func (s CampaignSpec) Validate() error {
+	if s.StartEpoch > s.EndEpoch {
+		return fmt.Errorf("start epoch %d is after end epoch %d", s.StartEpoch, s.EndEpoch)
+	}
+	seen := make(map[string]bool)
+	for _, url := range s.MsgTypeUrls {
+		if !strings.HasPrefix(url, "/") || len(url) == 1 {
+			return fmt.Errorf("invalid message type url %q", url)
+		}
+		if seen[url] {
+			return fmt.Errorf("duplicate message type url %q", url)
+		}
+		seen[url] = true
+	}
+	return nil
+}
+
+// Counts reports whether a message of the given type sent in epoch counts
+// as a contribution to the campaign.
+func (s CampaignSpec) Counts(epoch uint64, msgTypeURL string) bool {
+	if epoch < s.StartEpoch || epoch > s.EndEpoch {
+		return false
+	}
+	return len(s.MsgTypeUrls) == 0 || slices.Contains(s.MsgTypeUrls, msgTypeURL)
+}
+
+// NewCampaign creates a new Campaign instance.
+func NewCampaign(id uint64, sponsor string, spec CampaignSpec, funds sdk.Coins) Campaign {
+	return Campaign{
+		Id:      id,
+		Sponsor: sponsor,
+		Spec:    spec,
+		Funds:   funds,
+	}
+}
+
+// Unspent returns the funds neither paid out to contributors nor refunded.
+func (c Campaign) Unspent() sdk.Coins {
+	return c.Funds.Sub(c.Distributed...).Sub(c.Refunded...)
+}
+
+// Validate checks the campaign's sponsor, spec and amounts.
+func (c Campaign) Validate() error {
+	if c.Id == 0 {
+		return fmt.Errorf("campaign id must be positive")
+	}
+	if err := validateAccAddress(c.Sponsor); err != nil {
+		return fmt.Errorf("campaign %d: invalid sponsor: %w", c.Id, err)
+	}
+	if err := c.Spec.Validate(); err != nil {
+		return fmt.Errorf("campaign %d: %w", c.Id, err)
+	}
+	if !c.Funds.IsValid() || c.Funds.IsZero() {
+		return fmt.Errorf("campaign %d: invalid funds %s", c.Id, c.Funds)
+	}
+	if !c.Distributed.IsValid() || !c.Refunded.IsValid() {
+		return fmt.Errorf("campaign %d: invalid distributed or refunded amount", c.Id)
+	}
+	if _, negative := c.Funds.SafeSub(c.Distributed.Add(c.Refunded...)...); negative {
+		return fmt.Errorf("campaign %d: paid out more than its funds", c.Id)
+	}
+	return nil
+}
@@ -12,6 +12,17 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgVetoParamChange{},
+		&MsgDelegateVotingPower{},
+		&MsgUndelegateVotingPower{},
+		&MsgRegisterCandidate{},
+		&MsgWithdrawCandidacy{},
+		&MsgSetRewardWithdrawAddress{},
+		&MsgWithdrawVested{},
+		&MsgFundRewardsPool{},
+		&MsgRefundCampaign{},
+		&MsgLockEGV{},
+		&MsgUnlockEGV{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	math "cosmossdk.io/math"
+)
+
+// NewContributionScore creates a new ContributionScore instance.
+func NewContributionScore(score math.LegacyDec, epoch uint64) ContributionScore {
+	return ContributionScore{
+		Score: score.String(),
+		Epoch: epoch,
+	}
+}
+
+// DecayedTo returns the score decayed from its epoch to the given epoch. A
+// score never grows by decaying, so an earlier epoch returns it unchanged.
+func (s ContributionScore) DecayedTo(epoch, halfLife uint64) (math.LegacyDec, error) {
+	if s.Score == "" {
+		return math.LegacyZeroDec(), nil
+	}
+	score, err := math.LegacyNewDecFromStr(s.Score)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if epoch <= s.Epoch {
+		return score, nil
+	}
+	factor, err := DecayFactor(epoch-s.Epoch, halfLife)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return score.Mul(factor), nil
+}
+
+// DecayFactor returns 0.5^(epochs/halfLife), the share of a score left after
+// the given number of epochs. Whole half-lives halve the score exactly; the
+// remaining epochs use the per-epoch factor, the halfLife-th root of 0.5.
+func DecayFactor(epochs, halfLife uint64) (math.LegacyDec, error) {
+	if halfLife == 0 {
+		return math.LegacyDec{}, ErrInvalidParams.Wrap("score half-life must be positive")
+	}
+
+	half := math.LegacyNewDecWithPrec(5, 1)
+	factor := half.Power(epochs / halfLife)
+	if remainder := epochs % halfLife; remainder > 0 && factor.IsPositive() {
+		perEpoch, err := half.ApproxRoot(halfLife)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		factor = factor.Mul(perEpoch.Power(remainder))
+	}
+	return factor, nil
+}
+
+// Validate checks that a stored score is a non-negative decimal.
+func (s ContributionScore) Validate() error {
+	score, err := math.LegacyNewDecFromStr(s.Score)
+	if err != nil {
+		return fmt.Errorf("invalid contribution score %s: %w", s.Score, err)
+	}
+	if score.IsNegative() {
+		return fmt.Errorf("contribution score %s must not be negative", s.Score)
+	}
+	return nil
+}
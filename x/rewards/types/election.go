@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewCandidate creates a new Candidate instance.
+func NewCandidate(address string, registeredHeight int64) Candidate {
+	return Candidate{
+		Address:          address,
+		RegisteredHeight: registeredHeight,
+	}
+}
+
+// Validate checks the term and the member addresses of a council.
+func (c Council) Validate() error {
+	if c.Term == 0 {
+		return fmt.Errorf("council term must be positive")
+	}
+	seen := make(map[string]bool, len(c.Members))
+	for _, member := range c.Members {
+		if err := validateAccAddress(member); err != nil {
+			return fmt.Errorf("council %d: invalid member address %s: %w", c.Term, member, err)
+		}
+		if seen[member] {
+			return fmt.Errorf("council %d: duplicate member %s", c.Term, member)
+		}
+		seen[member] = true
+	}
+	return nil
+}
+
+// HasMember reports whether the address sits on the council.
+func (c Council) HasMember(address string) bool {
+	for _, member := range c.Members {
+		if member == address {
+			return true
+		}
+	}
+	return false
+}
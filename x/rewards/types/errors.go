@@ -9,5 +9,33 @@ import (
 // x/rewards module sentinel errors
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidParams = sdkerrors.Register(ModuleName, 1101, "invalid rewards params")
+
+	ErrInflationChangeTooLarge = sdkerrors.Register(ModuleName, 1102, "inflation rate change exceeds the maximum allowed per update")
+	ErrInvalidInflationBounds  = sdkerrors.Register(ModuleName, 1103, "inflation rate outside of the allowed bounds")
+	ErrParamChangeNotFound     = sdkerrors.Register(ModuleName, 1104, "queued params change not found")
+	ErrNotGovernanceWallet     = sdkerrors.Register(ModuleName, 1105, "signer is not a governance wallet")
+	ErrAlreadyVetoed           = sdkerrors.Register(ModuleName, 1106, "governance wallet already vetoed this change")
+	ErrSelfDelegation          = sdkerrors.Register(ModuleName, 1107, "cannot delegate voting power to self")
+	ErrDelegationNotFound      = sdkerrors.Register(ModuleName, 1108, "voting power delegation not found")
+	ErrAlreadyCandidate        = sdkerrors.Register(ModuleName, 1109, "address is already an election candidate")
+	ErrCandidateNotFound       = sdkerrors.Register(ModuleName, 1110, "election candidate not found")
+	ErrParamsNotFound          = sdkerrors.Register(ModuleName, 1111, "rewards params not found")
+	ErrLockFailed              = sdkerrors.Register(ModuleName, 1112, "failed to update lock")
+	ErrBlockedAddress          = sdkerrors.Register(ModuleName, 1113, "address is blocked or a module account")
+	ErrGovernanceSetChange     = sdkerrors.Register(ModuleName, 1114, "governance wallet set change too large")
+	ErrNothingVested           = sdkerrors.Register(ModuleName, 1115, "no vested rewards to withdraw")
+	ErrInvalidCampaign         = sdkerrors.Register(ModuleName, 1116, "invalid campaign")
+	ErrCampaignNotFound        = sdkerrors.Register(ModuleName, 1117, "campaign not found")
+	ErrCampaignNotRefundable   = sdkerrors.Register(ModuleName, 1118, "campaign is not refundable")
+	ErrSupplyCapExceeded       = sdkerrors.Register(ModuleName, 1119, "EGV supply cap exceeded")
+	ErrEGVNonTransferable      = sdkerrors.Register(ModuleName, 1120, "EGV is soulbound and cannot be transferred between accounts")
+	ErrRewardDenomImmutable    = sdkerrors.Register(ModuleName, 1121, "reward denom cannot be changed after genesis")
+	ErrWalletSuspended         = sdkerrors.Register(ModuleName, 1122, "governance wallet is suspended for inactivity")
+	ErrInsufficientLocked      = sdkerrors.Register(ModuleName, 1123, "not enough locked EGV")
+	ErrLockBelowMinimum        = sdkerrors.Register(ModuleName, 1124, "lock below the minimum lock amount")
+	ErrCouncilNotFound         = sdkerrors.Register(ModuleName, 1125, "council not found")
+	ErrInflationGuardrail      = sdkerrors.Register(ModuleName, 1126, "inflation guardrail change exceeds the maximum allowed per update")
+	ErrEpochLengthImmutable    = sdkerrors.Register(ModuleName, 1127, "epoch length cannot be changed after genesis")
+	ErrNoVotingWeight          = sdkerrors.Register(ModuleName, 1128, "only governance wallets and election candidates have voting weight to delegate")
 )
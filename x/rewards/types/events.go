@@ -0,0 +1,67 @@
+package types
+
+// rewards module event types
+const (
+	EventTypeInflationChangeScheduled = "inflation_change_scheduled"
+	EventTypeInflationChangeApplied   = "inflation_change_applied"
+	EventTypeParamChangeQueued        = "param_change_queued"
+	EventTypeParamChangeVetoed        = "param_change_vetoed"
+	EventTypeParamChangeCancelled     = "param_change_cancelled"
+	EventTypeParamChangeExecuted      = "param_change_executed"
+	EventTypeParamChangeFailed        = "param_change_failed"
+	EventTypeDelegateVotingPower      = "delegate_voting_power"
+	EventTypeUndelegateVotingPower    = "undelegate_voting_power"
+	EventTypeRegisterCandidate        = "register_candidate"
+	EventTypeWithdrawCandidacy        = "withdraw_candidacy"
+	EventTypeCouncilElected           = "council_elected"
+	EventTypeElectionFailed           = "election_failed"
+	EventTypeDistributeReward         = "distribute_reward"
+	EventTypeSetRewardWithdrawAddress = "set_reward_withdraw_address"
+	EventTypeWithdrawVested           = "withdraw_vested"
+	EventTypeCollectFeeShare          = "collect_fee_share"
+	EventTypeFundRewardsPool          = "fund_rewards_pool"
+	EventTypeSettleCampaign           = "settle_campaign"
+	EventTypeDistributeCampaignReward = "distribute_campaign_reward"
+	EventTypeRefundCampaign           = "refund_campaign"
+	EventTypeMintInflation            = "mint_inflation"
+	EventTypeBurnRewards              = "burn_rewards"
+	EventTypeInactivityPenalty        = "inactivity_penalty"
+	EventTypeParticipationFailed      = "participation_failed"
+	EventTypeLockEGV                  = "lock_egv"
+	EventTypeUnlockEGV                = "unlock_egv"
+	EventTypeCompleteUnlock           = "complete_unlock"
+
+	AttributeKeyInflationRate         = "inflation_rate"
+	AttributeKeyPreviousInflationRate = "previous_inflation_rate"
+	AttributeKeyActivationHeight      = "activation_height"
+	AttributeKeyChangeID              = "change_id"
+	AttributeKeyExecuteHeight         = "execute_height"
+	AttributeKeyVoter                 = "voter"
+	AttributeKeyVetoes                = "vetoes"
+	AttributeKeyError                 = "error"
+	AttributeKeyDelegator             = "delegator"
+	AttributeKeyDelegatee             = "delegatee"
+	AttributeKeyCandidate             = "candidate"
+	AttributeKeyTerm                  = "term"
+	AttributeKeyEpoch                 = "epoch"
+	AttributeKeyMembers               = "members"
+	AttributeKeyRecipient             = "recipient"
+	AttributeKeyAmount                = "amount"
+	AttributeKeyUncappedAmount        = "uncapped_amount"
+	AttributeKeyShare                 = "share"
+	AttributeKeyUncappedShare         = "uncapped_share"
+	AttributeKeyAddress               = "address"
+	AttributeKeyWithdrawAddress       = "withdraw_address"
+	AttributeKeyVestingAmount         = "vesting_amount"
+	AttributeKeyVestingPositionID     = "vesting_position_id"
+	AttributeKeySender                = "sender"
+	AttributeKeyCampaignID            = "campaign_id"
+	AttributeKeySponsor               = "sponsor"
+	AttributeKeyPenalty               = "penalty"
+	AttributeKeyMissedActions         = "missed_actions"
+	AttributeKeyPenaltyEndEpoch       = "penalty_end_epoch"
+	AttributeKeyLocked                = "locked"
+	AttributeKeyUnlockID              = "unlock_id"
+	AttributeKeyCompletionHeight      = "completion_height"
+	AttributeKeyProposalID            = "proposal_id"
+)
@@ -1,15 +1,41 @@
 package types
 
+import (
+	"fmt"
+
+	math "cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// InitialWalletBalance is the EGV minted at genesis for each predefined wallet
+const InitialWalletBalance int64 = 1000
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:        DefaultParams(),
+		DenomMetadata: DefaultDenomMetadata(),
+	}
+}
+
+// DefaultDenomMetadata returns the EGV denom metadata. EGV is only ever
+// handled in whole tokens, so the base unit is also the display unit.
+func DefaultDenomMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: "The Zenoda governance token, earned by contributing transactions to the network.",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: DefaultRewardDenom, Exponent: 0, Aliases: []string{"EGV"}},
+		},
+		Base:    DefaultRewardDenom,
+		Display: DefaultRewardDenom,
+		Name:    "Zenoda Governance",
+		Symbol:  "EGV",
 	}
 }
 
@@ -18,5 +44,259 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	// Genesis files from before denom metadata was added leave it empty.
+	if gs.DenomMetadata.Base != "" {
+		if err := gs.DenomMetadata.Validate(); err != nil {
+			return fmt.Errorf("invalid denom metadata: %w", err)
+		}
+		if gs.DenomMetadata.Base != gs.Params.RewardDenom {
+			return fmt.Errorf("denom metadata base %s must be the reward denom %s", gs.DenomMetadata.Base, gs.Params.RewardDenom)
+		}
+	}
+
+	maxSupply, err := gs.Params.GetMaxSupplyAsInt()
+	if err != nil {
+		return err
+	}
+	initialSupply := math.NewInt(InitialWalletBalance).MulRaw(int64(len(gs.Params.PredefinedWallets)))
+	if maxSupply.IsPositive() && initialSupply.GT(maxSupply) {
+		return fmt.Errorf("initial EGV supply %s exceeds max supply %s", initialSupply, maxSupply)
+	}
+	// A saturation above the initial supply leaves the full lock boost out of
+	// reach.
+	if saturation, ok := math.NewIntFromString(gs.Params.LockBoostSaturation); ok && saturation.GT(initialSupply) {
+		return fmt.Errorf("lock boost saturation %s exceeds initial EGV supply %s", saturation, initialSupply)
+	}
+	if gs.BurnedSupply != "" {
+		burned, ok := math.NewIntFromString(gs.BurnedSupply)
+		if !ok || burned.IsNegative() {
+			return fmt.Errorf("invalid burned supply %s", gs.BurnedSupply)
+		}
+	}
+
+	if gs.PendingInflationChange != nil {
+		if gs.PendingInflationChange.ActivationHeight <= 0 {
+			return fmt.Errorf("pending inflation change activation height must be positive")
+		}
+		if err := gs.PendingInflationChange.ApplyTo(gs.Params).Validate(); err != nil {
+			return fmt.Errorf("pending inflation change: %w", err)
+		}
+	}
+
+	seen := make(map[uint64]bool)
+	for _, change := range gs.QueuedParamChanges {
+		if seen[change.Id] {
+			return fmt.Errorf("duplicate queued param change id %d", change.Id)
+		}
+		seen[change.Id] = true
+		if err := change.Params.Validate(); err != nil {
+			return fmt.Errorf("queued param change %d: %w", change.Id, err)
+		}
+	}
+
+	delegators := make(map[string]bool)
+	for _, delegation := range gs.VotingDelegations {
+		if err := delegation.ValidateStateless(); err != nil {
+			return err
+		}
+		if delegators[delegation.Delegator] {
+			return fmt.Errorf("duplicate voting delegation for %s", delegation.Delegator)
+		}
+		delegators[delegation.Delegator] = true
+	}
+
+	candidates := make(map[string]bool)
+	for _, candidate := range gs.Candidates {
+		if err := validateAccAddress(candidate.Address); err != nil {
+			return fmt.Errorf("invalid candidate address %s: %w", candidate.Address, err)
+		}
+		if candidates[candidate.Address] {
+			return fmt.Errorf("duplicate candidate %s", candidate.Address)
+		}
+		candidates[candidate.Address] = true
+	}
+
+	terms := make(map[uint64]bool)
+	for _, council := range gs.Councils {
+		if err := council.Validate(); err != nil {
+			return err
+		}
+		if terms[council.Term] {
+			return fmt.Errorf("duplicate council term %d", council.Term)
+		}
+		terms[council.Term] = true
+	}
+
+	withdrawers := make(map[string]bool)
+	for _, entry := range gs.RewardWithdrawAddresses {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		if withdrawers[entry.Address] {
+			return fmt.Errorf("duplicate reward withdraw address for %s", entry.Address)
+		}
+		withdrawers[entry.Address] = true
+	}
+
+	positions := make(map[uint64]bool)
+	for _, position := range gs.VestingPositions {
+		if err := position.Validate(); err != nil {
+			return err
+		}
+		if positions[position.Id] {
+			return fmt.Errorf("duplicate vesting position id %d", position.Id)
+		}
+		positions[position.Id] = true
+	}
+
+	campaigns := make(map[uint64]bool)
+	for _, campaign := range gs.Campaigns {
+		if err := campaign.Validate(); err != nil {
+			return err
+		}
+		if campaigns[campaign.Id] {
+			return fmt.Errorf("duplicate campaign id %d", campaign.Id)
+		}
+		campaigns[campaign.Id] = true
+	}
+	for _, contribution := range gs.CampaignContributions {
+		if !campaigns[contribution.CampaignId] {
+			return fmt.Errorf("contribution to unknown campaign %d", contribution.CampaignId)
+		}
+		if err := validateAccAddress(contribution.Address); err != nil {
+			return fmt.Errorf("invalid campaign contribution address %s: %w", contribution.Address, err)
+		}
+	}
+
+	participants := make(map[string]bool)
+	for _, participation := range gs.Participations {
+		if err := validateAccAddress(participation.Address); err != nil {
+			return fmt.Errorf("invalid participation address %s: %w", participation.Address, err)
+		}
+		if participants[participation.Address] {
+			return fmt.Errorf("duplicate participation for %s", participation.Address)
+		}
+		participants[participation.Address] = true
+	}
+	for _, participation := range gs.EpochParticipations {
+		if err := validateAccAddress(participation.Address); err != nil {
+			return fmt.Errorf("invalid epoch participation address %s: %w", participation.Address, err)
+		}
+	}
+	for _, vote := range gs.ProposalVotes {
+		if err := validateAccAddress(vote.Voter); err != nil {
+			return fmt.Errorf("invalid proposal voter %s: %w", vote.Voter, err)
+		}
+	}
+
+	lockers := make(map[string]bool)
+	for _, lock := range gs.Locks {
+		if err := lock.Validate(); err != nil {
+			return err
+		}
+		if lockers[lock.Address] {
+			return fmt.Errorf("duplicate lock for %s", lock.Address)
+		}
+		lockers[lock.Address] = true
+	}
+	unlocks := make(map[uint64]bool)
+	for _, unlock := range gs.Unlocks {
+		if err := unlock.Validate(); err != nil {
+			return err
+		}
+		if unlocks[unlock.Id] {
+			return fmt.Errorf("duplicate unlock id %d", unlock.Id)
+		}
+		unlocks[unlock.Id] = true
+	}
+	if gs.GovernanceSetRemovals != nil {
+		for _, wallet := range gs.GovernanceSetRemovals.Wallets {
+			if err := validateAccAddress(wallet); err != nil {
+				return fmt.Errorf("invalid removed governance wallet %s: %w", wallet, err)
+			}
+		}
+	}
+
+	counted := make(map[string]bool)
+	for _, count := range gs.TransactionCounts {
+		if err := validateAccAddress(count.Address); err != nil {
+			return fmt.Errorf("invalid transaction count address %s: %w", count.Address, err)
+		}
+		if counted[count.Address] {
+			return fmt.Errorf("duplicate transaction count for %s", count.Address)
+		}
+		counted[count.Address] = true
+	}
+	for _, count := range gs.EpochTransactionCounts {
+		if err := validateAccAddress(count.Address); err != nil {
+			return fmt.Errorf("invalid epoch transaction count address %s: %w", count.Address, err)
+		}
+	}
+	totalEpochs := make(map[uint64]bool)
+	for _, total := range gs.EpochTotalTransactions {
+		if totalEpochs[total.Epoch] {
+			return fmt.Errorf("duplicate total transactions for epoch %d", total.Epoch)
+		}
+		totalEpochs[total.Epoch] = true
+	}
+	scored := make(map[string]bool)
+	for _, score := range gs.ContributionScores {
+		if err := validateAccAddress(score.Address); err != nil {
+			return fmt.Errorf("invalid contribution score address %s: %w", score.Address, err)
+		}
+		if err := score.Score.Validate(); err != nil {
+			return err
+		}
+		if scored[score.Address] {
+			return fmt.Errorf("duplicate contribution score for %s", score.Address)
+		}
+		scored[score.Address] = true
+	}
+	if gs.TotalContributionScore != nil {
+		if err := gs.TotalContributionScore.Validate(); err != nil {
+			return fmt.Errorf("total contribution score: %w", err)
+		}
+	}
+
+	rewardEpochs := make(map[uint64]bool)
+	for _, reward := range gs.EpochRewards {
+		if err := reward.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid epoch %d rewards: %w", reward.Epoch, err)
+		}
+		if rewardEpochs[reward.Epoch] {
+			return fmt.Errorf("duplicate rewards for epoch %d", reward.Epoch)
+		}
+		rewardEpochs[reward.Epoch] = true
+	}
+	rewarded := make(map[string]bool)
+	for _, reward := range gs.AddressRewards {
+		if err := validateAccAddress(reward.Address); err != nil {
+			return fmt.Errorf("invalid address rewards address %s: %w", reward.Address, err)
+		}
+		if err := reward.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards of %s: %w", reward.Address, err)
+		}
+		if rewarded[reward.Address] {
+			return fmt.Errorf("duplicate rewards for %s", reward.Address)
+		}
+		rewarded[reward.Address] = true
+	}
+
+	if gs.LockBonus != "" {
+		bonus, err := math.LegacyNewDecFromStr(gs.LockBonus)
+		if err != nil || bonus.IsNegative() {
+			return fmt.Errorf("invalid lock bonus %s", gs.LockBonus)
+		}
+	}
+	if gs.LockScoreBonus != nil {
+		if err := gs.LockScoreBonus.Validate(); err != nil {
+			return fmt.Errorf("lock score bonus: %w", err)
+		}
+	}
+
+	return nil
 }
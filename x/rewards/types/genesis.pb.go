@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -28,6 +30,68 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// pending_inflation_change is an inflation rate change that has been
+	// accepted by governance but not yet activated.
+	PendingInflationChange *PendingInflationChange `protobuf:"bytes,2,opt,name=pending_inflation_change,json=pendingInflationChange,proto3" json:"pending_inflation_change,omitempty"`
+	// queued_param_changes are the params updates waiting in the timelock queue.
+	QueuedParamChanges []QueuedParamChange `protobuf:"bytes,3,rep,name=queued_param_changes,json=queuedParamChanges,proto3" json:"queued_param_changes"`
+	// voting_delegations are the voting power delegations between addresses.
+	VotingDelegations []VotingDelegation `protobuf:"bytes,4,rep,name=voting_delegations,json=votingDelegations,proto3" json:"voting_delegations"`
+	// candidates are the addresses registered for governance wallet elections.
+	Candidates []Candidate `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates"`
+	// councils is the history of elected governance wallet sets.
+	Councils []Council `protobuf:"bytes,6,rep,name=councils,proto3" json:"councils"`
+	// reward_withdraw_addresses are the configured reward payout addresses.
+	RewardWithdrawAddresses []RewardWithdrawAddress `protobuf:"bytes,7,rep,name=reward_withdraw_addresses,json=rewardWithdrawAddresses,proto3" json:"reward_withdraw_addresses"`
+	// vesting_positions are the reward vesting positions held by the module.
+	VestingPositions []VestingPosition `protobuf:"bytes,8,rep,name=vesting_positions,json=vestingPositions,proto3" json:"vesting_positions"`
+	// campaigns are the sponsored incentive campaigns.
+	Campaigns []Campaign `protobuf:"bytes,9,rep,name=campaigns,proto3" json:"campaigns"`
+	// campaign_contributions are the matching messages counted for the
+	// campaigns not settled yet.
+	CampaignContributions []CampaignContribution `protobuf:"bytes,10,rep,name=campaign_contributions,json=campaignContributions,proto3" json:"campaign_contributions"`
+	// burned_supply is the EGV burned from reward distributions so far.
+	BurnedSupply string `protobuf:"bytes,11,opt,name=burned_supply,json=burnedSupply,proto3" json:"burned_supply,omitempty"`
+	// denom_metadata is the EGV denom metadata registered with x/bank.
+	DenomMetadata types.Metadata `protobuf:"bytes,12,opt,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// participations are the governance participation records of the
+	// governance wallets.
+	Participations []Participation `protobuf:"bytes,13,rep,name=participations,proto3" json:"participations"`
+	// epoch_participations are the participation counts of the retained
+	// epochs.
+	EpochParticipations []EpochParticipation `protobuf:"bytes,14,rep,name=epoch_participations,json=epochParticipations,proto3" json:"epoch_participations"`
+	// proposal_votes are the votes cast on x/gov proposals still in their
+	// voting period.
+	ProposalVotes []ProposalVote `protobuf:"bytes,15,rep,name=proposal_votes,json=proposalVotes,proto3" json:"proposal_votes"`
+	// locks is the EGV locked per address.
+	Locks []EGVLock `protobuf:"bytes,16,rep,name=locks,proto3" json:"locks"`
+	// unlocks is the locked EGV waiting out the unbonding period.
+	Unlocks []EGVUnlock `protobuf:"bytes,17,rep,name=unlocks,proto3" json:"unlocks"`
+	// governance_set_removals are the governance wallets removed for
+	// inactivity in the current epoch.
+	GovernanceSetRemovals *GovernanceSetRemovals `protobuf:"bytes,18,opt,name=governance_set_removals,json=governanceSetRemovals,proto3" json:"governance_set_removals,omitempty"`
+	// transaction_counts are the lifetime transaction counts per address.
+	TransactionCounts []TransactionCount `protobuf:"bytes,19,rep,name=transaction_counts,json=transactionCounts,proto3" json:"transaction_counts"`
+	// total_transactions is the lifetime transaction count of the network.
+	TotalTransactions uint64 `protobuf:"varint,20,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	// epoch_transaction_counts are the transaction counts per address of the
+	// retained epochs.
+	EpochTransactionCounts []EpochTransactionCount `protobuf:"bytes,21,rep,name=epoch_transaction_counts,json=epochTransactionCounts,proto3" json:"epoch_transaction_counts"`
+	// epoch_total_transactions are the network transaction counts of the
+	// retained epochs.
+	EpochTotalTransactions []EpochContribution `protobuf:"bytes,22,rep,name=epoch_total_transactions,json=epochTotalTransactions,proto3" json:"epoch_total_transactions"`
+	// contribution_scores are the stored decayed scores per address.
+	ContributionScores []AddressContributionScore `protobuf:"bytes,23,rep,name=contribution_scores,json=contributionScores,proto3" json:"contribution_scores"`
+	// total_contribution_score is the stored decayed score of the network.
+	TotalContributionScore *ContributionScore `protobuf:"bytes,24,opt,name=total_contribution_score,json=totalContributionScore,proto3" json:"total_contribution_score,omitempty"`
+	// epoch_rewards are the rewards distributed in the retained epochs.
+	EpochRewards []EpochReward `protobuf:"bytes,25,rep,name=epoch_rewards,json=epochRewards,proto3" json:"epoch_rewards"`
+	// address_rewards are the lifetime rewards paid out per address.
+	AddressRewards []AddressReward `protobuf:"bytes,26,rep,name=address_rewards,json=addressRewards,proto3" json:"address_rewards"`
+	// lock_bonus is the running lock bonus on lifetime transaction counts.
+	LockBonus string `protobuf:"bytes,27,opt,name=lock_bonus,json=lockBonus,proto3" json:"lock_bonus,omitempty"`
+	// lock_score_bonus is the running lock bonus on decayed scores.
+	LockScoreBonus *ContributionScore `protobuf:"bytes,28,opt,name=lock_score_bonus,json=lockScoreBonus,proto3" json:"lock_score_bonus,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +134,195 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPendingInflationChange() *PendingInflationChange {
+	if m != nil {
+		return m.PendingInflationChange
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedParamChanges() []QueuedParamChange {
+	if m != nil {
+		return m.QueuedParamChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingDelegations() []VotingDelegation {
+	if m != nil {
+		return m.VotingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandidates() []Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCouncils() []Council {
+	if m != nil {
+		return m.Councils
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardWithdrawAddresses() []RewardWithdrawAddress {
+	if m != nil {
+		return m.RewardWithdrawAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingPositions() []VestingPosition {
+	if m != nil {
+		return m.VestingPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCampaigns() []Campaign {
+	if m != nil {
+		return m.Campaigns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCampaignContributions() []CampaignContribution {
+	if m != nil {
+		return m.CampaignContributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnedSupply() string {
+	if m != nil {
+		return m.BurnedSupply
+	}
+	return ""
+}
+
+func (m *GenesisState) GetDenomMetadata() types.Metadata {
+	if m != nil {
+		return m.DenomMetadata
+	}
+	return types.Metadata{}
+}
+
+func (m *GenesisState) GetParticipations() []Participation {
+	if m != nil {
+		return m.Participations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochParticipations() []EpochParticipation {
+	if m != nil {
+		return m.EpochParticipations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalVotes() []ProposalVote {
+	if m != nil {
+		return m.ProposalVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLocks() []EGVLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnlocks() []EGVUnlock {
+	if m != nil {
+		return m.Unlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceSetRemovals() *GovernanceSetRemovals {
+	if m != nil {
+		return m.GovernanceSetRemovals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransactionCounts() []TransactionCount {
+	if m != nil {
+		return m.TransactionCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalTransactions() uint64 {
+	if m != nil {
+		return m.TotalTransactions
+	}
+	return 0
+}
+
+func (m *GenesisState) GetEpochTransactionCounts() []EpochTransactionCount {
+	if m != nil {
+		return m.EpochTransactionCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochTotalTransactions() []EpochContribution {
+	if m != nil {
+		return m.EpochTotalTransactions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContributionScores() []AddressContributionScore {
+	if m != nil {
+		return m.ContributionScores
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalContributionScore() *ContributionScore {
+	if m != nil {
+		return m.TotalContributionScore
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochRewards() []EpochReward {
+	if m != nil {
+		return m.EpochRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAddressRewards() []AddressReward {
+	if m != nil {
+		return m.AddressRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockBonus() string {
+	if m != nil {
+		return m.LockBonus
+	}
+	return ""
+}
+
+func (m *GenesisState) GetLockScoreBonus() *ContributionScore {
+	if m != nil {
+		return m.LockScoreBonus
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zenoda.rewards.GenesisState")
 }
@@ -77,19 +330,71 @@ func init() {
 func init() { proto.RegisterFile("zenoda/rewards/genesis.proto", fileDescriptor_19aa3fe12f63b394) }
 
 var fileDescriptor_19aa3fe12f63b394 = []byte{
-	// 188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xa9, 0x4a, 0xcd, 0xcb,
-	0x4f, 0x49, 0xd4, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xea, 0x41, 0x65, 0xa5,
-	0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48, 0x7a, 0x7e, 0x7a,
-	0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xa5, 0xd1, 0x8c, 0x2d, 0x48, 0x2c, 0x4a, 0xcc, 0x85,
-	0x9a, 0xaa, 0xe4, 0xc9, 0xc5, 0xe3, 0x0e, 0xb1, 0x26, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x92,
-	0x8b, 0x0d, 0x22, 0x2f, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa6, 0x87, 0x6a, 0xad, 0x5e,
-	0x00, 0x58, 0xd6, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41,
-	0x35, 0x38, 0x19, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x18, 0xd4,
-	0x05, 0x15, 0x70, 0x37, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x60, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0xa5, 0xa6, 0x7f, 0x6f, 0xf9, 0x00, 0x00, 0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0x8f, 0xd6, 0x36, 0x6d, 0x98, 0xd8, 0x8d, 0x99, 0xc4, 0xa1, 0xf3, 0xc7, 0x75, 0x83, 0x6d,
+	0x30, 0x06, 0xd4, 0x5e, 0xdb, 0xcb, 0x76, 0x29, 0xd0, 0xfc, 0x41, 0x50, 0x74, 0x7f, 0x52, 0x67,
+	0x4b, 0x81, 0x0e, 0x9b, 0x4a, 0x4b, 0xac, 0xa2, 0x45, 0x26, 0x55, 0x3d, 0xda, 0x59, 0x77, 0xda,
+	0x47, 0xd8, 0xc7, 0xd8, 0x71, 0x87, 0x7d, 0x88, 0x1e, 0x8b, 0x9d, 0x76, 0x1a, 0x86, 0xe4, 0xb0,
+	0xaf, 0x31, 0x88, 0xa4, 0x63, 0x9a, 0x92, 0x8b, 0x5d, 0x12, 0x93, 0xbf, 0x3f, 0xef, 0xf1, 0x89,
+	0xef, 0x49, 0x68, 0xeb, 0x67, 0xc6, 0x45, 0x48, 0xbb, 0x19, 0x3b, 0xa7, 0x59, 0x08, 0xdd, 0x88,
+	0x71, 0x06, 0x31, 0x74, 0xd2, 0x4c, 0x48, 0x81, 0xab, 0x1a, 0xed, 0x18, 0x74, 0xa3, 0x46, 0x07,
+	0x31, 0x17, 0x5d, 0xf5, 0x57, 0x53, 0x36, 0x56, 0x23, 0x11, 0x09, 0xf5, 0xb3, 0x9b, 0xff, 0x32,
+	0xbb, 0x9b, 0x8e, 0x6d, 0x4a, 0x33, 0x3a, 0x30, 0xae, 0x1b, 0xdb, 0x0e, 0x28, 0xe3, 0x01, 0x4b,
+	0x44, 0x70, 0x36, 0x43, 0x3b, 0x12, 0x32, 0xe6, 0xd1, 0x0c, 0x2d, 0x4b, 0x58, 0x20, 0x63, 0xc1,
+	0x67, 0xc0, 0xe7, 0xb1, 0x3c, 0x0d, 0x33, 0x7a, 0x6e, 0x60, 0xf7, 0xb4, 0x23, 0x06, 0xef, 0xf1,
+	0x0e, 0xe8, 0x20, 0xa5, 0x71, 0x34, 0xf6, 0x6e, 0x04, 0x02, 0x06, 0x02, 0x7c, 0x7d, 0x58, 0xbd,
+	0x30, 0x50, 0x53, 0xaf, 0xba, 0x7d, 0xca, 0xcf, 0xba, 0xa3, 0xfb, 0x7d, 0x26, 0xe9, 0x7d, 0xb5,
+	0x30, 0xf8, 0x4e, 0xb1, 0x1c, 0x32, 0x0e, 0xe2, 0x94, 0x5a, 0xa9, 0x37, 0x1c, 0x8e, 0x55, 0x91,
+	0xbb, 0x6e, 0x62, 0x82, 0xcb, 0x2c, 0xee, 0x0f, 0x27, 0xea, 0x9d, 0x5f, 0x30, 0x5a, 0x3a, 0xd4,
+	0xcf, 0xee, 0x58, 0x52, 0xc9, 0xf0, 0xe7, 0x68, 0x5e, 0x17, 0x9d, 0x78, 0x2d, 0xaf, 0xbd, 0xf8,
+	0xa0, 0xde, 0x99, 0x7e, 0x96, 0x9d, 0x23, 0x85, 0xee, 0x2e, 0xbc, 0xfd, 0xfb, 0xce, 0xdc, 0x6f,
+	0xff, 0xfe, 0xfe, 0x89, 0xd7, 0x33, 0x02, 0xfc, 0x12, 0x91, 0x94, 0xf1, 0x30, 0xe6, 0x91, 0x1f,
+	0xf3, 0x57, 0x89, 0x4a, 0xd2, 0x0f, 0x4e, 0x29, 0x8f, 0x18, 0xf9, 0x40, 0x99, 0x7d, 0x5c, 0x30,
+	0xd3, 0xfc, 0x27, 0x63, 0xfa, 0x9e, 0x62, 0xf7, 0xea, 0x69, 0xe9, 0x3e, 0xfe, 0x01, 0xad, 0xbe,
+	0x1e, 0xb2, 0x21, 0x0b, 0x7d, 0x15, 0xd2, 0x98, 0x03, 0xb9, 0xd6, 0xba, 0xd6, 0x5e, 0x7c, 0x70,
+	0xd7, 0x75, 0x7f, 0xa6, 0xb8, 0x2a, 0x61, 0x6d, 0x60, 0x67, 0x8d, 0x5f, 0xbb, 0x28, 0xe0, 0x17,
+	0x08, 0xeb, 0x5b, 0xe3, 0x87, 0x2c, 0x61, 0x91, 0x0a, 0x0d, 0xe4, 0xba, 0x72, 0x6f, 0xb9, 0xee,
+	0x27, 0x8a, 0xb9, 0x7f, 0x45, 0xb4, 0xcd, 0x6b, 0x23, 0x07, 0x04, 0xbc, 0x8f, 0x50, 0x40, 0x79,
+	0x18, 0x87, 0x54, 0x32, 0x20, 0x37, 0x94, 0x67, 0xc3, 0xf5, 0xdc, 0x1b, 0x33, 0x6c, 0x33, 0x4b,
+	0x87, 0x1f, 0xa1, 0x5b, 0x81, 0x18, 0xf2, 0x20, 0x4e, 0x80, 0xcc, 0x2b, 0x8f, 0xf5, 0x82, 0x87,
+	0xc6, 0x6d, 0x87, 0x2b, 0x0d, 0x4e, 0x50, 0x43, 0xf3, 0xfc, 0xf1, 0x15, 0xf7, 0x69, 0x18, 0x66,
+	0x0c, 0x80, 0x01, 0xb9, 0xa9, 0x0c, 0x3f, 0x72, 0x0d, 0x7b, 0xea, 0xff, 0x73, 0xc3, 0x7f, 0xac,
+	0xe9, 0xb6, 0xfd, 0x7a, 0x56, 0xc6, 0x60, 0x80, 0x9f, 0xa3, 0x9a, 0x69, 0x15, 0x3f, 0x15, 0x10,
+	0xeb, 0x72, 0xde, 0x52, 0x51, 0xee, 0x14, 0xca, 0xa9, 0x89, 0x47, 0x86, 0x67, 0xfb, 0x2f, 0x8f,
+	0xa6, 0x31, 0xc0, 0x8f, 0xd1, 0xc2, 0xb8, 0xcb, 0x80, 0x2c, 0x28, 0x43, 0x52, 0xac, 0xa5, 0x26,
+	0xd8, 0x4e, 0x13, 0x15, 0x7e, 0x85, 0xea, 0xe3, 0x85, 0x6f, 0x37, 0x06, 0x10, 0xa4, 0xfc, 0x3e,
+	0x9c, 0xe5, 0xb7, 0x67, 0x91, 0x6d, 0xef, 0xb5, 0xa0, 0x84, 0x00, 0xf8, 0x21, 0xaa, 0xf4, 0x87,
+	0x19, 0x67, 0xa1, 0x0f, 0xc3, 0x34, 0x4d, 0xde, 0x90, 0xc5, 0x96, 0xd7, 0x5e, 0xd8, 0xad, 0xfe,
+	0xf9, 0xc7, 0x3d, 0x64, 0x86, 0xc1, 0x13, 0x2e, 0x7b, 0x4b, 0x9a, 0x74, 0xac, 0x38, 0xf8, 0x6b,
+	0x54, 0x0d, 0x19, 0x17, 0x03, 0x7f, 0xc0, 0x24, 0x0d, 0xa9, 0xa4, 0x64, 0x49, 0x35, 0xd0, 0x76,
+	0xc7, 0x48, 0xd4, 0x90, 0x30, 0x13, 0xa3, 0xf3, 0xa5, 0x21, 0xd9, 0xd9, 0x54, 0x94, 0x7e, 0x8c,
+	0xe0, 0x23, 0x54, 0x9d, 0x1a, 0x1e, 0x40, 0x2a, 0xea, 0x94, 0xdb, 0x25, 0xed, 0x3d, 0x61, 0xd9,
+	0x86, 0x8e, 0x1e, 0xbf, 0x44, 0xab, 0x2c, 0x15, 0xc1, 0xa9, 0xef, 0xf8, 0x56, 0x95, 0xef, 0x8e,
+	0xeb, 0x7b, 0x90, 0x73, 0x67, 0x9a, 0xaf, 0xb0, 0x02, 0x0c, 0xf8, 0x2b, 0x54, 0x4d, 0x33, 0x91,
+	0x0a, 0xa0, 0x89, 0x3f, 0x12, 0x79, 0xd7, 0xdc, 0x56, 0xde, 0x5b, 0x85, 0x9c, 0x0d, 0xeb, 0x44,
+	0x4c, 0x37, 0x4e, 0x25, 0xb5, 0x00, 0xc0, 0x9f, 0xa1, 0x1b, 0xf9, 0x70, 0x04, 0xb2, 0x5c, 0xde,
+	0x38, 0x07, 0x87, 0x27, 0x5f, 0x88, 0xe0, 0xcc, 0x76, 0xd0, 0x02, 0xfc, 0x08, 0xdd, 0x1c, 0x72,
+	0xad, 0xad, 0x95, 0x37, 0xee, 0xc1, 0xe1, 0xc9, 0xb7, 0x3c, 0x71, 0xd4, 0x63, 0x11, 0xfe, 0x1e,
+	0xad, 0x47, 0x62, 0xc4, 0x32, 0x4e, 0x79, 0xc0, 0x7c, 0x60, 0xd2, 0xcf, 0xd8, 0x40, 0x8c, 0x68,
+	0x02, 0x04, 0xb7, 0xbc, 0xb2, 0x9e, 0x3b, 0xbc, 0xa2, 0x1f, 0x33, 0xd9, 0x33, 0xe4, 0xde, 0x5a,
+	0x54, 0xb6, 0x9d, 0x8f, 0x2d, 0x99, 0x51, 0x0e, 0x34, 0xd0, 0x23, 0x57, 0x0c, 0xb9, 0x04, 0xb2,
+	0x52, 0x3e, 0xb6, 0xbe, 0x99, 0x30, 0xf3, 0x49, 0x21, 0xa7, 0xc6, 0x96, 0x74, 0x40, 0xc0, 0xf7,
+	0x10, 0x96, 0x42, 0xd2, 0xc4, 0xb7, 0x20, 0x20, 0xab, 0x2d, 0xaf, 0x7d, 0xbd, 0x57, 0x53, 0x88,
+	0x65, 0x08, 0xf8, 0x47, 0x44, 0xf4, 0xad, 0x28, 0x49, 0x68, 0xad, 0x7c, 0xbc, 0xa8, 0x9b, 0xf1,
+	0xbe, 0xac, 0xea, 0xac, 0x8c, 0x91, 0x77, 0xf0, 0x38, 0x56, 0x31, 0xc1, 0x7a, 0xf9, 0x1b, 0x41,
+	0xc5, 0x9a, 0xd5, 0xc0, 0x26, 0x4e, 0xe1, 0x4c, 0x21, 0x5a, 0xb1, 0x07, 0x84, 0x0f, 0x81, 0xc8,
+	0x18, 0x90, 0x75, 0x15, 0xa2, 0xed, 0x86, 0x30, 0xd3, 0xcf, 0x0e, 0x72, 0x9c, 0x0b, 0xa6, 0xde,
+	0x3d, 0x81, 0x8b, 0x02, 0xfe, 0x0e, 0x11, 0x7d, 0x8e, 0x62, 0x2c, 0x42, 0x5a, 0x5e, 0xd9, 0x69,
+	0x0a, 0x31, 0x7a, 0x75, 0x65, 0x51, 0xd8, 0xc7, 0x4f, 0x51, 0x45, 0x97, 0xca, 0x48, 0x49, 0x43,
+	0x25, 0xbf, 0x59, 0x5a, 0x1f, 0x3d, 0xef, 0xed, 0x7c, 0x97, 0xd8, 0x64, 0x1f, 0xf0, 0x33, 0x74,
+	0xdb, 0xbc, 0x33, 0xae, 0xec, 0x36, 0xca, 0x87, 0x89, 0xa9, 0x45, 0xd1, 0xb0, 0x4a, 0x6d, 0x24,
+	0xbf, 0x65, 0x28, 0xef, 0x14, 0xbf, 0x2f, 0xf8, 0x10, 0xc8, 0x66, 0x61, 0x42, 0xee, 0xb3, 0xa0,
+	0xb7, 0xa0, 0xda, 0x2b, 0x27, 0xe0, 0xa7, 0x68, 0x59, 0xd1, 0x55, 0x75, 0x8c, 0x68, 0xeb, 0xff,
+	0xd6, 0xa8, 0x9a, 0x4b, 0xf5, 0x23, 0xc9, 0x85, 0xbb, 0x9f, 0xbe, 0xbd, 0x68, 0x7a, 0xef, 0x2e,
+	0x9a, 0xde, 0x3f, 0x17, 0x4d, 0xef, 0xd7, 0xcb, 0xe6, 0xdc, 0xbb, 0xcb, 0xe6, 0xdc, 0x5f, 0x97,
+	0xcd, 0xb9, 0x17, 0x75, 0xf3, 0xfd, 0xf4, 0xd3, 0xe4, 0x93, 0xf3, 0x4d, 0xca, 0xa0, 0x3f, 0xaf,
+	0xbe, 0x9d, 0x1e, 0xfe, 0x37, 0x00, 0x75, 0xe9, 0x34, 0x80, 0x05, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +417,375 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LockScoreBonus != nil {
+		{
+			size, err := m.LockScoreBonus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.LockBonus) > 0 {
+		i -= len(m.LockBonus)
+		copy(dAtA[i:], m.LockBonus)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockBonus)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.AddressRewards) > 0 {
+		for iNdEx := len(m.AddressRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.EpochRewards) > 0 {
+		for iNdEx := len(m.EpochRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.TotalContributionScore != nil {
+		{
+			size, err := m.TotalContributionScore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.ContributionScores) > 0 {
+		for iNdEx := len(m.ContributionScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContributionScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.EpochTotalTransactions) > 0 {
+		for iNdEx := len(m.EpochTotalTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochTotalTransactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.EpochTransactionCounts) > 0 {
+		for iNdEx := len(m.EpochTransactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochTransactionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.TotalTransactions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalTransactions))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.TransactionCounts) > 0 {
+		for iNdEx := len(m.TransactionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransactionCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.GovernanceSetRemovals != nil {
+		{
+			size, err := m.GovernanceSetRemovals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Unlocks) > 0 {
+		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ProposalVotes) > 0 {
+		for iNdEx := len(m.ProposalVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.EpochParticipations) > 0 {
+		for iNdEx := len(m.EpochParticipations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochParticipations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Participations) > 0 {
+		for iNdEx := len(m.Participations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.DenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.BurnedSupply) > 0 {
+		i -= len(m.BurnedSupply)
+		copy(dAtA[i:], m.BurnedSupply)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BurnedSupply)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CampaignContributions) > 0 {
+		for iNdEx := len(m.CampaignContributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CampaignContributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for iNdEx := len(m.Campaigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Campaigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VestingPositions) > 0 {
+		for iNdEx := len(m.VestingPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RewardWithdrawAddresses) > 0 {
+		for iNdEx := len(m.RewardWithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWithdrawAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Councils) > 0 {
+		for iNdEx := len(m.Councils) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Councils[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Candidates) > 0 {
+		for iNdEx := len(m.Candidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VotingDelegations) > 0 {
+		for iNdEx := len(m.VotingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.QueuedParamChanges) > 0 {
+		for iNdEx := len(m.QueuedParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PendingInflationChange != nil {
+		{
+			size, err := m.PendingInflationChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,28 +818,171 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
+	if m.PendingInflationChange != nil {
+		l = m.PendingInflationChange.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.QueuedParamChanges) > 0 {
+		for _, e := range m.QueuedParamChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingDelegations) > 0 {
+		for _, e := range m.VotingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candidates) > 0 {
+		for _, e := range m.Candidates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Councils) > 0 {
+		for _, e := range m.Councils {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardWithdrawAddresses) > 0 {
+		for _, e := range m.RewardWithdrawAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingPositions) > 0 {
+		for _, e := range m.VestingPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Campaigns) > 0 {
+		for _, e := range m.Campaigns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CampaignContributions) > 0 {
+		for _, e := range m.CampaignContributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.BurnedSupply)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.DenomMetadata.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Participations) > 0 {
+		for _, e := range m.Participations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochParticipations) > 0 {
+		for _, e := range m.EpochParticipations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalVotes) > 0 {
+		for _, e := range m.ProposalVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unlocks) > 0 {
+		for _, e := range m.Unlocks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GovernanceSetRemovals != nil {
+		l = m.GovernanceSetRemovals.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TransactionCounts) > 0 {
+		for _, e := range m.TransactionCounts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalTransactions != 0 {
+		n += 2 + sovGenesis(uint64(m.TotalTransactions))
+	}
+	if len(m.EpochTransactionCounts) > 0 {
+		for _, e := range m.EpochTransactionCounts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochTotalTransactions) > 0 {
+		for _, e := range m.EpochTotalTransactions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContributionScores) > 0 {
+		for _, e := range m.ContributionScores {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalContributionScore != nil {
+		l = m.TotalContributionScore.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.EpochRewards) > 0 {
+		for _, e := range m.EpochRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressRewards) > 0 {
+		for _, e := range m.AddressRewards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.LockBonus)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.LockScoreBonus != nil {
+		l = m.LockScoreBonus.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
 			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
@@ -215,6 +1032,912 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingInflationChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingInflationChange == nil {
+				m.PendingInflationChange = &PendingInflationChange{}
+			}
+			if err := m.PendingInflationChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedParamChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedParamChanges = append(m.QueuedParamChanges, QueuedParamChange{})
+			if err := m.QueuedParamChanges[len(m.QueuedParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingDelegations = append(m.VotingDelegations, VotingDelegation{})
+			if err := m.VotingDelegations[len(m.VotingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidates = append(m.Candidates, Candidate{})
+			if err := m.Candidates[len(m.Candidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Councils", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Councils = append(m.Councils, Council{})
+			if err := m.Councils[len(m.Councils)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWithdrawAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWithdrawAddresses = append(m.RewardWithdrawAddresses, RewardWithdrawAddress{})
+			if err := m.RewardWithdrawAddresses[len(m.RewardWithdrawAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPositions = append(m.VestingPositions, VestingPosition{})
+			if err := m.VestingPositions[len(m.VestingPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Campaigns = append(m.Campaigns, Campaign{})
+			if err := m.Campaigns[len(m.Campaigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignContributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CampaignContributions = append(m.CampaignContributions, CampaignContribution{})
+			if err := m.CampaignContributions[len(m.CampaignContributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participations = append(m.Participations, Participation{})
+			if err := m.Participations[len(m.Participations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochParticipations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochParticipations = append(m.EpochParticipations, EpochParticipation{})
+			if err := m.EpochParticipations[len(m.EpochParticipations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalVotes = append(m.ProposalVotes, ProposalVote{})
+			if err := m.ProposalVotes[len(m.ProposalVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, EGVLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, EGVUnlock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceSetRemovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GovernanceSetRemovals == nil {
+				m.GovernanceSetRemovals = &GovernanceSetRemovals{}
+			}
+			if err := m.GovernanceSetRemovals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionCounts = append(m.TransactionCounts, TransactionCount{})
+			if err := m.TransactionCounts[len(m.TransactionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTransactions", wireType)
+			}
+			m.TotalTransactions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTransactions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTransactionCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochTransactionCounts = append(m.EpochTransactionCounts, EpochTransactionCount{})
+			if err := m.EpochTransactionCounts[len(m.EpochTransactionCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTotalTransactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochTotalTransactions = append(m.EpochTotalTransactions, EpochContribution{})
+			if err := m.EpochTotalTransactions[len(m.EpochTotalTransactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContributionScores = append(m.ContributionScores, AddressContributionScore{})
+			if err := m.ContributionScores[len(m.ContributionScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalContributionScore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalContributionScore == nil {
+				m.TotalContributionScore = &ContributionScore{}
+			}
+			if err := m.TotalContributionScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochRewards = append(m.EpochRewards, EpochReward{})
+			if err := m.EpochRewards[len(m.EpochRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressRewards = append(m.AddressRewards, AddressReward{})
+			if err := m.AddressRewards[len(m.AddressRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBonus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockBonus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockScoreBonus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockScoreBonus == nil {
+				m.LockScoreBonus = &ContributionScore{}
+			}
+			if err := m.LockScoreBonus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
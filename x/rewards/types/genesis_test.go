@@ -3,6 +3,10 @@ package types_test
 import (
 	"testing"
 
+	math "cosmossdk.io/math"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"zenoda/testutil/sample"
 	"zenoda/x/rewards/types"
 
 	"github.com/stretchr/testify/require"
@@ -20,13 +24,244 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: sample.RewardsParams(),
+				PendingInflationChange: &types.PendingInflationChange{
+					InflationRate:    "0.06",
+					ActivationHeight: 100,
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "pending inflation change outside bounds",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				PendingInflationChange: &types.PendingInflationChange{
+					InflationRate:    "0.50",
+					ActivationHeight: 100,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated voting delegation",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				VotingDelegations: []types.VotingDelegation{
+					{Delegator: sample.RewardsParams().PredefinedWallets[0], Delegatee: sample.RewardsParams().PredefinedWallets[1]},
+					{Delegator: sample.RewardsParams().PredefinedWallets[0], Delegatee: sample.RewardsParams().PredefinedWallets[2]},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "voting delegation to self",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				VotingDelegations: []types.VotingDelegation{
+					{Delegator: sample.RewardsParams().PredefinedWallets[0], Delegatee: sample.RewardsParams().PredefinedWallets[0]},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown voting weight function",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := sample.RewardsParams()
+					params.VotingWeightFunction = "cubic"
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated council term",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				Councils: []types.Council{
+					{Term: 1, Members: sample.RewardsParams().PredefinedWallets[:2]},
+					{Term: 1, Members: sample.RewardsParams().PredefinedWallets[2:4]},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated predefined wallet",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := sample.RewardsParams()
+					params.PredefinedWallets = append([]string{}, params.PredefinedWallets...)
+					params.PredefinedWallets[1] = params.PredefinedWallets[0]
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "denom metadata for another denom",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				DenomMetadata: func() banktypes.Metadata {
+					metadata := types.DefaultDenomMetadata()
+					metadata.Base = "stake"
+					metadata.Display = "stake"
+					metadata.DenomUnits = []*banktypes.DenomUnit{{Denom: "stake"}}
+					return metadata
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid denom metadata",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				DenomMetadata: func() banktypes.Metadata {
+					metadata := types.DefaultDenomMetadata()
+					metadata.Symbol = ""
+					return metadata
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reward denom",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := sample.RewardsParams()
+					params.RewardDenom = "1egv"
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid EGV transfer allowlist address",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := sample.RewardsParams()
+					params.SoulboundEgv = true
+					params.EgvTransferAllowlist = []string{"invalid"}
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "predefined wallets above max governance set size",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := sample.RewardsParams()
+					params.MaxGovernanceSetSize = 9
+					params.CouncilSize = 9
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "empty predefined wallets",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := sample.RewardsParams()
+					params.PredefinedWallets = nil
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown contribution score mode",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := sample.RewardsParams()
+					params.ContributionScoreMode = "recent"
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reward withdraw address",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				RewardWithdrawAddresses: []types.RewardWithdrawAddress{
+					{Address: sample.RewardsParams().PredefinedWallets[0], WithdrawAddress: sample.RewardsParams().PredefinedWallets[1]},
+					{Address: sample.RewardsParams().PredefinedWallets[0], WithdrawAddress: sample.RewardsParams().PredefinedWallets[2]},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "vesting position withdrawn above its amount",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				VestingPositions: []types.VestingPosition{
+					{Id: 0, Address: sample.RewardsParams().PredefinedWallets[0], Amount: "100", Withdrawn: "150", StartHeight: 1, EndHeight: 11},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "campaign contribution to unknown campaign",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				CampaignContributions: []types.CampaignContribution{
+					{CampaignId: 1, Address: sample.RewardsParams().PredefinedWallets[0], Count: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "initial supply above max supply",
+			genState: func() *types.GenesisState {
+				params := sample.RewardsParams()
+				params.MaxSupply = "1000"
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "lock boost saturation above initial supply",
+			genState: func() *types.GenesisState {
+				params := sample.RewardsParams()
+				params.LockBoostSaturation = math.NewInt(10*types.InitialWalletBalance + 1).String()
+				return &types.GenesisState{Params: params}
+			}(),
+			valid: false,
+		},
+		{
+			desc: "duplicated lock",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				Locks: []types.EGVLock{
+					{Address: sample.RewardsParams().PredefinedWallets[0], Amount: "100"},
+					{Address: sample.RewardsParams().PredefinedWallets[0], Amount: "200"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unlock without an amount",
+			genState: &types.GenesisState{
+				Params: sample.RewardsParams(),
+				Unlocks: []types.EGVUnlock{
+					{Id: 0, Address: sample.RewardsParams().PredefinedWallets[0], Amount: "0", CompletionHeight: 10},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid removed governance wallet",
+			genState: &types.GenesisState{
+				Params:                sample.RewardsParams(),
+				GovernanceSetRemovals: &types.GovernanceSetRemovals{Epoch: 1, Wallets: []string{"invalid"}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,172 @@
+package types
+
+import (
+	"fmt"
+
+	math "cosmossdk.io/math"
+)
+
+// Inflation schedules
+const (
+	// InflationScheduleConstant keeps inflation_rate in every epoch.
+	InflationScheduleConstant = "constant"
+
+	// InflationScheduleStepwise applies the rate of the latest inflation step
+	// started, and inflation_rate before the first one.
+	InflationScheduleStepwise = "stepwise"
+
+	// InflationScheduleHalving halves inflation_rate every
+	// inflation_halving_epochs epochs.
+	InflationScheduleHalving = "halving"
+
+	// InflationScheduleExponentialDecay moves inflation_rate toward
+	// inflation_floor by inflation_decay_rate of the distance every epoch.
+	InflationScheduleExponentialDecay = "exponential_decay"
+)
+
+// InflationRateAt returns the effective inflation rate of an epoch under the
+// inflation schedule, kept within min_inflation_rate and max_inflation_rate.
+func (p Params) InflationRateAt(epoch uint64) (math.LegacyDec, error) {
+	base, err := p.GetInflationRateAsDec()
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid inflation rate: %w", err)
+	}
+
+	rate := base
+	switch p.InflationSchedule {
+	case InflationScheduleConstant:
+	case InflationScheduleStepwise:
+		for _, step := range p.InflationSteps {
+			if step.StartEpoch > epoch {
+				break
+			}
+			if rate, err = math.LegacyNewDecFromStr(step.InflationRate); err != nil {
+				return math.LegacyDec{}, fmt.Errorf("invalid inflation step rate: %w", err)
+			}
+		}
+	case InflationScheduleHalving:
+		halvings := epoch / p.InflationHalvingEpochs
+		rate = base.Mul(math.LegacyNewDecWithPrec(5, 1).Power(halvings))
+	case InflationScheduleExponentialDecay:
+		decay, err := math.LegacyNewDecFromStr(p.InflationDecayRate)
+		if err != nil {
+			return math.LegacyDec{}, fmt.Errorf("invalid inflation decay rate: %w", err)
+		}
+		floor, err := math.LegacyNewDecFromStr(p.InflationFloor)
+		if err != nil {
+			return math.LegacyDec{}, fmt.Errorf("invalid inflation floor: %w", err)
+		}
+		remaining := math.LegacyOneDec().Sub(decay).Power(epoch)
+		rate = floor.Add(base.Sub(floor).Mul(remaining))
+	default:
+		return math.LegacyDec{}, fmt.Errorf("unknown inflation schedule %q", p.InflationSchedule)
+	}
+
+	minRate, err := math.LegacyNewDecFromStr(p.MinInflationRate)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid min inflation rate: %w", err)
+	}
+	maxRate, err := math.LegacyNewDecFromStr(p.MaxInflationRate)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid max inflation rate: %w", err)
+	}
+	return math.LegacyMaxDec(minRate, math.LegacyMinDec(rate, maxRate)), nil
+}
+
+// SameInflationSchedule reports whether both params use the same inflation
+// schedule with the same settings.
+func (p Params) SameInflationSchedule(other Params) bool {
+	if p.InflationSchedule != other.InflationSchedule ||
+		p.InflationHalvingEpochs != other.InflationHalvingEpochs ||
+		p.InflationDecayRate != other.InflationDecayRate ||
+		p.InflationFloor != other.InflationFloor ||
+		len(p.InflationSteps) != len(other.InflationSteps) {
+		return false
+	}
+	for i := range p.InflationSteps {
+		if !p.InflationSteps[i].Equal(other.InflationSteps[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// WithInflationSchedule returns the params with the inflation schedule and
+// its settings taken from other.
+func (p Params) WithInflationSchedule(other Params) Params {
+	p.InflationSchedule = other.InflationSchedule
+	p.InflationSteps = other.InflationSteps
+	p.InflationHalvingEpochs = other.InflationHalvingEpochs
+	p.InflationDecayRate = other.InflationDecayRate
+	p.InflationFloor = other.InflationFloor
+	return p
+}
+
+// NewPendingInflationChange schedules the inflation rate and schedule of the
+// params for the activation height.
+func NewPendingInflationChange(params Params, activationHeight int64) PendingInflationChange {
+	return PendingInflationChange{
+		InflationRate:          params.InflationRate,
+		ActivationHeight:       activationHeight,
+		InflationSchedule:      params.InflationSchedule,
+		InflationSteps:         params.InflationSteps,
+		InflationHalvingEpochs: params.InflationHalvingEpochs,
+		InflationDecayRate:     params.InflationDecayRate,
+		InflationFloor:         params.InflationFloor,
+	}
+}
+
+// ApplyTo returns the params with the inflation rate and schedule of the
+// change. A change without a schedule keeps the schedule of the params.
+func (c PendingInflationChange) ApplyTo(params Params) Params {
+	params.InflationRate = c.InflationRate
+	if c.InflationSchedule == "" {
+		return params
+	}
+	return params.WithInflationSchedule(Params{
+		InflationSchedule:      c.InflationSchedule,
+		InflationSteps:         c.InflationSteps,
+		InflationHalvingEpochs: c.InflationHalvingEpochs,
+		InflationDecayRate:     c.InflationDecayRate,
+		InflationFloor:         c.InflationFloor,
+	})
+}
+
+// validateInflationSchedule checks the schedule type and the settings it
+// relies on.
+func (p Params) validateInflationSchedule() error {
+	switch p.InflationSchedule {
+	case InflationScheduleConstant, InflationScheduleHalving, InflationScheduleExponentialDecay:
+	case InflationScheduleStepwise:
+		if len(p.InflationSteps) == 0 {
+			return fmt.Errorf("stepwise inflation schedule needs at least one step")
+		}
+	default:
+		return fmt.Errorf("unknown inflation schedule %q", p.InflationSchedule)
+	}
+
+	for i, step := range p.InflationSteps {
+		if i > 0 && step.StartEpoch <= p.InflationSteps[i-1].StartEpoch {
+			return fmt.Errorf("inflation steps must be in increasing start epoch order")
+		}
+		if err := p.ValidateInflationBounds(step.InflationRate); err != nil {
+			return fmt.Errorf("inflation step from epoch %d: %w", step.StartEpoch, err)
+		}
+	}
+
+	if p.InflationHalvingEpochs == 0 {
+		return fmt.Errorf("inflation halving epochs must be positive")
+	}
+
+	decay, err := math.LegacyNewDecFromStr(p.InflationDecayRate)
+	if err != nil {
+		return fmt.Errorf("invalid inflation decay rate format: %v", err)
+	}
+	if decay.IsNegative() || decay.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("inflation decay rate must be at least 0 and below 1")
+	}
+	if err := p.ValidateInflationBounds(p.InflationFloor); err != nil {
+		return fmt.Errorf("inflation floor: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"zenoda/testutil/sample"
+	"zenoda/x/rewards/types"
+)
+
+func TestInflationRateAt(t *testing.T) {
+	tests := []struct {
+		desc     string
+		modify   func(*types.Params)
+		epoch    uint64
+		expected string
+	}{
+		{
+			desc:     "constant",
+			modify:   func(p *types.Params) {},
+			epoch:    1000,
+			expected: "0.050000000000000000",
+		},
+		{
+			desc: "stepwise before the first step",
+			modify: func(p *types.Params) {
+				p.InflationSchedule = types.InflationScheduleStepwise
+				p.InflationSteps = []types.InflationStep{{StartEpoch: 10, InflationRate: "0.04"}, {StartEpoch: 20, InflationRate: "0.03"}}
+			},
+			epoch:    9,
+			expected: "0.050000000000000000",
+		},
+		{
+			desc: "stepwise inside a step",
+			modify: func(p *types.Params) {
+				p.InflationSchedule = types.InflationScheduleStepwise
+				p.InflationSteps = []types.InflationStep{{StartEpoch: 10, InflationRate: "0.04"}, {StartEpoch: 20, InflationRate: "0.03"}}
+			},
+			epoch:    15,
+			expected: "0.040000000000000000",
+		},
+		{
+			desc: "stepwise after the last step",
+			modify: func(p *types.Params) {
+				p.InflationSchedule = types.InflationScheduleStepwise
+				p.InflationSteps = []types.InflationStep{{StartEpoch: 10, InflationRate: "0.04"}, {StartEpoch: 20, InflationRate: "0.03"}}
+			},
+			epoch:    20,
+			expected: "0.030000000000000000",
+		},
+		{
+			desc: "halving",
+			modify: func(p *types.Params) {
+				p.InflationSchedule = types.InflationScheduleHalving
+				p.InflationHalvingEpochs = 100
+			},
+			epoch:    250,
+			expected: "0.012500000000000000",
+		},
+		{
+			desc: "halving held at the minimum rate",
+			modify: func(p *types.Params) {
+				p.InflationSchedule = types.InflationScheduleHalving
+				p.InflationHalvingEpochs = 100
+				p.MinInflationRate = "0.02"
+				p.InflationFloor = "0.02"
+			},
+			epoch:    250,
+			expected: "0.020000000000000000",
+		},
+		{
+			desc: "exponential decay toward the floor",
+			modify: func(p *types.Params) {
+				p.InflationSchedule = types.InflationScheduleExponentialDecay
+				p.InflationDecayRate = "0.5"
+				p.InflationFloor = "0.01"
+			},
+			epoch:    2,
+			expected: "0.020000000000000000",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := sample.RewardsParams()
+			tc.modify(&params)
+			require.NoError(t, params.Validate())
+
+			rate, err := params.InflationRateAt(tc.epoch)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, rate.String())
+		})
+	}
+}
+
+func TestValidateInflationSchedule(t *testing.T) {
+	tests := []struct {
+		desc   string
+		modify func(*types.Params)
+	}{
+		{
+			desc:   "unknown schedule",
+			modify: func(p *types.Params) { p.InflationSchedule = "linear" },
+		},
+		{
+			desc:   "stepwise without steps",
+			modify: func(p *types.Params) { p.InflationSchedule = types.InflationScheduleStepwise },
+		},
+		{
+			desc: "steps out of order",
+			modify: func(p *types.Params) {
+				p.InflationSteps = []types.InflationStep{{StartEpoch: 20, InflationRate: "0.04"}, {StartEpoch: 10, InflationRate: "0.03"}}
+			},
+		},
+		{
+			desc: "step above the maximum rate",
+			modify: func(p *types.Params) {
+				p.InflationSteps = []types.InflationStep{{StartEpoch: 10, InflationRate: "0.5"}}
+			},
+		},
+		{
+			desc:   "zero halving epochs",
+			modify: func(p *types.Params) { p.InflationHalvingEpochs = 0 },
+		},
+		{
+			desc:   "full decay",
+			modify: func(p *types.Params) { p.InflationDecayRate = "1" },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := sample.RewardsParams()
+			tc.modify(&params)
+			require.Error(t, params.Validate())
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "rewards"
@@ -7,26 +9,134 @@ const (
 	// RewardsModuleName defines the name of the rewards pool module account
 	RewardsModuleName = "rewards_pool"
 
+	// VestingModuleName defines the name of the module account holding vesting rewards
+	VestingModuleName = "rewards_vesting"
+
+	// CampaignModuleName defines the name of the module account holding sponsored campaign funds
+	CampaignModuleName = "rewards_campaigns"
+
+	// LockModuleName defines the name of the module account holding locked EGV
+	LockModuleName = "rewards_locks"
+
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_rewards"
 
-	// TransactionCountKey is the prefix to store transaction count per address
-	TransactionCountKey = "transaction_count"
+	// DefaultRewardDenom is the default denomination of the EGV token. The
+	// denom in use is the reward_denom param.
+	DefaultRewardDenom = "egv"
+)
+
+var (
+	// ParamsKey is the prefix of the module params item
+	ParamsKey = collections.NewPrefix(0)
 
-	// TotalTxKey is the key for storing total transactions in the network
-	TotalTxKey = "total_transactions"
+	// TransactionCountKeyPrefix is the prefix of the transaction count per address map
+	TransactionCountKeyPrefix = collections.NewPrefix(1)
 
-	// EGVDenom is the denomination for the EGV token
-	EGVDenom = "egv"
+	// TotalTransactionsKey is the prefix of the total network transactions item
+	TotalTransactionsKey = collections.NewPrefix(2)
 
-	TotalSupplyKey = "TotalSupply"
-)
+	// TotalSupplyKey is the prefix of the EGV total supply item
+	TotalSupplyKey = collections.NewPrefix(3)
 
-var (
-	ParamsKey = []byte("p_rewards")
+	// EpochTransactionCountKeyPrefix is the prefix of the transaction count per (epoch, address) map
+	EpochTransactionCountKeyPrefix = collections.NewPrefix(4)
+
+	// EpochTotalTransactionsKeyPrefix is the prefix of the network transactions per epoch map
+	EpochTotalTransactionsKeyPrefix = collections.NewPrefix(5)
+
+	// ContributionScoreKeyPrefix is the prefix of the decayed contribution score per address map
+	ContributionScoreKeyPrefix = collections.NewPrefix(6)
+
+	// TotalContributionScoreKey is the prefix of the network decayed contribution score item
+	TotalContributionScoreKey = collections.NewPrefix(7)
+
+	// RewardWithdrawAddressKeyPrefix is the prefix of the reward withdraw address per account map
+	RewardWithdrawAddressKeyPrefix = collections.NewPrefix(8)
+
+	// VestingPositionKeyPrefix is the prefix of the reward vesting positions per (address, id) map
+	VestingPositionKeyPrefix = collections.NewPrefix(9)
+
+	// VestingPositionSequenceKey is the prefix of the next reward vesting position id
+	VestingPositionSequenceKey = collections.NewPrefix(10)
+
+	// EpochRewardKeyPrefix is the prefix of the rewards distributed per (epoch, denom) map
+	EpochRewardKeyPrefix = collections.NewPrefix(11)
+
+	// AddressRewardKeyPrefix is the prefix of the rewards paid out per (address, denom) map
+	AddressRewardKeyPrefix = collections.NewPrefix(12)
+
+	// CampaignKeyPrefix is the prefix of the sponsored campaigns per id map
+	CampaignKeyPrefix = collections.NewPrefix(13)
+
+	// CampaignSequenceKey is the prefix of the last sponsored campaign id
+	CampaignSequenceKey = collections.NewPrefix(14)
+
+	// CampaignContributionKeyPrefix is the prefix of the matching messages per (campaign, address) map
+	CampaignContributionKeyPrefix = collections.NewPrefix(15)
+
+	// BurnedSupplyKey is the prefix of the EGV burned supply item
+	BurnedSupplyKey = collections.NewPrefix(16)
+
+	// ParticipationKeyPrefix is the prefix of the governance participation per address map
+	ParticipationKeyPrefix = collections.NewPrefix(17)
+
+	// EpochParticipationKeyPrefix is the prefix of the governance participation per (epoch, address) map
+	EpochParticipationKeyPrefix = collections.NewPrefix(18)
+
+	// ProposalVoteKeyPrefix is the prefix of the (proposal, voter) set of x/gov votes
+	ProposalVoteKeyPrefix = collections.NewPrefix(19)
+
+	// LockKeyPrefix is the prefix of the locked EGV per address map
+	LockKeyPrefix = collections.NewPrefix(20)
+
+	// UnlockKeyPrefix is the prefix of the EGV unlocks per (address, id) map
+	UnlockKeyPrefix = collections.NewPrefix(21)
+
+	// UnlockSequenceKey is the prefix of the next EGV unlock id
+	UnlockSequenceKey = collections.NewPrefix(22)
+
+	// ActiveCampaignKeyPrefix is the prefix of the (end epoch, id) set of unsettled campaigns
+	ActiveCampaignKeyPrefix = collections.NewPrefix(23)
+
+	// UnlockQueueKeyPrefix is the prefix of the (completion height, address, id) set of pending EGV unlocks
+	UnlockQueueKeyPrefix = collections.NewPrefix(24)
+
+	// LockBonusKey is the prefix of the running total of what lock boosts add to the lifetime transaction count
+	LockBonusKey = collections.NewPrefix(25)
+
+	// LockScoreBonusKey is the prefix of the running total of what lock boosts add to the decayed network score
+	LockScoreBonusKey = collections.NewPrefix(26)
+
+	// GovernanceSetRemovalsKey is the prefix of the governance wallets removed for inactivity in the current epoch
+	GovernanceSetRemovalsKey = collections.NewPrefix(27)
+
+	// PendingInflationChangeKey is the prefix of the inflation rate change waiting for the next epoch boundary
+	PendingInflationChangeKey = collections.NewPrefix(28)
+
+	// QueuedParamChangeKeyPrefix is the prefix of the params updates per id waiting in the timelock queue
+	QueuedParamChangeKeyPrefix = collections.NewPrefix(29)
+
+	// QueuedParamChangeSequenceKey is the prefix of the next timelock queue id
+	QueuedParamChangeSequenceKey = collections.NewPrefix(30)
+
+	// VotingDelegationKeyPrefix is the prefix of the voting power delegations per delegator map
+	VotingDelegationKeyPrefix = collections.NewPrefix(31)
+
+	// VotingDelegationByDelegateeKeyPrefix is the prefix of the (delegatee, delegator) set of voting power delegations
+	VotingDelegationByDelegateeKeyPrefix = collections.NewPrefix(32)
+
+	// CandidateKeyPrefix is the prefix of the governance wallet election candidates per address map
+	CandidateKeyPrefix = collections.NewPrefix(33)
+
+	// CouncilKeyPrefix is the prefix of the elected councils per term map
+	CouncilKeyPrefix = collections.NewPrefix(34)
+
+	// CouncilSequenceKey is the prefix of the term of the latest elected council
+	CouncilSequenceKey = collections.NewPrefix(35)
 )
 
 func KeyPrefix(p string) []byte {
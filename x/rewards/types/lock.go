@@ -0,0 +1,151 @@
+package types
+
+import (
+	"fmt"
+
+	math "cosmossdk.io/math"
+)
+
+// Lock boost curves
+const (
+	// LockBoostLinear grows the boost in proportion to the locked amount.
+	LockBoostLinear = "linear"
+
+	// LockBoostSqrt grows the boost with the square root of the locked
+	// amount, favouring smaller locks.
+	LockBoostSqrt = "sqrt"
+)
+
+// DefaultLockUnbondingPeriod returns unlocked EGV after about three weeks.
+const DefaultLockUnbondingPeriod = 21 * DefaultEpochLength
+
+// DefaultLockBoostSaturation is half the genesis balance of a governance
+// wallet, in base units of the reward denom, so every wallet can reach the
+// full boost.
+const DefaultLockBoostSaturation = InitialWalletBalance / 2
+
+// DefaultMinLockAmount keeps locks of less than a hundredth of a governance
+// wallet's genesis balance, in base units of the reward denom, out of the
+// store.
+const DefaultMinLockAmount = InitialWalletBalance / 100
+
+// NewEGVLock creates a new EGVLock of amount for addr.
+func NewEGVLock(addr string, amount math.Int) EGVLock {
+	return EGVLock{
+		Address: addr,
+		Amount:  amount.String(),
+	}
+}
+
+// Validate checks the address, under any Bech32 prefix, and that the amount is
+// positive.
+func (l EGVLock) Validate() error {
+	if err := validateAccAddress(l.Address); err != nil {
+		return fmt.Errorf("lock of %s: invalid address: %w", l.Address, err)
+	}
+	amount, ok := math.NewIntFromString(l.Amount)
+	if !ok || !amount.IsPositive() {
+		return fmt.Errorf("lock of %s: amount %q must be positive", l.Address, l.Amount)
+	}
+	return nil
+}
+
+// NewEGVUnlock creates a new EGVUnlock returning amount to addr at
+// completionHeight.
+func NewEGVUnlock(id uint64, addr string, amount math.Int, completionHeight int64) EGVUnlock {
+	return EGVUnlock{
+		Id:               id,
+		Address:          addr,
+		Amount:           amount.String(),
+		CompletionHeight: completionHeight,
+	}
+}
+
+// Validate checks the address, under any Bech32 prefix, and that the amount is
+// positive.
+func (u EGVUnlock) Validate() error {
+	if err := validateAccAddress(u.Address); err != nil {
+		return fmt.Errorf("unlock %d: invalid address: %w", u.Id, err)
+	}
+	amount, ok := math.NewIntFromString(u.Amount)
+	if !ok || !amount.IsPositive() {
+		return fmt.Errorf("unlock %d: amount %q must be positive", u.Id, u.Amount)
+	}
+	return nil
+}
+
+// LockBoost returns the multiplier applied to the contribution of an address
+// that locked the given amount: 1 + (lock_boost_max - 1) * f(x), where x is
+// the locked amount as a fraction of lock_boost_saturation, capped at 1, and
+// f is the lock_boost_curve.
+func (p Params) LockBoost(locked math.Int) (math.LegacyDec, error) {
+	boostMax, err := math.LegacyNewDecFromStr(p.LockBoostMax)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid lock boost max: %w", err)
+	}
+	saturation, ok := math.NewIntFromString(p.LockBoostSaturation)
+	if !ok || !saturation.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("invalid lock boost saturation %q", p.LockBoostSaturation)
+	}
+	if !locked.IsPositive() || boostMax.LTE(math.LegacyOneDec()) {
+		return math.LegacyOneDec(), nil
+	}
+
+	fraction := math.LegacyOneDec()
+	if locked.LT(saturation) {
+		fraction = math.LegacyNewDecFromInt(locked).QuoInt(saturation)
+	}
+	if p.LockBoostCurve == LockBoostSqrt {
+		if fraction, err = fraction.ApproxSqrt(); err != nil {
+			return math.LegacyDec{}, err
+		}
+	}
+	return math.LegacyOneDec().Add(boostMax.Sub(math.LegacyOneDec()).Mul(fraction)), nil
+}
+
+func (p Params) validateLockBoost() error {
+	if p.LockUnbondingPeriod == 0 {
+		return fmt.Errorf("lock unbonding period must be positive")
+	}
+	switch p.LockBoostCurve {
+	case LockBoostLinear, LockBoostSqrt:
+	default:
+		return fmt.Errorf("unknown lock boost curve %q", p.LockBoostCurve)
+	}
+
+	boostMax, err := math.LegacyNewDecFromStr(p.LockBoostMax)
+	if err != nil {
+		return fmt.Errorf("invalid lock boost max format: %v", err)
+	}
+	if boostMax.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("lock boost max must be at least 1")
+	}
+	saturation, ok := math.NewIntFromString(p.LockBoostSaturation)
+	if !ok {
+		return fmt.Errorf("invalid lock boost saturation: %s", p.LockBoostSaturation)
+	}
+	if !saturation.IsPositive() {
+		return fmt.Errorf("lock boost saturation must be positive")
+	}
+	minLock, ok := math.NewIntFromString(p.MinLockAmount)
+	if !ok {
+		return fmt.Errorf("invalid min lock amount: %s", p.MinLockAmount)
+	}
+	if minLock.IsNegative() {
+		return fmt.Errorf("min lock amount cannot be negative")
+	}
+	return nil
+}
+
+// ValidateLockAmount checks that the amount left locked by an address is
+// either nothing or at least min_lock_amount.
+func (p Params) ValidateLockAmount(locked math.Int) error {
+	minLock, ok := math.NewIntFromString(p.MinLockAmount)
+	if !ok {
+		return fmt.Errorf("invalid min lock amount %q", p.MinLockAmount)
+	}
+	if locked.IsPositive() && locked.LT(minLock) {
+		return fmt.Errorf("%s locked is below min lock amount %s", locked, minLock)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"zenoda/testutil/sample"
+	"zenoda/x/rewards/types"
+)
+
+func TestLockBoost(t *testing.T) {
+	tests := []struct {
+		desc     string
+		curve    string
+		max      string
+		locked   int64
+		expected string
+	}{
+		{desc: "nothing locked", curve: types.LockBoostSqrt, max: "2", locked: 0, expected: "1.000000000000000000"},
+		{desc: "linear", curve: types.LockBoostLinear, max: "3", locked: 250, expected: "1.500000000000000000"},
+		{desc: "sqrt", curve: types.LockBoostSqrt, max: "3", locked: 250, expected: "2.000000000000000000"},
+		{desc: "saturated", curve: types.LockBoostLinear, max: "3", locked: 5000, expected: "3.000000000000000000"},
+		{desc: "boost disabled", curve: types.LockBoostSqrt, max: "1", locked: 500, expected: "1.000000000000000000"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := sample.RewardsParams()
+			params.LockBoostCurve = tc.curve
+			params.LockBoostMax = tc.max
+			params.LockBoostSaturation = "1000"
+
+			boost, err := params.LockBoost(math.NewInt(tc.locked))
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, boost.String())
+		})
+	}
+}
+
+func TestValidateLockBoost(t *testing.T) {
+	tests := []struct {
+		desc   string
+		modify func(*types.Params)
+	}{
+		{desc: "zero unbonding period", modify: func(p *types.Params) { p.LockUnbondingPeriod = 0 }},
+		{desc: "unknown curve", modify: func(p *types.Params) { p.LockBoostCurve = "cubic" }},
+		{desc: "max below one", modify: func(p *types.Params) { p.LockBoostMax = "0.5" }},
+		{desc: "zero saturation", modify: func(p *types.Params) { p.LockBoostSaturation = "0" }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := sample.RewardsParams()
+			tc.modify(&params)
+			require.Error(t, params.Validate())
+		})
+	}
+}
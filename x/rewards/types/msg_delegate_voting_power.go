@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgDelegateVotingPower{}
+
+// NewMsgDelegateVotingPower creates a new MsgDelegateVotingPower instance.
+func NewMsgDelegateVotingPower(delegator, delegatee string) *MsgDelegateVotingPower {
+	return &MsgDelegateVotingPower{
+		Delegator: delegator,
+		Delegatee: delegatee,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgDelegateVotingPower) ValidateBasic() error {
+	return VotingDelegation{Delegator: m.Delegator, Delegatee: m.Delegatee}.ValidateStateless()
+}
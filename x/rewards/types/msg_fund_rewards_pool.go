@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFundRewardsPool{}
+
+// NewMsgFundRewardsPool creates a new MsgFundRewardsPool instance. A nil
+// campaign funds the reward pool.
+func NewMsgFundRewardsPool(sender string, amount sdk.Coins, campaign *CampaignSpec) *MsgFundRewardsPool {
+	return &MsgFundRewardsPool{
+		Sender:   sender,
+		Amount:   amount,
+		Campaign: campaign,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFundRewardsPool) ValidateBasic() error {
+	if err := validateAccAddress(m.Sender); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+	if m.Campaign != nil {
+		if err := m.Campaign.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidCampaign, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgLockEGV{}
+
+// NewMsgLockEGV creates a new MsgLockEGV instance.
+func NewMsgLockEGV(owner string, amount math.Int) *MsgLockEGV {
+	return &MsgLockEGV{
+		Owner:  owner,
+		Amount: amount.String(),
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgLockEGV) ValidateBasic() error {
+	if err := validateAccAddress(m.Owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	amount, ok := math.NewIntFromString(m.Amount)
+	if !ok || !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount %q must be positive", m.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRefundCampaign{}
+
+// NewMsgRefundCampaign creates a new MsgRefundCampaign instance.
+func NewMsgRefundCampaign(sponsor string, campaignID uint64) *MsgRefundCampaign {
+	return &MsgRefundCampaign{
+		Sponsor:    sponsor,
+		CampaignId: campaignID,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRefundCampaign) ValidateBasic() error {
+	if err := validateAccAddress(m.Sponsor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if m.CampaignId == 0 {
+		return errorsmod.Wrap(ErrCampaignNotFound, "campaign id must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRegisterCandidate{}
+
+// NewMsgRegisterCandidate creates a new MsgRegisterCandidate instance.
+func NewMsgRegisterCandidate(candidate string) *MsgRegisterCandidate {
+	return &MsgRegisterCandidate{
+		Candidate: candidate,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRegisterCandidate) ValidateBasic() error {
+	if err := validateAccAddress(m.Candidate); err != nil {
+		return errorsmod.Wrap(err, "invalid candidate address")
+	}
+	return nil
+}
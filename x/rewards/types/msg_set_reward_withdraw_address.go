@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSetRewardWithdrawAddress{}
+
+// NewMsgSetRewardWithdrawAddress creates a new MsgSetRewardWithdrawAddress instance.
+func NewMsgSetRewardWithdrawAddress(address, withdrawAddress string) *MsgSetRewardWithdrawAddress {
+	return &MsgSetRewardWithdrawAddress{
+		Address:         address,
+		WithdrawAddress: withdrawAddress,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetRewardWithdrawAddress) ValidateBasic() error {
+	return RewardWithdrawAddress{Address: m.Address, WithdrawAddress: m.WithdrawAddress}.Validate()
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUndelegateVotingPower{}
+
+// NewMsgUndelegateVotingPower creates a new MsgUndelegateVotingPower instance.
+func NewMsgUndelegateVotingPower(delegator string) *MsgUndelegateVotingPower {
+	return &MsgUndelegateVotingPower{
+		Delegator: delegator,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUndelegateVotingPower) ValidateBasic() error {
+	if err := validateAccAddress(m.Delegator); err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+	return nil
+}
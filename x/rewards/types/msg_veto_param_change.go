@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgVetoParamChange{}
+
+// NewMsgVetoParamChange creates a new MsgVetoParamChange instance.
+func NewMsgVetoParamChange(signer string, changeID uint64) *MsgVetoParamChange {
+	return &MsgVetoParamChange{
+		Signer:   signer,
+		ChangeId: changeID,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgVetoParamChange) ValidateBasic() error {
+	if err := validateAccAddress(m.Signer); err != nil {
+		return errorsmod.Wrap(err, "invalid signer address")
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgWithdrawCandidacy{}
+
+// NewMsgWithdrawCandidacy creates a new MsgWithdrawCandidacy instance.
+func NewMsgWithdrawCandidacy(candidate string) *MsgWithdrawCandidacy {
+	return &MsgWithdrawCandidacy{
+		Candidate: candidate,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgWithdrawCandidacy) ValidateBasic() error {
+	if err := validateAccAddress(m.Candidate); err != nil {
+		return errorsmod.Wrap(err, "invalid candidate address")
+	}
+	return nil
+}
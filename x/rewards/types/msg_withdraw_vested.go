@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgWithdrawVested{}
+
+// NewMsgWithdrawVested creates a new MsgWithdrawVested instance.
+func NewMsgWithdrawVested(address string) *MsgWithdrawVested {
+	return &MsgWithdrawVested{
+		Address: address,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgWithdrawVested) ValidateBasic() error {
+	if err := validateAccAddress(m.Address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return nil
+}
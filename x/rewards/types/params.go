@@ -3,37 +3,96 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/core/address"
 	math "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
-// Parameter keys
-var (
-	KeyInflationRate     = []byte("InflationRate")
-	KeyPredefinedWallets = []byte("PredefinedWallets")
+// Default parameter values
+const (
+	// DefaultEpochLength is roughly one day of 5 second blocks.
+	DefaultEpochLength uint64 = 17280
+
+	// DefaultParamChangeDelay keeps governance params updates queued for two epochs.
+	DefaultParamChangeDelay = 2 * DefaultEpochLength
+
+	// MinParamChangeDelay keeps governance params updates queued for at
+	// least about an hour of 5 second blocks, so the timelock cannot be
+	// switched off and the governance wallets always get time to veto.
+	MinParamChangeDelay uint64 = 720
+
+	// DefaultCouncilSize matches the ten governance wallets set at genesis.
+	DefaultCouncilSize uint64 = 10
+
+	// DefaultMaxConsecutiveTerms lets a wallet sit on three councils in a row.
+	DefaultMaxConsecutiveTerms uint64 = 3
+
+	// DefaultMinGovernanceSetSize requires at least one governance wallet.
+	DefaultMinGovernanceSetSize uint64 = 1
+
+	// DefaultMaxGovernanceSetSize caps the governance wallets at 21.
+	DefaultMaxGovernanceSetSize uint64 = 21
+
+	// DefaultContributionRetentionEpochs keeps about three months of daily
+	// contribution history.
+	DefaultContributionRetentionEpochs uint64 = 90
+
+	// DefaultScoreHalfLifeEpochs halves a transaction's score after about a month.
+	DefaultScoreHalfLifeEpochs uint64 = 30
+
+	// DefaultRewardVestingDuration releases vesting rewards over about a month.
+	DefaultRewardVestingDuration = 30 * DefaultEpochLength
+
+	// DefaultInflationHalvingEpochs halves the inflation rate about every four years.
+	DefaultInflationHalvingEpochs uint64 = 4 * 365
+
+	// LegacyAccountAddressPrefix is the prefix of the default governance
+	// wallets. Wallets using it are accepted and re-encoded with the chain's
+	// own prefix.
+	LegacyAccountAddressPrefix = "cosmos"
 )
 
-var _ paramtypes.ParamSet = (*Params)(nil)
+// Voting weight functions
+const (
+	// VotingWeightLinear counts every transaction as one unit of weight.
+	VotingWeightLinear = "linear"
 
-// ParamKeyTable creates the key table for rewards module parameters
-func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
-}
+	// VotingWeightQuadratic uses the square root of the transaction count.
+	VotingWeightQuadratic = "quadratic"
+
+	// VotingWeightCapped counts transactions linearly up to voting_weight_cap
+	// of all network transactions.
+	VotingWeightCapped = "capped"
+)
+
+// Contribution score modes
+const (
+	// ContributionScoreLifetime uses lifetime transaction counts.
+	ContributionScoreLifetime = "lifetime"
 
-// NewParams creates a new Params instance
+	// ContributionScoreDecayed uses the exponentially decayed contribution score.
+	ContributionScoreDecayed = "decayed"
+)
+
+// NewParams creates a new Params instance with the given inflation rate and
+// governance wallets and every other parameter at its default. Build Params
+// with a struct literal to set anything else.
 func NewParams(inflationRate math.LegacyDec, predefinedWallets []string) Params {
-	return Params{
-		InflationRate:     inflationRate.String(), // Keep InflationRate as a string
-		PredefinedWallets: predefinedWallets,      // List of governance wallets
-	}
+	params := DefaultParams()
+	params.InflationRate = inflationRate.String()
+	params.PredefinedWallets = predefinedWallets
+	return params
 }
 
-// DefaultParams returns a default set of parameters
+// DefaultParams returns a default set of parameters. The predefined wallets
+// are the genesis governance set, encoded with LegacyAccountAddressPrefix and
+// normalised to the chain's prefix at genesis; tests should use
+// sample.RewardsParams, which generates wallets for the configured prefix.
 func DefaultParams() Params {
-	return NewParams(
-		math.LegacyMustNewDecFromStr("0.05"), // Default 5% inflation rate
-		[]string{
+	return Params{
+		InflationRate: math.LegacyMustNewDecFromStr("0.05").String(), // Default 5% inflation rate
+		PredefinedWallets: []string{
 			"cosmos1lhahcqzx45mssr9wfknx48hy4truyz9p2wj3ht",
 			"cosmos1g6k8qf0zksqruq8exv0duw3p9fn33aeffdprl6",
 			"cosmos1fmatdzaqv75sxte07nt67arx398v2cdudv25fl",
@@ -45,14 +104,46 @@ func DefaultParams() Params {
 			"cosmos1nvwepluydj7xnga6qud3cl46juft7rrnktx5as",
 			"cosmos1gj2yqrzkdd9q7yedcasvvke5ls5ahkfq0gm6x4",
 		},
-	)
-}
+		MinInflationRate:       math.LegacyZeroDec().String(),                 // Default 0% minimum inflation rate
+		MaxInflationRate:       math.LegacyMustNewDecFromStr("0.20").String(), // Default 20% maximum inflation rate
+		MaxInflationRateChange: math.LegacyMustNewDecFromStr("0.02").String(), // Default 2 points maximum change per update
+		EpochLength:            DefaultEpochLength,
+		ParamChangeDelay:       DefaultParamChangeDelay,
+		VetoThreshold:          math.LegacyMustNewDecFromStr("0.67").String(), // Default two-thirds of governance wallets can veto
+		VotingWeightFunction:   VotingWeightLinear,
+		VotingWeightCap:        math.LegacyMustNewDecFromStr("0.10").String(), // Default 10% cap for the capped voting weight
+		ElectionInterval:       0,                                             // Elections are disabled by default
+		CouncilSize:            DefaultCouncilSize,
+		MinCandidateBalance:    math.NewInt(100).String(), // Default 100 EGV to stand for election
+		MaxConsecutiveTerms:    DefaultMaxConsecutiveTerms,
+		MinGovernanceSetSize:   DefaultMinGovernanceSetSize,
+		MaxGovernanceSetSize:   DefaultMaxGovernanceSetSize,
+		MaxGovernanceSetChange: math.LegacyMustNewDecFromStr("0.34").String(), // Default one third of the governance wallets per update
 
-// ParamSetPairs defines the parameter set pairs
-func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyInflationRate, &p.InflationRate, validateInflationRate),
-		paramtypes.NewParamSetPair(KeyPredefinedWallets, &p.PredefinedWallets, validatePredefinedWallets),
+		ContributionRetentionEpochs: DefaultContributionRetentionEpochs,
+		ContributionScoreMode:       ContributionScoreLifetime,
+		ScoreHalfLifeEpochs:         DefaultScoreHalfLifeEpochs,
+		MaxSharePerAddress:          math.LegacyOneDec().String(),  // No reward share cap by default
+		RewardVestingFraction:       math.LegacyZeroDec().String(), // Rewards are paid out immediately by default
+		RewardVestingDuration:       DefaultRewardVestingDuration,
+		FeeShare:                    math.LegacyZeroDec().String(), // All fees go to x/distribution by default
+		MaxSupply:                   math.ZeroInt().String(),       // No EGV supply cap by default
+		BurnFraction:                math.LegacyZeroDec().String(), // Nothing is burned by default
+		InflationSchedule:           InflationScheduleConstant,
+		InflationHalvingEpochs:      DefaultInflationHalvingEpochs,
+		InflationDecayRate:          math.LegacyZeroDec().String(), // No decay unless configured
+		InflationFloor:              math.LegacyZeroDec().String(), // Decay toward zero inflation
+		SoulboundEgv:                false,                         // EGV is transferable by default
+		RewardDenom:                 DefaultRewardDenom,
+		MaxMissedGovernanceActions:  0, // Inactivity penalties are disabled by default
+		InactivityPenalty:           InactivityPenaltyRewardReduction,
+		InactivityRewardReduction:   math.LegacyMustNewDecFromStr("0.5").String(), // Default halving of an inactive wallet's reward share
+		InactivityPenaltyEpochs:     DefaultInactivityPenaltyEpochs,
+		LockUnbondingPeriod:         DefaultLockUnbondingPeriod,
+		LockBoostCurve:              LockBoostSqrt,
+		LockBoostMax:                math.LegacyNewDec(2).String(), // Default doubling of the contribution of a saturated lock
+		LockBoostSaturation:         math.NewInt(DefaultLockBoostSaturation).String(),
+		MinLockAmount:               math.NewInt(DefaultMinLockAmount).String(),
 	}
 }
 
@@ -64,16 +155,111 @@ func (p Params) Validate() error {
 	if err := validatePredefinedWallets(p.PredefinedWallets); err != nil {
 		return err
 	}
-	return nil
+	if err := validateInflationRate(p.MinInflationRate); err != nil {
+		return fmt.Errorf("min inflation rate: %w", err)
+	}
+	if err := validateInflationRate(p.MaxInflationRate); err != nil {
+		return fmt.Errorf("max inflation rate: %w", err)
+	}
+	if err := validateInflationRate(p.MaxInflationRateChange); err != nil {
+		return fmt.Errorf("max inflation rate change: %w", err)
+	}
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return err
+	}
+	if err := validateParamChangeDelay(p.ParamChangeDelay); err != nil {
+		return err
+	}
+	if err := validateVetoThreshold(p.VetoThreshold); err != nil {
+		return err
+	}
+	if err := validateVotingWeightFunction(p.VotingWeightFunction); err != nil {
+		return err
+	}
+	if err := validateVotingWeightCap(p.VotingWeightCap); err != nil {
+		return err
+	}
+	if err := validateCouncilSize(p.CouncilSize); err != nil {
+		return err
+	}
+	if err := validateMinCandidateBalance(p.MinCandidateBalance); err != nil {
+		return err
+	}
+	if err := p.validateGovernanceSetSize(); err != nil {
+		return err
+	}
+	if err := validateMaxGovernanceSetChange(p.MaxGovernanceSetChange); err != nil {
+		return err
+	}
+	if err := validateContributionScoreMode(p.ContributionScoreMode); err != nil {
+		return err
+	}
+	if err := validateScoreHalfLifeEpochs(p.ScoreHalfLifeEpochs); err != nil {
+		return err
+	}
+	if err := validateMaxSharePerAddress(p.MaxSharePerAddress); err != nil {
+		return err
+	}
+	if err := validateRewardVestingFraction(p.RewardVestingFraction); err != nil {
+		return err
+	}
+	if err := validateRewardVestingDuration(p.RewardVestingDuration); err != nil {
+		return err
+	}
+	if err := validateFeeShare(p.FeeShare); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateBurnFraction(p.BurnFraction); err != nil {
+		return err
+	}
+	if err := p.validateInflationSchedule(); err != nil {
+		return err
+	}
+	if err := validateEGVTransferAllowlist(p.EgvTransferAllowlist); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.RewardDenom); err != nil {
+		return fmt.Errorf("invalid reward denom: %w", err)
+	}
+	if err := p.validateInactivityPenalty(); err != nil {
+		return err
+	}
+	if err := p.validateLockBoost(); err != nil {
+		return err
+	}
+	return p.ValidateInflationBounds(p.InflationRate)
 }
 
-// validateInflationRate ensures the inflation rate is between 0 and 1
-func validateInflationRate(i interface{}) error {
-	inflationRateStr, ok := i.(string)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
+// ValidateInflationBounds ensures the given rate lies within the min/max
+// inflation bounds of the params.
+func (p Params) ValidateInflationBounds(rate string) error {
+	inflationRate, err := math.LegacyNewDecFromStr(rate)
+	if err != nil {
+		return fmt.Errorf("invalid inflation rate format: %v", err)
+	}
+	minRate, err := math.LegacyNewDecFromStr(p.MinInflationRate)
+	if err != nil {
+		return fmt.Errorf("invalid min inflation rate format: %v", err)
+	}
+	maxRate, err := math.LegacyNewDecFromStr(p.MaxInflationRate)
+	if err != nil {
+		return fmt.Errorf("invalid max inflation rate format: %v", err)
+	}
+
+	if minRate.GT(maxRate) {
+		return fmt.Errorf("min inflation rate %s is greater than max inflation rate %s", minRate, maxRate)
+	}
+	if inflationRate.LT(minRate) || inflationRate.GT(maxRate) {
+		return fmt.Errorf("inflation rate %s must be between %s and %s", inflationRate, minRate, maxRate)
 	}
+	return nil
+}
 
+// validateInflationRate ensures the inflation rate is between 0 and 1
+func validateInflationRate(inflationRateStr string) error {
 	inflationRate, err := math.LegacyNewDecFromStr(inflationRateStr)
 	if err != nil {
 		return fmt.Errorf("invalid inflation rate format: %v", err)
@@ -85,22 +271,399 @@ func validateInflationRate(i interface{}) error {
 	return nil
 }
 
-// validatePredefinedWallets ensures that all provided addresses are valid Bech32 addresses
-func validatePredefinedWallets(i interface{}) error {
-	wallets, ok := i.([]string)
+// validateEpochLength ensures the epoch is at least one block long
+func validateEpochLength(epochLength uint64) error {
+	if epochLength == 0 {
+		return fmt.Errorf("epoch length must be positive")
+	}
+	return nil
+}
+
+// validateParamChangeDelay ensures the timelock lasts at least
+// MinParamChangeDelay blocks.
+func validateParamChangeDelay(delay uint64) error {
+	if delay < MinParamChangeDelay {
+		return fmt.Errorf("param change delay %d must be at least %d blocks", delay, MinParamChangeDelay)
+	}
+	return nil
+}
+
+// validateVetoThreshold ensures the veto threshold is above 0 and at most 1
+func validateVetoThreshold(thresholdStr string) error {
+	threshold, err := math.LegacyNewDecFromStr(thresholdStr)
+	if err != nil {
+		return fmt.Errorf("invalid veto threshold format: %v", err)
+	}
+
+	if !threshold.IsPositive() || threshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("veto threshold must be greater than 0 and at most 1")
+	}
+	return nil
+}
+
+// validateVotingWeightFunction ensures the voting weight function is known
+func validateVotingWeightFunction(function string) error {
+	switch function {
+	case VotingWeightLinear, VotingWeightQuadratic, VotingWeightCapped:
+		return nil
+	default:
+		return fmt.Errorf("unknown voting weight function %q", function)
+	}
+}
+
+// validateVotingWeightCap ensures the voting weight cap is above 0 and at most 1
+func validateVotingWeightCap(capStr string) error {
+	votingWeightCap, err := math.LegacyNewDecFromStr(capStr)
+	if err != nil {
+		return fmt.Errorf("invalid voting weight cap format: %v", err)
+	}
+
+	if !votingWeightCap.IsPositive() || votingWeightCap.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("voting weight cap must be greater than 0 and at most 1")
+	}
+	return nil
+}
+
+// validateCouncilSize ensures elections pick at least one governance wallet
+func validateCouncilSize(councilSize uint64) error {
+	if councilSize == 0 {
+		return fmt.Errorf("council size must be positive")
+	}
+	return nil
+}
+
+// validateGovernanceSetSize ensures the size bounds are consistent and that
+// both the governance wallets and an elected council fit within them.
+func (p Params) validateGovernanceSetSize() error {
+	if p.MinGovernanceSetSize == 0 {
+		return fmt.Errorf("min governance set size must be positive")
+	}
+	if p.MinGovernanceSetSize > p.MaxGovernanceSetSize {
+		return fmt.Errorf(
+			"min governance set size %d is greater than max governance set size %d",
+			p.MinGovernanceSetSize, p.MaxGovernanceSetSize,
+		)
+	}
+	size := uint64(len(p.PredefinedWallets))
+	if size < p.MinGovernanceSetSize || size > p.MaxGovernanceSetSize {
+		return fmt.Errorf(
+			"number of predefined wallets %d must be between %d and %d",
+			size, p.MinGovernanceSetSize, p.MaxGovernanceSetSize,
+		)
+	}
+	if p.CouncilSize > p.MaxGovernanceSetSize {
+		return fmt.Errorf(
+			"council size %d is greater than max governance set size %d",
+			p.CouncilSize, p.MaxGovernanceSetSize,
+		)
+	}
+	return nil
+}
+
+// validateMaxGovernanceSetChange ensures the change fraction is above 0 and at most 1
+func validateMaxGovernanceSetChange(changeStr string) error {
+	change, err := math.LegacyNewDecFromStr(changeStr)
+	if err != nil {
+		return fmt.Errorf("invalid max governance set change format: %v", err)
+	}
+
+	if !change.IsPositive() || change.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max governance set change must be greater than 0 and at most 1")
+	}
+	return nil
+}
+
+// validateContributionScoreMode ensures the contribution score mode is known
+func validateContributionScoreMode(mode string) error {
+	switch mode {
+	case ContributionScoreLifetime, ContributionScoreDecayed:
+		return nil
+	default:
+		return fmt.Errorf("unknown contribution score mode %q", mode)
+	}
+}
+
+// validateScoreHalfLifeEpochs ensures the score half-life is at least one epoch
+func validateScoreHalfLifeEpochs(halfLife uint64) error {
+	if halfLife == 0 {
+		return fmt.Errorf("score half-life must be positive")
+	}
+	return nil
+}
+
+// validateMaxSharePerAddress ensures the reward share cap is above 0 and at most 1
+func validateMaxSharePerAddress(shareStr string) error {
+	share, err := math.LegacyNewDecFromStr(shareStr)
+	if err != nil {
+		return fmt.Errorf("invalid max share per address format: %v", err)
+	}
+
+	if !share.IsPositive() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max share per address must be greater than 0 and at most 1")
+	}
+	return nil
+}
+
+// validateRewardVestingFraction ensures the vesting fraction is between 0 and 1
+func validateRewardVestingFraction(fractionStr string) error {
+	fraction, err := math.LegacyNewDecFromStr(fractionStr)
+	if err != nil {
+		return fmt.Errorf("invalid reward vesting fraction format: %v", err)
+	}
+
+	if fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reward vesting fraction must be between 0 and 1 (inclusive)")
+	}
+	return nil
+}
+
+// validateRewardVestingDuration ensures vesting rewards are released over at least one block
+func validateRewardVestingDuration(duration uint64) error {
+	if duration == 0 {
+		return fmt.Errorf("reward vesting duration must be positive")
+	}
+	return nil
+}
+
+// validateFeeShare ensures the fee share is between 0 and 1
+func validateFeeShare(feeShareStr string) error {
+	feeShare, err := math.LegacyNewDecFromStr(feeShareStr)
+	if err != nil {
+		return fmt.Errorf("invalid fee share format: %v", err)
+	}
+
+	if feeShare.IsNegative() || feeShare.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fee share must be between 0 and 1 (inclusive)")
+	}
+	return nil
+}
+
+// validateMaxSupply ensures the supply cap is a non-negative amount
+func validateMaxSupply(maxSupplyStr string) error {
+	maxSupply, ok := math.NewIntFromString(maxSupplyStr)
 	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
+		return fmt.Errorf("invalid max supply: %s", maxSupplyStr)
 	}
+	if maxSupply.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative")
+	}
+	return nil
+}
 
+// validateBurnFraction ensures the burn fraction is between 0 and 1
+func validateBurnFraction(fractionStr string) error {
+	fraction, err := math.LegacyNewDecFromStr(fractionStr)
+	if err != nil {
+		return fmt.Errorf("invalid burn fraction format: %v", err)
+	}
+
+	if fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("burn fraction must be between 0 and 1 (inclusive)")
+	}
+	return nil
+}
+
+// validateMinCandidateBalance ensures the candidate balance is a non-negative amount
+func validateMinCandidateBalance(balanceStr string) error {
+	balance, ok := math.NewIntFromString(balanceStr)
+	if !ok {
+		return fmt.Errorf("invalid min candidate balance: %s", balanceStr)
+	}
+	if balance.IsNegative() {
+		return fmt.Errorf("min candidate balance cannot be negative")
+	}
+	return nil
+}
+
+// validatePredefinedWallets ensures that all provided addresses are valid Bech32
+// addresses. The prefix is not checked here: SetParams normalises the wallets
+// to the chain's prefix with the app's address codec.
+func validatePredefinedWallets(wallets []string) error {
+	seen := make(map[string]bool, len(wallets))
 	for _, wallet := range wallets {
-		if _, err := sdk.AccAddressFromBech32(wallet); err != nil {
+		_, bz, err := bech32.DecodeAndConvert(wallet)
+		if err != nil {
 			return fmt.Errorf("invalid Bech32 address: %s", wallet)
 		}
+		if err := sdk.VerifyAddressFormat(bz); err != nil {
+			return fmt.Errorf("invalid address %s: %w", wallet, err)
+		}
+		// Compare the address bytes so the same wallet under two prefixes is caught.
+		if seen[string(bz)] {
+			return fmt.Errorf("duplicated predefined wallet: %s", wallet)
+		}
+		seen[string(bz)] = true
+	}
+	return nil
+}
+
+// validateAccAddress checks that addr is a valid Bech32 account address
+// without requiring a particular prefix.
+func validateAccAddress(addr string) error {
+	_, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return err
+	}
+	return sdk.VerifyAddressFormat(bz)
+}
+
+// validateEGVTransferAllowlist ensures the allowlisted recipients are distinct,
+// valid Bech32 addresses.
+func validateEGVTransferAllowlist(addrs []string) error {
+	seen := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		_, bz, err := bech32.DecodeAndConvert(addr)
+		if err != nil {
+			return fmt.Errorf("invalid EGV transfer allowlist address: %s", addr)
+		}
+		if err := sdk.VerifyAddressFormat(bz); err != nil {
+			return fmt.Errorf("invalid EGV transfer allowlist address %s: %w", addr, err)
+		}
+		if seen[string(bz)] {
+			return fmt.Errorf("duplicated EGV transfer allowlist address: %s", addr)
+		}
+		seen[string(bz)] = true
 	}
 	return nil
 }
 
+// GovernanceSetChanges returns how many wallets of the current governance set
+// a new set replaces: the larger of the number of wallets it adds and the
+// number it removes. Wallets are compared by address bytes.
+func GovernanceSetChanges(current, next []string) (uint64, error) {
+	decode := func(wallets []string) (map[string]bool, error) {
+		set := make(map[string]bool, len(wallets))
+		for _, wallet := range wallets {
+			_, bz, err := bech32.DecodeAndConvert(wallet)
+			if err != nil {
+				return nil, fmt.Errorf("invalid Bech32 address: %s", wallet)
+			}
+			set[string(bz)] = true
+		}
+		return set, nil
+	}
+	currentSet, err := decode(current)
+	if err != nil {
+		return 0, err
+	}
+	nextSet, err := decode(next)
+	if err != nil {
+		return 0, err
+	}
+
+	var added, removed uint64
+	for wallet := range nextSet {
+		if !currentSet[wallet] {
+			added++
+		}
+	}
+	for wallet := range currentSet {
+		if !nextSet[wallet] {
+			removed++
+		}
+	}
+	if added > removed {
+		return added, nil
+	}
+	return removed, nil
+}
+
+// NormalizeWallets returns the params with every governance wallet encoded
+// with the given address codec. Wallets may use either the chain's prefix or
+// LegacyAccountAddressPrefix.
+func (p Params) NormalizeWallets(ac address.Codec) (Params, error) {
+	wallets := make([]string, len(p.PredefinedWallets))
+	for i, wallet := range p.PredefinedWallets {
+		normalized, err := NormalizeAddress(ac, wallet)
+		if err != nil {
+			return p, err
+		}
+		wallets[i] = normalized
+	}
+	p.PredefinedWallets = wallets
+	return p, nil
+}
+
+// NormalizeAddress re-encodes an account address with the given address
+// codec. Addresses that already use the codec's prefix are returned as they
+// are; addresses using LegacyAccountAddressPrefix are converted.
+func NormalizeAddress(ac address.Codec, addr string) (string, error) {
+	if bz, err := ac.StringToBytes(addr); err == nil {
+		return ac.BytesToString(bz)
+	}
+
+	hrp, bz, err := bech32.DecodeAndConvert(addr)
+	if err != nil {
+		return "", fmt.Errorf("invalid Bech32 address: %s", addr)
+	}
+	if hrp != LegacyAccountAddressPrefix {
+		return "", fmt.Errorf("address %s has unsupported prefix %q", addr, hrp)
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return "", fmt.Errorf("invalid address %s: %w", addr, err)
+	}
+	return ac.BytesToString(bz)
+}
+
 // Helper to get inflation rate as LegacyDec
 func (p Params) GetInflationRateAsDec() (math.LegacyDec, error) {
 	return math.LegacyNewDecFromStr(p.InflationRate)
 }
+
+// Helper to get veto threshold as LegacyDec
+func (p Params) GetVetoThresholdAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.VetoThreshold)
+}
+
+// Helper to get max inflation rate change as LegacyDec
+func (p Params) GetMaxInflationRateChangeAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.MaxInflationRateChange)
+}
+
+// Helper to get voting weight cap as LegacyDec
+func (p Params) GetVotingWeightCapAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.VotingWeightCap)
+}
+
+// Helper to get max governance set change as LegacyDec
+func (p Params) GetMaxGovernanceSetChangeAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.MaxGovernanceSetChange)
+}
+
+// Helper to get max share per address as LegacyDec
+func (p Params) GetMaxSharePerAddressAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.MaxSharePerAddress)
+}
+
+// Helper to get reward vesting fraction as LegacyDec
+func (p Params) GetRewardVestingFractionAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.RewardVestingFraction)
+}
+
+// Helper to get fee share as LegacyDec
+func (p Params) GetFeeShareAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.FeeShare)
+}
+
+// Helper to get max supply as Int
+func (p Params) GetMaxSupplyAsInt() (math.Int, error) {
+	maxSupply, ok := math.NewIntFromString(p.MaxSupply)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid max supply: %s", p.MaxSupply)
+	}
+	return maxSupply, nil
+}
+
+// Helper to get burn fraction as LegacyDec
+func (p Params) GetBurnFractionAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.BurnFraction)
+}
+
+// Helper to get min candidate balance as Int
+func (p Params) GetMinCandidateBalanceAsInt() (math.Int, error) {
+	balance, ok := math.NewIntFromString(p.MinCandidateBalance)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid min candidate balance: %s", p.MinCandidateBalance)
+	}
+	return balance, nil
+}
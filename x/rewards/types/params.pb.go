@@ -28,6 +28,138 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	InflationRate     string   `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
 	PredefinedWallets []string `protobuf:"bytes,2,rep,name=predefined_wallets,json=predefinedWallets,proto3" json:"predefined_wallets,omitempty"`
+	// min_inflation_rate is the lowest inflation rate governance may set.
+	MinInflationRate string `protobuf:"bytes,3,opt,name=min_inflation_rate,json=minInflationRate,proto3" json:"min_inflation_rate,omitempty"`
+	// max_inflation_rate is the highest inflation rate governance may set.
+	MaxInflationRate string `protobuf:"bytes,4,opt,name=max_inflation_rate,json=maxInflationRate,proto3" json:"max_inflation_rate,omitempty"`
+	// max_inflation_rate_change is the largest absolute change to the
+	// inflation rate that a single params update may schedule.
+	MaxInflationRateChange string `protobuf:"bytes,5,opt,name=max_inflation_rate_change,json=maxInflationRateChange,proto3" json:"max_inflation_rate_change,omitempty"`
+	// epoch_length is the number of blocks in a rewards epoch.
+	EpochLength uint64 `protobuf:"varint,6,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// param_change_delay is the number of blocks a governance params update
+	// waits in the timelock queue before it is applied, at least 720.
+	ParamChangeDelay uint64 `protobuf:"varint,7,opt,name=param_change_delay,json=paramChangeDelay,proto3" json:"param_change_delay,omitempty"`
+	// veto_threshold is the fraction of governance wallets that must veto a
+	// queued params update to cancel it.
+	VetoThreshold string `protobuf:"bytes,8,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// voting_weight_function selects how a transaction count turns into voting
+	// weight: "linear", "quadratic" (square root of the count) or "capped"
+	// (linear, capped at voting_weight_cap of all network transactions).
+	VotingWeightFunction string `protobuf:"bytes,9,opt,name=voting_weight_function,json=votingWeightFunction,proto3" json:"voting_weight_function,omitempty"`
+	// voting_weight_cap is the largest share of all network transactions a
+	// single address counts for under the "capped" voting weight function.
+	VotingWeightCap string `protobuf:"bytes,10,opt,name=voting_weight_cap,json=votingWeightCap,proto3" json:"voting_weight_cap,omitempty"`
+	// election_interval is the number of epochs between governance wallet
+	// elections. Zero disables elections and keeps predefined_wallets fixed.
+	ElectionInterval uint64 `protobuf:"varint,11,opt,name=election_interval,json=electionInterval,proto3" json:"election_interval,omitempty"`
+	// council_size is the number of governance wallets an election picks from
+	// the top contributors.
+	CouncilSize uint64 `protobuf:"varint,12,opt,name=council_size,json=councilSize,proto3" json:"council_size,omitempty"`
+	// min_candidate_balance is the EGV balance a registered candidate must hold
+	// at election time to be eligible.
+	MinCandidateBalance string `protobuf:"bytes,13,opt,name=min_candidate_balance,json=minCandidateBalance,proto3" json:"min_candidate_balance,omitempty"`
+	// max_consecutive_terms is the number of consecutive councils an address
+	// may sit on before it has to sit out an election. Zero means no limit.
+	MaxConsecutiveTerms uint64 `protobuf:"varint,14,opt,name=max_consecutive_terms,json=maxConsecutiveTerms,proto3" json:"max_consecutive_terms,omitempty"`
+	// min_governance_set_size is the smallest number of governance wallets
+	// predefined_wallets may hold.
+	MinGovernanceSetSize uint64 `protobuf:"varint,15,opt,name=min_governance_set_size,json=minGovernanceSetSize,proto3" json:"min_governance_set_size,omitempty"`
+	// max_governance_set_size is the largest number of governance wallets
+	// predefined_wallets may hold.
+	MaxGovernanceSetSize uint64 `protobuf:"varint,16,opt,name=max_governance_set_size,json=maxGovernanceSetSize,proto3" json:"max_governance_set_size,omitempty"`
+	// max_governance_set_change is the largest fraction of the current
+	// governance wallets a single params update may replace.
+	MaxGovernanceSetChange string `protobuf:"bytes,17,opt,name=max_governance_set_change,json=maxGovernanceSetChange,proto3" json:"max_governance_set_change,omitempty"`
+	// contribution_retention_epochs is the number of most recent epochs, the
+	// current one included, whose per-epoch transaction counts are kept.
+	// Older epochs are pruned at the end of the block. Zero keeps every epoch.
+	ContributionRetentionEpochs uint64 `protobuf:"varint,18,opt,name=contribution_retention_epochs,json=contributionRetentionEpochs,proto3" json:"contribution_retention_epochs,omitempty"`
+	// contribution_score_mode selects what rewards and voting weights are
+	// computed from: "lifetime" transaction counts or the "decayed"
+	// contribution score.
+	ContributionScoreMode string `protobuf:"bytes,19,opt,name=contribution_score_mode,json=contributionScoreMode,proto3" json:"contribution_score_mode,omitempty"`
+	// score_half_life_epochs is the number of epochs after which a
+	// transaction's share of the decayed contribution score has halved.
+	ScoreHalfLifeEpochs uint64 `protobuf:"varint,20,opt,name=score_half_life_epochs,json=scoreHalfLifeEpochs,proto3" json:"score_half_life_epochs,omitempty"`
+	// max_share_per_address is the largest fraction of a distribution's reward
+	// pool a single governance wallet may receive. The excess is shared out
+	// among the other wallets in proportion to their contribution.
+	MaxSharePerAddress string `protobuf:"bytes,21,opt,name=max_share_per_address,json=maxSharePerAddress,proto3" json:"max_share_per_address,omitempty"`
+	// reward_vesting_fraction is the fraction of each distributed reward that
+	// vests instead of being paid out immediately.
+	RewardVestingFraction string `protobuf:"bytes,22,opt,name=reward_vesting_fraction,json=rewardVestingFraction,proto3" json:"reward_vesting_fraction,omitempty"`
+	// reward_vesting_duration is the number of blocks over which the vesting
+	// part of a reward is released linearly.
+	RewardVestingDuration uint64 `protobuf:"varint,23,opt,name=reward_vesting_duration,json=rewardVestingDuration,proto3" json:"reward_vesting_duration,omitempty"`
+	// fee_share is the fraction of the fee collector balance moved into the
+	// rewards pool every block, before x/mint adds the staking inflation and
+	// x/distribution allocates it, so only fees are shared. The pool is split
+	// among contributors at each epoch boundary.
+	FeeShare string `protobuf:"bytes,24,opt,name=fee_share,json=feeShare,proto3" json:"fee_share,omitempty"`
+	// max_supply caps the EGV supply. Inflation is minted only up to the cap.
+	// Zero means no cap.
+	MaxSupply string `protobuf:"bytes,25,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// burn_fraction is the fraction of the EGV in each distribution's reward
+	// pool that is burned instead of paid out.
+	BurnFraction string `protobuf:"bytes,26,opt,name=burn_fraction,json=burnFraction,proto3" json:"burn_fraction,omitempty"`
+	// inflation_schedule selects how the effective inflation rate evolves with
+	// the epoch: "constant", "stepwise", "halving" or "exponential_decay".
+	// inflation_rate is the rate the schedule starts from.
+	InflationSchedule string `protobuf:"bytes,27,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule,omitempty"`
+	// inflation_steps are the rates of the stepwise schedule, each applying
+	// from its start epoch on, in increasing start epoch order.
+	InflationSteps []InflationStep `protobuf:"bytes,28,rep,name=inflation_steps,json=inflationSteps,proto3" json:"inflation_steps"`
+	// inflation_halving_epochs is the number of epochs after which the halving
+	// schedule halves the rate.
+	InflationHalvingEpochs uint64 `protobuf:"varint,29,opt,name=inflation_halving_epochs,json=inflationHalvingEpochs,proto3" json:"inflation_halving_epochs,omitempty"`
+	// inflation_decay_rate is the fraction of the distance to
+	// inflation_floor the exponential decay schedule removes every epoch.
+	InflationDecayRate string `protobuf:"bytes,30,opt,name=inflation_decay_rate,json=inflationDecayRate,proto3" json:"inflation_decay_rate,omitempty"`
+	// inflation_floor is the rate the exponential decay schedule approaches.
+	InflationFloor string `protobuf:"bytes,31,opt,name=inflation_floor,json=inflationFloor,proto3" json:"inflation_floor,omitempty"`
+	// soulbound_egv blocks EGV transfers between user accounts. Transfers from
+	// or to module accounts, such as reward payouts and governance deposits,
+	// stay allowed.
+	SoulboundEgv bool `protobuf:"varint,32,opt,name=soulbound_egv,json=soulboundEgv,proto3" json:"soulbound_egv,omitempty"`
+	// egv_transfer_allowlist are the addresses that can receive EGV from user
+	// accounts while soulbound_egv is set.
+	EgvTransferAllowlist []string `protobuf:"bytes,33,rep,name=egv_transfer_allowlist,json=egvTransferAllowlist,proto3" json:"egv_transfer_allowlist,omitempty"`
+	// reward_denom is the denom of the governance token the module mints,
+	// distributes and burns. It is set at genesis and cannot be changed by a
+	// params update.
+	RewardDenom string `protobuf:"bytes,34,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	// max_missed_governance_actions is the number of x/gov proposals in a row
+	// a governance wallet can miss before inactivity_penalty applies. Zero
+	// disables inactivity penalties.
+	MaxMissedGovernanceActions uint64 `protobuf:"varint,35,opt,name=max_missed_governance_actions,json=maxMissedGovernanceActions,proto3" json:"max_missed_governance_actions,omitempty"`
+	// inactivity_penalty is the penalty for an inactive governance wallet:
+	// "reward_reduction", "suspension" or "removal".
+	InactivityPenalty string `protobuf:"bytes,36,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	// inactivity_reward_reduction is the fraction of its reward share a wallet
+	// loses under the reward_reduction penalty.
+	InactivityRewardReduction string `protobuf:"bytes,37,opt,name=inactivity_reward_reduction,json=inactivityRewardReduction,proto3" json:"inactivity_reward_reduction,omitempty"`
+	// inactivity_penalty_epochs is the number of epochs a reward reduction or
+	// suspension lasts.
+	InactivityPenaltyEpochs uint64 `protobuf:"varint,38,opt,name=inactivity_penalty_epochs,json=inactivityPenaltyEpochs,proto3" json:"inactivity_penalty_epochs,omitempty"`
+	// lock_unbonding_period is the number of blocks unlocked EGV stays in the
+	// module before it is returned to its owner.
+	LockUnbondingPeriod uint64 `protobuf:"varint,39,opt,name=lock_unbonding_period,json=lockUnbondingPeriod,proto3" json:"lock_unbonding_period,omitempty"`
+	// lock_boost_curve is how locked EGV boosts contribution weight: "linear"
+	// or "sqrt" in the locked amount up to lock_boost_saturation.
+	LockBoostCurve string `protobuf:"bytes,40,opt,name=lock_boost_curve,json=lockBoostCurve,proto3" json:"lock_boost_curve,omitempty"`
+	// lock_boost_max is the multiplier applied to the contribution of an
+	// address that locked lock_boost_saturation or more. One disables the
+	// boost.
+	LockBoostMax string `protobuf:"bytes,41,opt,name=lock_boost_max,json=lockBoostMax,proto3" json:"lock_boost_max,omitempty"`
+	// lock_boost_saturation is the locked amount, in base units of
+	// reward_denom, that earns lock_boost_max. At genesis it may not exceed the
+	// initial EGV supply.
+	LockBoostSaturation string `protobuf:"bytes,42,opt,name=lock_boost_saturation,json=lockBoostSaturation,proto3" json:"lock_boost_saturation,omitempty"`
+	// min_lock_amount is the smallest amount, in base units of reward_denom,
+	// an address may keep locked. Unlocks must leave either nothing or at
+	// least this much.
+	MinLockAmount string `protobuf:"bytes,43,opt,name=min_lock_amount,json=minLockAmount,proto3" json:"min_lock_amount,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -77,138 +209,2686 @@ func (m *Params) GetPredefinedWallets() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "zenoda.rewards.Params")
+func (m *Params) GetMinInflationRate() string {
+	if m != nil {
+		return m.MinInflationRate
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("zenoda/rewards/params.proto", fileDescriptor_b5e9f45fecde47c5) }
+func (m *Params) GetMaxInflationRate() string {
+	if m != nil {
+		return m.MaxInflationRate
+	}
+	return ""
+}
 
-var fileDescriptor_b5e9f45fecde47c5 = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xae, 0x4a, 0xcd, 0xcb,
-	0x4f, 0x49, 0xd4, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xea, 0x41, 0x25, 0xa5, 0x04,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e,
-	0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x1a, 0x18, 0xb9, 0xd8, 0x02, 0xc0, 0x26, 0x09, 0xa9,
-	0x72, 0xf1, 0x65, 0xe6, 0xa5, 0xe5, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0xc5, 0x17, 0x25, 0x96, 0xa4,
-	0x4a, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xf1, 0xc2, 0x45, 0x83, 0x12, 0x4b, 0x52, 0x85, 0x74,
-	0xb9, 0x84, 0x0a, 0x8a, 0x52, 0x53, 0x52, 0xd3, 0x32, 0xf3, 0x52, 0x53, 0xe2, 0xcb, 0x13, 0x73,
-	0x72, 0x52, 0x4b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0x38, 0x83, 0x04, 0x11, 0x32, 0xe1, 0x10,
-	0x09, 0x2b, 0x85, 0x17, 0x0b, 0xe4, 0x19, 0xbb, 0x9e, 0x6f, 0xd0, 0x12, 0x87, 0xba, 0xbf, 0x02,
-	0xee, 0x03, 0x88, 0xbd, 0x4e, 0x06, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x25, 0x86, 0xa1, 0xa5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x76, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x39, 0x29, 0x14, 0x66, 0x13, 0x01, 0x00, 0x00,
+func (m *Params) GetMaxInflationRateChange() string {
+	if m != nil {
+		return m.MaxInflationRateChange
+	}
+	return ""
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *Params) GetParamChangeDelay() uint64 {
+	if m != nil {
+		return m.ParamChangeDelay
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *Params) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
 	}
-	if this.InflationRate != that1.InflationRate {
-		return false
+	return ""
+}
+
+func (m *Params) GetVotingWeightFunction() string {
+	if m != nil {
+		return m.VotingWeightFunction
 	}
-	if len(this.PredefinedWallets) != len(that1.PredefinedWallets) {
-		return false
+	return ""
+}
+
+func (m *Params) GetVotingWeightCap() string {
+	if m != nil {
+		return m.VotingWeightCap
 	}
-	for i := range this.PredefinedWallets {
-		if this.PredefinedWallets[i] != that1.PredefinedWallets[i] {
-			return false
-		}
+	return ""
+}
+
+func (m *Params) GetElectionInterval() uint64 {
+	if m != nil {
+		return m.ElectionInterval
 	}
-	return true
+	return 0
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *Params) GetCouncilSize() uint64 {
+	if m != nil {
+		return m.CouncilSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMinCandidateBalance() string {
+	if m != nil {
+		return m.MinCandidateBalance
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PredefinedWallets) > 0 {
-		for iNdEx := len(m.PredefinedWallets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PredefinedWallets[iNdEx])
-			copy(dAtA[i:], m.PredefinedWallets[iNdEx])
-			i = encodeVarintParams(dAtA, i, uint64(len(m.PredefinedWallets[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *Params) GetMaxConsecutiveTerms() uint64 {
+	if m != nil {
+		return m.MaxConsecutiveTerms
 	}
-	if len(m.InflationRate) > 0 {
-		i -= len(m.InflationRate)
-		copy(dAtA[i:], m.InflationRate)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationRate)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetMinGovernanceSetSize() uint64 {
+	if m != nil {
+		return m.MinGovernanceSetSize
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Params) GetMaxGovernanceSetSize() uint64 {
+	if m != nil {
+		return m.MaxGovernanceSetSize
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Params) GetMaxGovernanceSetChange() string {
+	if m != nil {
+		return m.MaxGovernanceSetChange
 	}
-	var l int
-	_ = l
-	l = len(m.InflationRate)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	return ""
+}
+
+func (m *Params) GetContributionRetentionEpochs() uint64 {
+	if m != nil {
+		return m.ContributionRetentionEpochs
 	}
-	if len(m.PredefinedWallets) > 0 {
-		for _, s := range m.PredefinedWallets {
-			l = len(s)
-			n += 1 + l + sovParams(uint64(l))
+	return 0
+}
+
+func (m *Params) GetContributionScoreMode() string {
+	if m != nil {
+		return m.ContributionScoreMode
+	}
+	return ""
+}
+
+func (m *Params) GetScoreHalfLifeEpochs() uint64 {
+	if m != nil {
+		return m.ScoreHalfLifeEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSharePerAddress() string {
+	if m != nil {
+		return m.MaxSharePerAddress
+	}
+	return ""
+}
+
+func (m *Params) GetRewardVestingFraction() string {
+	if m != nil {
+		return m.RewardVestingFraction
+	}
+	return ""
+}
+
+func (m *Params) GetRewardVestingDuration() uint64 {
+	if m != nil {
+		return m.RewardVestingDuration
+	}
+	return 0
+}
+
+func (m *Params) GetFeeShare() string {
+	if m != nil {
+		return m.FeeShare
+	}
+	return ""
+}
+
+func (m *Params) GetMaxSupply() string {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return ""
+}
+
+func (m *Params) GetBurnFraction() string {
+	if m != nil {
+		return m.BurnFraction
+	}
+	return ""
+}
+
+func (m *Params) GetInflationSchedule() string {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return ""
+}
+
+func (m *Params) GetInflationSteps() []InflationStep {
+	if m != nil {
+		return m.InflationSteps
+	}
+	return nil
+}
+
+func (m *Params) GetInflationHalvingEpochs() uint64 {
+	if m != nil {
+		return m.InflationHalvingEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetInflationDecayRate() string {
+	if m != nil {
+		return m.InflationDecayRate
+	}
+	return ""
+}
+
+func (m *Params) GetInflationFloor() string {
+	if m != nil {
+		return m.InflationFloor
+	}
+	return ""
+}
+
+func (m *Params) GetSoulboundEgv() bool {
+	if m != nil {
+		return m.SoulboundEgv
+	}
+	return false
+}
+
+func (m *Params) GetEgvTransferAllowlist() []string {
+	if m != nil {
+		return m.EgvTransferAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
+	}
+	return ""
+}
+
+func (m *Params) GetMaxMissedGovernanceActions() uint64 {
+	if m != nil {
+		return m.MaxMissedGovernanceActions
+	}
+	return 0
+}
+
+func (m *Params) GetInactivityPenalty() string {
+	if m != nil {
+		return m.InactivityPenalty
+	}
+	return ""
+}
+
+func (m *Params) GetInactivityRewardReduction() string {
+	if m != nil {
+		return m.InactivityRewardReduction
+	}
+	return ""
+}
+
+func (m *Params) GetInactivityPenaltyEpochs() uint64 {
+	if m != nil {
+		return m.InactivityPenaltyEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetLockUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.LockUnbondingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetLockBoostCurve() string {
+	if m != nil {
+		return m.LockBoostCurve
+	}
+	return ""
+}
+
+func (m *Params) GetLockBoostMax() string {
+	if m != nil {
+		return m.LockBoostMax
+	}
+	return ""
+}
+
+func (m *Params) GetLockBoostSaturation() string {
+	if m != nil {
+		return m.LockBoostSaturation
+	}
+	return ""
+}
+
+func (m *Params) GetMinLockAmount() string {
+	if m != nil {
+		return m.MinLockAmount
+	}
+	return ""
+}
+
+// PendingInflationChange is an inflation rate or schedule change waiting for
+// the next epoch boundary to take effect.
+type PendingInflationChange struct {
+	// inflation_rate is the rate that will become active.
+	InflationRate string `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+	// activation_height is the block height at which the rate takes effect.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// inflation_schedule is the schedule that will become active, together
+	// with the settings below. Empty keeps the active schedule.
+	InflationSchedule string `protobuf:"bytes,3,opt,name=inflation_schedule,json=inflationSchedule,proto3" json:"inflation_schedule,omitempty"`
+	// inflation_steps are the steps of the stepwise schedule that will become
+	// active.
+	InflationSteps []InflationStep `protobuf:"bytes,4,rep,name=inflation_steps,json=inflationSteps,proto3" json:"inflation_steps"`
+	// inflation_halving_epochs is the halving period that will become active.
+	InflationHalvingEpochs uint64 `protobuf:"varint,5,opt,name=inflation_halving_epochs,json=inflationHalvingEpochs,proto3" json:"inflation_halving_epochs,omitempty"`
+	// inflation_decay_rate is the decay rate that will become active.
+	InflationDecayRate string `protobuf:"bytes,6,opt,name=inflation_decay_rate,json=inflationDecayRate,proto3" json:"inflation_decay_rate,omitempty"`
+	// inflation_floor is the decay floor that will become active.
+	InflationFloor string `protobuf:"bytes,7,opt,name=inflation_floor,json=inflationFloor,proto3" json:"inflation_floor,omitempty"`
+}
+
+func (m *PendingInflationChange) Reset()         { *m = PendingInflationChange{} }
+func (m *PendingInflationChange) String() string { return proto.CompactTextString(m) }
+func (*PendingInflationChange) ProtoMessage()    {}
+func (*PendingInflationChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5e9f45fecde47c5, []int{1}
+}
+func (m *PendingInflationChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingInflationChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingInflationChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingInflationChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingInflationChange.Merge(m, src)
+}
+func (m *PendingInflationChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingInflationChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingInflationChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingInflationChange proto.InternalMessageInfo
+
+func (m *PendingInflationChange) GetInflationRate() string {
+	if m != nil {
+		return m.InflationRate
+	}
+	return ""
+}
+
+func (m *PendingInflationChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *PendingInflationChange) GetInflationSchedule() string {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return ""
+}
+
+func (m *PendingInflationChange) GetInflationSteps() []InflationStep {
+	if m != nil {
+		return m.InflationSteps
+	}
+	return nil
+}
+
+func (m *PendingInflationChange) GetInflationHalvingEpochs() uint64 {
+	if m != nil {
+		return m.InflationHalvingEpochs
+	}
+	return 0
+}
+
+func (m *PendingInflationChange) GetInflationDecayRate() string {
+	if m != nil {
+		return m.InflationDecayRate
+	}
+	return ""
+}
+
+func (m *PendingInflationChange) GetInflationFloor() string {
+	if m != nil {
+		return m.InflationFloor
+	}
+	return ""
+}
+
+// InflationStep is the inflation rate of a stepwise schedule from an epoch
+// on.
+type InflationStep struct {
+	// start_epoch is the first epoch the rate applies to.
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// inflation_rate is the rate applying from start_epoch on.
+	InflationRate string `protobuf:"bytes,2,opt,name=inflation_rate,json=inflationRate,proto3" json:"inflation_rate,omitempty"`
+}
+
+func (m *InflationStep) Reset()         { *m = InflationStep{} }
+func (m *InflationStep) String() string { return proto.CompactTextString(m) }
+func (*InflationStep) ProtoMessage()    {}
+func (*InflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5e9f45fecde47c5, []int{2}
+}
+func (m *InflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationStep.Merge(m, src)
+}
+func (m *InflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationStep proto.InternalMessageInfo
+
+func (m *InflationStep) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *InflationStep) GetInflationRate() string {
+	if m != nil {
+		return m.InflationRate
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "zenoda.rewards.Params")
+	proto.RegisterType((*PendingInflationChange)(nil), "zenoda.rewards.PendingInflationChange")
+	proto.RegisterType((*InflationStep)(nil), "zenoda.rewards.InflationStep")
+}
+
+func init() { proto.RegisterFile("zenoda/rewards/params.proto", fileDescriptor_b5e9f45fecde47c5) }
+
+var fileDescriptor_b5e9f45fecde47c5 = []byte{
+	// 1231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4b, 0x6f, 0x1b, 0x37,
+	0x17, 0xb5, 0x62, 0xc7, 0x89, 0xe9, 0xa7, 0x18, 0x5b, 0xa6, 0xed, 0xcf, 0xb2, 0xe2, 0xbc, 0xf4,
+	0x39, 0x8d, 0x9d, 0x26, 0x6d, 0xd1, 0x66, 0x51, 0xc0, 0x8f, 0xa4, 0x09, 0x90, 0x00, 0xae, 0x94,
+	0x36, 0x40, 0x81, 0x62, 0x40, 0xcd, 0x5c, 0x49, 0x83, 0xcc, 0x90, 0x03, 0x92, 0x33, 0x96, 0xb3,
+	0xed, 0xae, 0xab, 0xfe, 0x84, 0x2e, 0xbb, 0xcc, 0xcf, 0xc8, 0x32, 0xcb, 0xae, 0x8a, 0x22, 0x5e,
+	0xa4, 0xe8, 0xaf, 0x28, 0x78, 0x39, 0x1a, 0x49, 0xb6, 0x0b, 0x34, 0x05, 0xba, 0x31, 0x06, 0xe7,
+	0x9c, 0x4b, 0x1e, 0xdf, 0x97, 0x48, 0xd6, 0x5e, 0x81, 0x90, 0x01, 0xdf, 0x51, 0x70, 0xc4, 0x55,
+	0xa0, 0x77, 0x12, 0xae, 0x78, 0xac, 0xb7, 0x13, 0x25, 0x8d, 0xa4, 0x73, 0x8e, 0xdc, 0xce, 0xc9,
+	0xd5, 0x32, 0x8f, 0x43, 0x21, 0x77, 0xf0, 0xaf, 0x93, 0xac, 0x2e, 0x76, 0x64, 0x47, 0xe2, 0xe7,
+	0x8e, 0xfd, 0x72, 0xe8, 0xe6, 0x9f, 0x0b, 0x64, 0xf2, 0x10, 0x4f, 0xa2, 0x37, 0xc8, 0x5c, 0x28,
+	0xda, 0x11, 0x37, 0xa1, 0x14, 0x9e, 0xe2, 0x06, 0x58, 0xa9, 0x56, 0xaa, 0x4f, 0x35, 0x66, 0x0b,
+	0xb4, 0xc1, 0x0d, 0xd0, 0x3b, 0x84, 0x26, 0x0a, 0x02, 0x68, 0x87, 0x02, 0x02, 0xef, 0x88, 0x47,
+	0x11, 0x18, 0xcd, 0x2e, 0xd4, 0xc6, 0xeb, 0x53, 0x8d, 0xf2, 0x80, 0x79, 0xe1, 0x08, 0xfa, 0x11,
+	0xa1, 0x71, 0x28, 0xbc, 0x53, 0x27, 0x8f, 0xe3, 0xc9, 0x0b, 0x71, 0x28, 0x9e, 0x8c, 0x1c, 0x6e,
+	0xd5, 0xbc, 0x77, 0x5a, 0x3d, 0x91, 0xab, 0x79, 0x6f, 0x54, 0xfd, 0x05, 0x59, 0x39, 0xab, 0xf6,
+	0xfc, 0x2e, 0x17, 0x1d, 0x60, 0x17, 0x31, 0xa8, 0x72, 0x3a, 0x68, 0x1f, 0x59, 0x7a, 0x95, 0xcc,
+	0x40, 0x22, 0xfd, 0xae, 0x17, 0x81, 0xe8, 0x98, 0x2e, 0x9b, 0xac, 0x95, 0xea, 0x13, 0x8d, 0x69,
+	0xc4, 0x9e, 0x22, 0x64, 0xbd, 0x60, 0x8e, 0xf3, 0x03, 0xbd, 0x00, 0x22, 0x7e, 0xcc, 0x2e, 0xa1,
+	0x70, 0x01, 0x19, 0x77, 0xd6, 0x81, 0xc5, 0x6d, 0xf6, 0x32, 0x30, 0xd2, 0x33, 0x5d, 0x05, 0xba,
+	0x2b, 0xa3, 0x80, 0x5d, 0x76, 0xd9, 0xb3, 0xe8, 0xf3, 0x3e, 0x48, 0x3f, 0x21, 0x95, 0x4c, 0x9a,
+	0x50, 0x74, 0xbc, 0x23, 0x08, 0x3b, 0x5d, 0xe3, 0xb5, 0x53, 0xe1, 0x5b, 0x6f, 0x6c, 0x0a, 0xe5,
+	0x8b, 0x8e, 0x7d, 0x81, 0xe4, 0xa3, 0x9c, 0xa3, 0x5b, 0xa4, 0x3c, 0x1a, 0xe5, 0xf3, 0x84, 0x11,
+	0x0c, 0x98, 0x1f, 0x0e, 0xd8, 0xe7, 0x09, 0xbd, 0x4d, 0xca, 0x10, 0x01, 0xc6, 0x79, 0xa1, 0x30,
+	0xa0, 0x32, 0x1e, 0xb1, 0x69, 0xe7, 0xba, 0x4f, 0x3c, 0xc9, 0x71, 0x9b, 0x06, 0x5f, 0xa6, 0xc2,
+	0x0f, 0x23, 0x4f, 0x87, 0xaf, 0x80, 0xcd, 0xb8, 0x34, 0xe4, 0x58, 0x33, 0x7c, 0x05, 0xf4, 0x1e,
+	0x59, 0xb2, 0x05, 0xf4, 0xb9, 0x08, 0xc2, 0xc0, 0xe6, 0xb7, 0xc5, 0x23, 0x2e, 0x7c, 0x60, 0xb3,
+	0x78, 0xff, 0x95, 0x38, 0x14, 0xfb, 0x7d, 0x6e, 0xcf, 0x51, 0x18, 0xc3, 0x7b, 0x9e, 0x2f, 0x85,
+	0x06, 0x3f, 0x35, 0x61, 0x06, 0x9e, 0x01, 0x15, 0x6b, 0x36, 0x87, 0xe7, 0x5f, 0x89, 0x79, 0x6f,
+	0x7f, 0xc0, 0x3d, 0xb7, 0x14, 0xfd, 0x94, 0x2c, 0xdb, 0x7b, 0x3a, 0x32, 0x03, 0x25, 0xec, 0x29,
+	0x9e, 0x06, 0xe3, 0x5c, 0xcd, 0x63, 0xd4, 0x62, 0x1c, 0x8a, 0xaf, 0x0a, 0xb6, 0x09, 0x06, 0xed,
+	0xd9, 0x30, 0xde, 0x3b, 0x37, 0x6c, 0x21, 0x0f, 0xe3, 0xbd, 0xb3, 0x61, 0x79, 0xeb, 0x9c, 0x0a,
+	0xcb, 0x5b, 0xa7, 0x5c, 0xb4, 0xce, 0x48, 0x60, 0xde, 0x3a, 0x7b, 0x64, 0xdd, 0x97, 0xc2, 0xa8,
+	0xb0, 0x95, 0xba, 0xa6, 0x03, 0x03, 0x02, 0xbf, 0xb0, 0x7b, 0x34, 0xa3, 0x78, 0xef, 0xda, 0xb0,
+	0xa8, 0xd1, 0xd7, 0x3c, 0x44, 0x09, 0xfd, 0x8c, 0x2c, 0x8f, 0x9c, 0xa1, 0x7d, 0xa9, 0xc0, 0x8b,
+	0x65, 0x00, 0xec, 0x0a, 0x5e, 0xbe, 0x34, 0x4c, 0x37, 0x2d, 0xfb, 0x4c, 0x06, 0x40, 0xef, 0x93,
+	0x8a, 0x93, 0x76, 0x79, 0xd4, 0xf6, 0xa2, 0xb0, 0x0d, 0xfd, 0x4b, 0x17, 0x5d, 0x66, 0x91, 0x7d,
+	0xcc, 0xa3, 0xf6, 0xd3, 0xb0, 0x0d, 0xf9, 0x65, 0x1f, 0xbb, 0x6a, 0xe8, 0x2e, 0x57, 0xe0, 0x25,
+	0xa0, 0x3c, 0x1e, 0x04, 0x0a, 0xb4, 0x66, 0x4b, 0x78, 0x95, 0x9d, 0xb8, 0xa6, 0xe5, 0x0e, 0x41,
+	0xed, 0x3a, 0xc6, 0xfa, 0x73, 0xab, 0xc4, 0xcb, 0x40, 0x63, 0xe3, 0xb5, 0x15, 0x77, 0x7d, 0x5a,
+	0x71, 0xfe, 0x1c, 0xfd, 0xad, 0x63, 0x1f, 0xe5, 0xe4, 0x39, 0x71, 0x41, 0xaa, 0x70, 0xf6, 0xd8,
+	0x32, 0x1a, 0x1c, 0x8d, 0x3b, 0xc8, 0x49, 0xba, 0x46, 0xa6, 0xda, 0x00, 0xce, 0x22, 0x63, 0x78,
+	0xc3, 0xe5, 0x36, 0x00, 0xda, 0xa2, 0xeb, 0x84, 0xa0, 0xff, 0x34, 0x49, 0xa2, 0x63, 0xb6, 0x82,
+	0xec, 0x94, 0x35, 0x8d, 0x00, 0xbd, 0x46, 0x66, 0x5b, 0xa9, 0x12, 0x03, 0x87, 0xab, 0xa8, 0x98,
+	0xb1, 0x60, 0x61, 0xec, 0x0e, 0xa1, 0x83, 0x35, 0xa1, 0xfd, 0x2e, 0x04, 0x69, 0x04, 0x6c, 0x0d,
+	0x95, 0xe5, 0x82, 0x69, 0xe6, 0x04, 0xfd, 0x9a, 0xcc, 0x0f, 0xc9, 0x0d, 0x24, 0x9a, 0xfd, 0xaf,
+	0x36, 0x5e, 0x9f, 0xbe, 0xb7, 0xbe, 0x3d, 0xba, 0x69, 0xb7, 0x8b, 0xe5, 0xd2, 0x34, 0x90, 0xec,
+	0x4d, 0xbd, 0xf9, 0x6d, 0x63, 0xec, 0x97, 0xf7, 0xaf, 0xb7, 0x4a, 0x8d, 0xb9, 0x70, 0x98, 0xd1,
+	0xf4, 0x73, 0xc2, 0x06, 0x47, 0x76, 0x79, 0x94, 0xd9, 0xec, 0xe4, 0xc5, 0x5b, 0xc7, 0xdc, 0x54,
+	0x0a, 0xfe, 0xb1, 0xa3, 0xf3, 0xfa, 0xdd, 0x25, 0x8b, 0x83, 0xc8, 0x00, 0x7c, 0x7e, 0xec, 0xd6,
+	0x62, 0xd5, 0x95, 0xaf, 0xe0, 0x0e, 0x2c, 0x85, 0x8b, 0xf1, 0xd6, 0xb0, 0xfd, 0x76, 0x24, 0xa5,
+	0x62, 0x1b, 0x28, 0x1e, 0x98, 0x7a, 0x64, 0x51, 0x9b, 0x3b, 0x2d, 0xd3, 0xa8, 0x25, 0x53, 0x11,
+	0x78, 0xd0, 0xc9, 0x58, 0xad, 0x56, 0xaa, 0x5f, 0x6e, 0xcc, 0x14, 0xe0, 0xc3, 0x4e, 0x66, 0x77,
+	0x16, 0x74, 0x32, 0xcf, 0x28, 0x2e, 0x74, 0xdb, 0xb6, 0x4f, 0x14, 0xc9, 0xa3, 0x28, 0xd4, 0x86,
+	0x5d, 0xc5, 0xad, 0xbf, 0x08, 0x9d, 0xec, 0x79, 0x4e, 0xee, 0xf6, 0x39, 0xbb, 0x5a, 0xf2, 0x56,
+	0x08, 0x40, 0xc8, 0x98, 0x6d, 0xa2, 0x81, 0x69, 0x87, 0x1d, 0x58, 0x88, 0xee, 0x92, 0x75, 0x5b,
+	0xd8, 0x38, 0xd4, 0x1a, 0x82, 0xe1, 0x59, 0x74, 0x45, 0xd3, 0xec, 0x1a, 0xe6, 0x65, 0x35, 0xe6,
+	0xbd, 0x67, 0xa8, 0x19, 0x8c, 0xe3, 0xae, 0x53, 0xb8, 0xba, 0x5a, 0x79, 0x16, 0x9a, 0x63, 0x2f,
+	0x01, 0xc1, 0x23, 0x73, 0xcc, 0xae, 0xf7, 0xeb, 0xda, 0x67, 0x0e, 0x1d, 0x41, 0xbf, 0x24, 0x6b,
+	0x43, 0xf2, 0xdc, 0x9f, 0x82, 0x20, 0x75, 0x9d, 0x73, 0x03, 0xe3, 0x56, 0x06, 0x92, 0x06, 0x2a,
+	0x1a, 0x7d, 0x01, 0x7d, 0x40, 0x56, 0xce, 0x5e, 0xd7, 0xaf, 0xe2, 0x4d, 0x74, 0xbb, 0x7c, 0xe6,
+	0xd6, 0xbc, 0x8c, 0xf7, 0xc8, 0x52, 0x24, 0xfd, 0x97, 0x5e, 0x2a, 0x5a, 0x52, 0x04, 0xb6, 0xfa,
+	0x09, 0xa8, 0x50, 0x06, 0xec, 0x96, 0x1b, 0x5d, 0x4b, 0x7e, 0xd3, 0xe7, 0x0e, 0x91, 0xa2, 0x75,
+	0xb2, 0x80, 0x31, 0x2d, 0x29, 0xb5, 0xf1, 0xfc, 0x54, 0x65, 0xc0, 0xea, 0xae, 0x92, 0x16, 0xdf,
+	0xb3, 0xf0, 0xbe, 0x45, 0xe9, 0x75, 0x32, 0x37, 0xa4, 0x8c, 0x79, 0x8f, 0xfd, 0xdf, 0x8d, 0x41,
+	0xa1, 0x7b, 0xc6, 0x7b, 0x85, 0x07, 0xa7, 0xd2, 0xdc, 0xf4, 0xa7, 0x73, 0xcb, 0x2d, 0xf3, 0x42,
+	0xdc, 0x2c, 0x28, 0x7a, 0x93, 0xcc, 0xdb, 0xc5, 0x8c, 0x71, 0x3c, 0x96, 0xa9, 0x30, 0xec, 0xb6,
+	0xfb, 0x69, 0x8b, 0x43, 0xf1, 0x54, 0xfa, 0x2f, 0x77, 0x11, 0x7c, 0x50, 0xfb, 0xe3, 0xe7, 0x8d,
+	0xd2, 0x8f, 0xef, 0x5f, 0x6f, 0x2d, 0xe7, 0x2f, 0x95, 0x5e, 0xf1, 0x56, 0x71, 0x2f, 0x8c, 0xcd,
+	0x1f, 0xc6, 0x49, 0xe5, 0x10, 0xf0, 0xff, 0x2b, 0xc6, 0x26, 0x5f, 0xaa, 0xff, 0xf0, 0xf1, 0x71,
+	0x9b, 0x94, 0x31, 0xb9, 0xf9, 0x14, 0xe1, 0x8f, 0x1e, 0xbb, 0x50, 0x2b, 0xd5, 0xc7, 0x1b, 0x0b,
+	0x03, 0xe2, 0x31, 0xe2, 0x7f, 0x33, 0xf3, 0xe3, 0x1f, 0x30, 0xf3, 0x13, 0xff, 0xe1, 0xcc, 0x5f,
+	0xfc, 0x57, 0x33, 0x3f, 0xf9, 0x21, 0x33, 0x7f, 0xe9, 0xbc, 0x99, 0xdf, 0xfc, 0x9e, 0xcc, 0x8e,
+	0xfc, 0x03, 0x74, 0x83, 0x4c, 0x6b, 0xc3, 0x95, 0x71, 0xce, 0x30, 0xf1, 0x13, 0x0d, 0x82, 0x10,
+	0xba, 0x39, 0xa7, 0x38, 0x17, 0xce, 0x29, 0xce, 0x83, 0x09, 0xdb, 0x00, 0x7b, 0x77, 0xdf, 0xbc,
+	0xab, 0x96, 0xde, 0xbe, 0xab, 0x96, 0x7e, 0x7f, 0x57, 0x2d, 0xfd, 0x74, 0x52, 0x1d, 0x7b, 0x7b,
+	0x52, 0x1d, 0xfb, 0xf5, 0xa4, 0x3a, 0xf6, 0x5d, 0xe5, 0x4c, 0x5f, 0x98, 0xe3, 0x04, 0x74, 0x6b,
+	0x12, 0x9f, 0xa2, 0xf7, 0xff, 0x1a, 0x00, 0xd3, 0x1c, 0x14, 0xa5, 0xe2, 0x0a, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.InflationRate != that1.InflationRate {
+		return false
+	}
+	if len(this.PredefinedWallets) != len(that1.PredefinedWallets) {
+		return false
+	}
+	for i := range this.PredefinedWallets {
+		if this.PredefinedWallets[i] != that1.PredefinedWallets[i] {
+			return false
+		}
+	}
+	if this.MinInflationRate != that1.MinInflationRate {
+		return false
+	}
+	if this.MaxInflationRate != that1.MaxInflationRate {
+		return false
+	}
+	if this.MaxInflationRateChange != that1.MaxInflationRateChange {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if this.ParamChangeDelay != that1.ParamChangeDelay {
+		return false
+	}
+	if this.VetoThreshold != that1.VetoThreshold {
+		return false
+	}
+	if this.VotingWeightFunction != that1.VotingWeightFunction {
+		return false
+	}
+	if this.VotingWeightCap != that1.VotingWeightCap {
+		return false
+	}
+	if this.ElectionInterval != that1.ElectionInterval {
+		return false
+	}
+	if this.CouncilSize != that1.CouncilSize {
+		return false
+	}
+	if this.MinCandidateBalance != that1.MinCandidateBalance {
+		return false
+	}
+	if this.MaxConsecutiveTerms != that1.MaxConsecutiveTerms {
+		return false
+	}
+	if this.MinGovernanceSetSize != that1.MinGovernanceSetSize {
+		return false
+	}
+	if this.MaxGovernanceSetSize != that1.MaxGovernanceSetSize {
+		return false
+	}
+	if this.MaxGovernanceSetChange != that1.MaxGovernanceSetChange {
+		return false
+	}
+	if this.ContributionRetentionEpochs != that1.ContributionRetentionEpochs {
+		return false
+	}
+	if this.ContributionScoreMode != that1.ContributionScoreMode {
+		return false
+	}
+	if this.ScoreHalfLifeEpochs != that1.ScoreHalfLifeEpochs {
+		return false
+	}
+	if this.MaxSharePerAddress != that1.MaxSharePerAddress {
+		return false
+	}
+	if this.RewardVestingFraction != that1.RewardVestingFraction {
+		return false
+	}
+	if this.RewardVestingDuration != that1.RewardVestingDuration {
+		return false
+	}
+	if this.FeeShare != that1.FeeShare {
+		return false
+	}
+	if this.MaxSupply != that1.MaxSupply {
+		return false
+	}
+	if this.BurnFraction != that1.BurnFraction {
+		return false
+	}
+	if this.InflationSchedule != that1.InflationSchedule {
+		return false
+	}
+	if len(this.InflationSteps) != len(that1.InflationSteps) {
+		return false
+	}
+	for i := range this.InflationSteps {
+		if !this.InflationSteps[i].Equal(&that1.InflationSteps[i]) {
+			return false
+		}
+	}
+	if this.InflationHalvingEpochs != that1.InflationHalvingEpochs {
+		return false
+	}
+	if this.InflationDecayRate != that1.InflationDecayRate {
+		return false
+	}
+	if this.InflationFloor != that1.InflationFloor {
+		return false
+	}
+	if this.SoulboundEgv != that1.SoulboundEgv {
+		return false
+	}
+	if len(this.EgvTransferAllowlist) != len(that1.EgvTransferAllowlist) {
+		return false
+	}
+	for i := range this.EgvTransferAllowlist {
+		if this.EgvTransferAllowlist[i] != that1.EgvTransferAllowlist[i] {
+			return false
+		}
+	}
+	if this.RewardDenom != that1.RewardDenom {
+		return false
+	}
+	if this.MaxMissedGovernanceActions != that1.MaxMissedGovernanceActions {
+		return false
+	}
+	if this.InactivityPenalty != that1.InactivityPenalty {
+		return false
+	}
+	if this.InactivityRewardReduction != that1.InactivityRewardReduction {
+		return false
+	}
+	if this.InactivityPenaltyEpochs != that1.InactivityPenaltyEpochs {
+		return false
+	}
+	if this.LockUnbondingPeriod != that1.LockUnbondingPeriod {
+		return false
+	}
+	if this.LockBoostCurve != that1.LockBoostCurve {
+		return false
+	}
+	if this.LockBoostMax != that1.LockBoostMax {
+		return false
+	}
+	if this.LockBoostSaturation != that1.LockBoostSaturation {
+		return false
+	}
+	if this.MinLockAmount != that1.MinLockAmount {
+		return false
+	}
+	return true
+}
+func (this *InflationStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InflationStep)
+	if !ok {
+		that2, ok := that.(InflationStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartEpoch != that1.StartEpoch {
+		return false
+	}
+	if this.InflationRate != that1.InflationRate {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinLockAmount) > 0 {
+		i -= len(m.MinLockAmount)
+		copy(dAtA[i:], m.MinLockAmount)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinLockAmount)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.LockBoostSaturation) > 0 {
+		i -= len(m.LockBoostSaturation)
+		copy(dAtA[i:], m.LockBoostSaturation)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LockBoostSaturation)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.LockBoostMax) > 0 {
+		i -= len(m.LockBoostMax)
+		copy(dAtA[i:], m.LockBoostMax)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LockBoostMax)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.LockBoostCurve) > 0 {
+		i -= len(m.LockBoostCurve)
+		copy(dAtA[i:], m.LockBoostCurve)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.LockBoostCurve)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.LockUnbondingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LockUnbondingPeriod))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.InactivityPenaltyEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InactivityPenaltyEpochs))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.InactivityRewardReduction) > 0 {
+		i -= len(m.InactivityRewardReduction)
+		copy(dAtA[i:], m.InactivityRewardReduction)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InactivityRewardReduction)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.InactivityPenalty) > 0 {
+		i -= len(m.InactivityPenalty)
+		copy(dAtA[i:], m.InactivityPenalty)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InactivityPenalty)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.MaxMissedGovernanceActions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMissedGovernanceActions))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.EgvTransferAllowlist) > 0 {
+		for iNdEx := len(m.EgvTransferAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EgvTransferAllowlist[iNdEx])
+			copy(dAtA[i:], m.EgvTransferAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.EgvTransferAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.SoulboundEgv {
+		i--
+		if m.SoulboundEgv {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.InflationFloor) > 0 {
+		i -= len(m.InflationFloor)
+		copy(dAtA[i:], m.InflationFloor)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationFloor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.InflationDecayRate) > 0 {
+		i -= len(m.InflationDecayRate)
+		copy(dAtA[i:], m.InflationDecayRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationDecayRate)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.InflationHalvingEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InflationHalvingEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if len(m.InflationSteps) > 0 {
+		for iNdEx := len(m.InflationSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.InflationSchedule) > 0 {
+		i -= len(m.InflationSchedule)
+		copy(dAtA[i:], m.InflationSchedule)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationSchedule)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.BurnFraction) > 0 {
+		i -= len(m.BurnFraction)
+		copy(dAtA[i:], m.BurnFraction)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BurnFraction)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.MaxSupply) > 0 {
+		i -= len(m.MaxSupply)
+		copy(dAtA[i:], m.MaxSupply)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxSupply)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.FeeShare) > 0 {
+		i -= len(m.FeeShare)
+		copy(dAtA[i:], m.FeeShare)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.FeeShare)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.RewardVestingDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RewardVestingDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.RewardVestingFraction) > 0 {
+		i -= len(m.RewardVestingFraction)
+		copy(dAtA[i:], m.RewardVestingFraction)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RewardVestingFraction)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.MaxSharePerAddress) > 0 {
+		i -= len(m.MaxSharePerAddress)
+		copy(dAtA[i:], m.MaxSharePerAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxSharePerAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.ScoreHalfLifeEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ScoreHalfLifeEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.ContributionScoreMode) > 0 {
+		i -= len(m.ContributionScoreMode)
+		copy(dAtA[i:], m.ContributionScoreMode)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ContributionScoreMode)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.ContributionRetentionEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ContributionRetentionEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.MaxGovernanceSetChange) > 0 {
+		i -= len(m.MaxGovernanceSetChange)
+		copy(dAtA[i:], m.MaxGovernanceSetChange)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxGovernanceSetChange)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxGovernanceSetSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGovernanceSetSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinGovernanceSetSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinGovernanceSetSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxConsecutiveTerms != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxConsecutiveTerms))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.MinCandidateBalance) > 0 {
+		i -= len(m.MinCandidateBalance)
+		copy(dAtA[i:], m.MinCandidateBalance)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinCandidateBalance)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.CouncilSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CouncilSize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ElectionInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ElectionInterval))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.VotingWeightCap) > 0 {
+		i -= len(m.VotingWeightCap)
+		copy(dAtA[i:], m.VotingWeightCap)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VotingWeightCap)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.VotingWeightFunction) > 0 {
+		i -= len(m.VotingWeightFunction)
+		copy(dAtA[i:], m.VotingWeightFunction)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VotingWeightFunction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ParamChangeDelay != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ParamChangeDelay))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MaxInflationRateChange) > 0 {
+		i -= len(m.MaxInflationRateChange)
+		copy(dAtA[i:], m.MaxInflationRateChange)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxInflationRateChange)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MaxInflationRate) > 0 {
+		i -= len(m.MaxInflationRate)
+		copy(dAtA[i:], m.MaxInflationRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxInflationRate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinInflationRate) > 0 {
+		i -= len(m.MinInflationRate)
+		copy(dAtA[i:], m.MinInflationRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinInflationRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PredefinedWallets) > 0 {
+		for iNdEx := len(m.PredefinedWallets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PredefinedWallets[iNdEx])
+			copy(dAtA[i:], m.PredefinedWallets[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PredefinedWallets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.InflationRate) > 0 {
+		i -= len(m.InflationRate)
+		copy(dAtA[i:], m.InflationRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationRate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingInflationChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingInflationChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingInflationChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InflationFloor) > 0 {
+		i -= len(m.InflationFloor)
+		copy(dAtA[i:], m.InflationFloor)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationFloor)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InflationDecayRate) > 0 {
+		i -= len(m.InflationDecayRate)
+		copy(dAtA[i:], m.InflationDecayRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationDecayRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.InflationHalvingEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InflationHalvingEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InflationSteps) > 0 {
+		for iNdEx := len(m.InflationSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InflationSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InflationSchedule) > 0 {
+		i -= len(m.InflationSchedule)
+		copy(dAtA[i:], m.InflationSchedule)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationSchedule)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InflationRate) > 0 {
+		i -= len(m.InflationRate)
+		copy(dAtA[i:], m.InflationRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationRate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InflationStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InflationRate) > 0 {
+		i -= len(m.InflationRate)
+		copy(dAtA[i:], m.InflationRate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.InflationRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InflationRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.PredefinedWallets) > 0 {
+		for _, s := range m.PredefinedWallets {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.MinInflationRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MaxInflationRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.MaxInflationRateChange)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovParams(uint64(m.EpochLength))
+	}
+	if m.ParamChangeDelay != 0 {
+		n += 1 + sovParams(uint64(m.ParamChangeDelay))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.VotingWeightFunction)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.VotingWeightCap)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ElectionInterval != 0 {
+		n += 1 + sovParams(uint64(m.ElectionInterval))
+	}
+	if m.CouncilSize != 0 {
+		n += 1 + sovParams(uint64(m.CouncilSize))
+	}
+	l = len(m.MinCandidateBalance)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxConsecutiveTerms != 0 {
+		n += 1 + sovParams(uint64(m.MaxConsecutiveTerms))
+	}
+	if m.MinGovernanceSetSize != 0 {
+		n += 1 + sovParams(uint64(m.MinGovernanceSetSize))
+	}
+	if m.MaxGovernanceSetSize != 0 {
+		n += 2 + sovParams(uint64(m.MaxGovernanceSetSize))
+	}
+	l = len(m.MaxGovernanceSetChange)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.ContributionRetentionEpochs != 0 {
+		n += 2 + sovParams(uint64(m.ContributionRetentionEpochs))
+	}
+	l = len(m.ContributionScoreMode)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.ScoreHalfLifeEpochs != 0 {
+		n += 2 + sovParams(uint64(m.ScoreHalfLifeEpochs))
+	}
+	l = len(m.MaxSharePerAddress)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.RewardVestingFraction)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.RewardVestingDuration != 0 {
+		n += 2 + sovParams(uint64(m.RewardVestingDuration))
+	}
+	l = len(m.FeeShare)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.MaxSupply)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.BurnFraction)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.InflationSchedule)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if len(m.InflationSteps) > 0 {
+		for _, e := range m.InflationSteps {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.InflationHalvingEpochs != 0 {
+		n += 2 + sovParams(uint64(m.InflationHalvingEpochs))
+	}
+	l = len(m.InflationDecayRate)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.InflationFloor)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.SoulboundEgv {
+		n += 3
+	}
+	if len(m.EgvTransferAllowlist) > 0 {
+		for _, s := range m.EgvTransferAllowlist {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.MaxMissedGovernanceActions != 0 {
+		n += 2 + sovParams(uint64(m.MaxMissedGovernanceActions))
+	}
+	l = len(m.InactivityPenalty)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.InactivityRewardReduction)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if m.InactivityPenaltyEpochs != 0 {
+		n += 2 + sovParams(uint64(m.InactivityPenaltyEpochs))
+	}
+	if m.LockUnbondingPeriod != 0 {
+		n += 2 + sovParams(uint64(m.LockUnbondingPeriod))
+	}
+	l = len(m.LockBoostCurve)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.LockBoostMax)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.LockBoostSaturation)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = len(m.MinLockAmount)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingInflationChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InflationRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
+	l = len(m.InflationSchedule)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.InflationSteps) > 0 {
+		for _, e := range m.InflationSteps {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.InflationHalvingEpochs != 0 {
+		n += 1 + sovParams(uint64(m.InflationHalvingEpochs))
+	}
+	l = len(m.InflationDecayRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.InflationFloor)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *InflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovParams(uint64(m.StartEpoch))
+	}
+	l = len(m.InflationRate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PredefinedWallets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PredefinedWallets = append(m.PredefinedWallets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinInflationRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxInflationRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxInflationRateChange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamChangeDelay", wireType)
+			}
+			m.ParamChangeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamChangeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingWeightFunction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingWeightFunction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingWeightCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingWeightCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionInterval", wireType)
+			}
+			m.ElectionInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElectionInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CouncilSize", wireType)
+			}
+			m.CouncilSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CouncilSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCandidateBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinCandidateBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsecutiveTerms", wireType)
+			}
+			m.MaxConsecutiveTerms = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsecutiveTerms |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGovernanceSetSize", wireType)
+			}
+			m.MinGovernanceSetSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGovernanceSetSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGovernanceSetSize", wireType)
+			}
+			m.MaxGovernanceSetSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGovernanceSetSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGovernanceSetChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGovernanceSetChange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionRetentionEpochs", wireType)
+			}
+			m.ContributionRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContributionRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContributionScoreMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContributionScoreMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreHalfLifeEpochs", wireType)
+			}
+			m.ScoreHalfLifeEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoreHalfLifeEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSharePerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSharePerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardVestingFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingDuration", wireType)
+			}
+			m.RewardVestingDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardVestingDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSteps = append(m.InflationSteps, InflationStep{})
+			if err := m.InflationSteps[len(m.InflationSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationHalvingEpochs", wireType)
+			}
+			m.InflationHalvingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationHalvingEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationDecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationDecayRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationFloor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoulboundEgv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SoulboundEgv = bool(v != 0)
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgvTransferAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EgvTransferAllowlist = append(m.EgvTransferAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedGovernanceActions", wireType)
+			}
+			m.MaxMissedGovernanceActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedGovernanceActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactivityPenalty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityRewardReduction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactivityRewardReduction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenaltyEpochs", wireType)
+			}
+			m.InactivityPenaltyEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenaltyEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockUnbondingPeriod", wireType)
+			}
+			m.LockUnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockUnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBoostCurve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockBoostCurve = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBoostMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockBoostMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockBoostSaturation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockBoostSaturation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLockAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinLockAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PendingInflationChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -231,10 +2911,10 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingInflationChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingInflationChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -270,8 +2950,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			m.InflationRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredefinedWallets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -299,7 +2998,225 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PredefinedWallets = append(m.PredefinedWallets, string(dAtA[iNdEx:postIndex]))
+			m.InflationSchedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSteps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationSteps = append(m.InflationSteps, InflationStep{})
+			if err := m.InflationSteps[len(m.InflationSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationHalvingEpochs", wireType)
+			}
+			m.InflationHalvingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationHalvingEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationDecayRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationDecayRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationFloor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflationRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
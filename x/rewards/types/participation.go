@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	math "cosmossdk.io/math"
+)
+
+// Inactivity penalties
+const (
+	// InactivityPenaltyRewardReduction cuts the wallet's reward share by
+	// inactivity_reward_reduction for inactivity_penalty_epochs epochs.
+	InactivityPenaltyRewardReduction = "reward_reduction"
+
+	// InactivityPenaltySuspension excludes the wallet from rewards and vetoes
+	// for inactivity_penalty_epochs epochs.
+	InactivityPenaltySuspension = "suspension"
+
+	// InactivityPenaltyRemoval removes the wallet from the governance set.
+	InactivityPenaltyRemoval = "removal"
+)
+
+// DefaultInactivityPenaltyEpochs lifts a reward reduction or suspension after
+// about a month.
+const DefaultInactivityPenaltyEpochs uint64 = 30
+
+// NewParticipation returns an empty participation record for an address.
+func NewParticipation(address string) Participation {
+	return Participation{Address: address}
+}
+
+// PenaltyActive reports whether a reward reduction or suspension is in effect
+// in the given epoch.
+func (p Participation) PenaltyActive(epoch uint64) bool {
+	switch p.Penalty {
+	case InactivityPenaltyRewardReduction, InactivityPenaltySuspension:
+		return epoch < p.PenaltyEndEpoch
+	default:
+		return false
+	}
+}
+
+// Suspended reports whether the wallet is suspended in the given epoch.
+func (p Participation) Suspended(epoch uint64) bool {
+	return p.Penalty == InactivityPenaltySuspension && p.PenaltyActive(epoch)
+}
+
+// GetInactivityRewardReductionAsDec returns inactivity_reward_reduction as a
+// LegacyDec.
+func (p Params) GetInactivityRewardReductionAsDec() (math.LegacyDec, error) {
+	return math.LegacyNewDecFromStr(p.InactivityRewardReduction)
+}
+
+func (p Params) validateInactivityPenalty() error {
+	switch p.InactivityPenalty {
+	case InactivityPenaltyRewardReduction, InactivityPenaltySuspension, InactivityPenaltyRemoval:
+	default:
+		return fmt.Errorf("unknown inactivity penalty %q", p.InactivityPenalty)
+	}
+
+	reduction, err := p.GetInactivityRewardReductionAsDec()
+	if err != nil {
+		return fmt.Errorf("invalid inactivity reward reduction format: %v", err)
+	}
+	if reduction.IsNegative() || reduction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("inactivity reward reduction must be between 0 and 1 (inclusive)")
+	}
+	if p.InactivityPenaltyEpochs == 0 {
+		return fmt.Errorf("inactivity penalty epochs must be positive")
+	}
+	return nil
+}
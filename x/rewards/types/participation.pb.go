@@ -0,0 +1,1236 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zenoda/rewards/participation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Participation is the governance participation record of a governance
+// wallet.
+type Participation struct {
+	// address is the governance wallet.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// votes is the number of x/gov votes the wallet has cast.
+	Votes uint64 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	// vetoes is the number of vetoes the wallet has signed against queued
+	// params updates.
+	Vetoes uint64 `protobuf:"varint,3,opt,name=vetoes,proto3" json:"vetoes,omitempty"`
+	// missed_actions is the number of x/gov proposals in a row whose voting
+	// period ended without a vote from the wallet or its delegatee.
+	MissedActions uint64 `protobuf:"varint,4,opt,name=missed_actions,json=missedActions,proto3" json:"missed_actions,omitempty"`
+	// penalty is the inactivity penalty applied last, empty if none.
+	Penalty string `protobuf:"bytes,5,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	// penalty_end_epoch is the epoch a reward reduction or suspension is
+	// lifted at.
+	PenaltyEndEpoch uint64 `protobuf:"varint,6,opt,name=penalty_end_epoch,json=penaltyEndEpoch,proto3" json:"penalty_end_epoch,omitempty"`
+}
+
+func (m *Participation) Reset()         { *m = Participation{} }
+func (m *Participation) String() string { return proto.CompactTextString(m) }
+func (*Participation) ProtoMessage()    {}
+func (*Participation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64efa5ddead09dd7, []int{0}
+}
+func (m *Participation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Participation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Participation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Participation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Participation.Merge(m, src)
+}
+func (m *Participation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Participation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Participation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Participation proto.InternalMessageInfo
+
+func (m *Participation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Participation) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *Participation) GetVetoes() uint64 {
+	if m != nil {
+		return m.Vetoes
+	}
+	return 0
+}
+
+func (m *Participation) GetMissedActions() uint64 {
+	if m != nil {
+		return m.MissedActions
+	}
+	return 0
+}
+
+func (m *Participation) GetPenalty() string {
+	if m != nil {
+		return m.Penalty
+	}
+	return ""
+}
+
+func (m *Participation) GetPenaltyEndEpoch() uint64 {
+	if m != nil {
+		return m.PenaltyEndEpoch
+	}
+	return 0
+}
+
+// EpochParticipation is the governance participation of a wallet within an
+// epoch.
+type EpochParticipation struct {
+	// epoch is the epoch the actions were taken in.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// address is the governance wallet.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// votes is the number of x/gov votes cast in the epoch.
+	Votes uint64 `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`
+	// vetoes is the number of vetoes signed in the epoch.
+	Vetoes uint64 `protobuf:"varint,4,opt,name=vetoes,proto3" json:"vetoes,omitempty"`
+}
+
+func (m *EpochParticipation) Reset()         { *m = EpochParticipation{} }
+func (m *EpochParticipation) String() string { return proto.CompactTextString(m) }
+func (*EpochParticipation) ProtoMessage()    {}
+func (*EpochParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64efa5ddead09dd7, []int{1}
+}
+func (m *EpochParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochParticipation.Merge(m, src)
+}
+func (m *EpochParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochParticipation proto.InternalMessageInfo
+
+func (m *EpochParticipation) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochParticipation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EpochParticipation) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
+	}
+	return 0
+}
+
+func (m *EpochParticipation) GetVetoes() uint64 {
+	if m != nil {
+		return m.Vetoes
+	}
+	return 0
+}
+
+// ProposalVote records that an address voted on an x/gov proposal whose
+// voting period has not ended yet.
+type ProposalVote struct {
+	// proposal_id is the x/gov proposal id.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the address that voted.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *ProposalVote) Reset()         { *m = ProposalVote{} }
+func (m *ProposalVote) String() string { return proto.CompactTextString(m) }
+func (*ProposalVote) ProtoMessage()    {}
+func (*ProposalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64efa5ddead09dd7, []int{2}
+}
+func (m *ProposalVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVote.Merge(m, src)
+}
+func (m *ProposalVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVote proto.InternalMessageInfo
+
+func (m *ProposalVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+// GovernanceSetRemovals lists the governance wallets removed for inactivity
+// within an epoch.
+type GovernanceSetRemovals struct {
+	// epoch is the epoch the wallets were removed in.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// wallets are the removed governance wallets.
+	Wallets []string `protobuf:"bytes,2,rep,name=wallets,proto3" json:"wallets,omitempty"`
+}
+
+func (m *GovernanceSetRemovals) Reset()         { *m = GovernanceSetRemovals{} }
+func (m *GovernanceSetRemovals) String() string { return proto.CompactTextString(m) }
+func (*GovernanceSetRemovals) ProtoMessage()    {}
+func (*GovernanceSetRemovals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64efa5ddead09dd7, []int{3}
+}
+func (m *GovernanceSetRemovals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceSetRemovals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceSetRemovals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceSetRemovals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceSetRemovals.Merge(m, src)
+}
+func (m *GovernanceSetRemovals) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceSetRemovals) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceSetRemovals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceSetRemovals proto.InternalMessageInfo
+
+func (m *GovernanceSetRemovals) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GovernanceSetRemovals) GetWallets() []string {
+	if m != nil {
+		return m.Wallets
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Participation)(nil), "zenoda.rewards.Participation")
+	proto.RegisterType((*EpochParticipation)(nil), "zenoda.rewards.EpochParticipation")
+	proto.RegisterType((*ProposalVote)(nil), "zenoda.rewards.ProposalVote")
+	proto.RegisterType((*GovernanceSetRemovals)(nil), "zenoda.rewards.GovernanceSetRemovals")
+}
+
+func init() {
+	proto.RegisterFile("zenoda/rewards/participation.proto", fileDescriptor_64efa5ddead09dd7)
+}
+
+var fileDescriptor_64efa5ddead09dd7 = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6a, 0x1b, 0x31,
+	0x10, 0xc6, 0x2d, 0xff, 0xa5, 0x6a, 0xed, 0x52, 0xe1, 0x1a, 0xb5, 0x87, 0xad, 0x59, 0x28, 0x98,
+	0x42, 0xed, 0xd2, 0x3e, 0x81, 0x0d, 0xa6, 0xe4, 0x66, 0xd6, 0x90, 0x43, 0x2e, 0x8b, 0xb2, 0x1a,
+	0x92, 0x85, 0xb5, 0x24, 0x24, 0xb1, 0x8e, 0xf3, 0x0c, 0x39, 0xe4, 0x61, 0xf2, 0x10, 0x39, 0x9a,
+	0x9c, 0x72, 0x0a, 0xc1, 0x7e, 0x91, 0xb0, 0x2b, 0x39, 0x38, 0x90, 0x3f, 0xe4, 0xa6, 0xef, 0x9b,
+	0x6f, 0x46, 0xf3, 0x83, 0xc1, 0xe1, 0x39, 0x08, 0xc9, 0xd9, 0x48, 0xc3, 0x92, 0x69, 0x6e, 0x46,
+	0x8a, 0x69, 0x9b, 0x26, 0xa9, 0x62, 0x36, 0x95, 0x62, 0xa8, 0xb4, 0xb4, 0x92, 0x74, 0x5c, 0x66,
+	0xe8, 0x33, 0xdf, 0xbf, 0x25, 0xd2, 0x2c, 0xa4, 0x89, 0xcb, 0xea, 0xc8, 0x09, 0x17, 0x0d, 0xef,
+	0x10, 0x6e, 0xcf, 0xf6, 0x47, 0x90, 0xbf, 0xb8, 0xc5, 0x38, 0xd7, 0x60, 0x0c, 0x45, 0x7d, 0x34,
+	0xf8, 0x30, 0xa1, 0x37, 0x57, 0xbf, 0xbb, 0xbe, 0x69, 0xec, 0x2a, 0x73, 0xab, 0x53, 0x71, 0x12,
+	0xed, 0x82, 0xa4, 0x8b, 0x1b, 0xb9, 0xb4, 0x60, 0x68, 0xb5, 0x8f, 0x06, 0xf5, 0xc8, 0x09, 0xd2,
+	0xc3, 0xcd, 0x1c, 0xac, 0x04, 0x43, 0x6b, 0xa5, 0xed, 0x15, 0xf9, 0x89, 0x3b, 0x8b, 0xd4, 0x18,
+	0xe0, 0x31, 0x4b, 0x8a, 0x2f, 0x0d, 0xad, 0x97, 0xf5, 0xb6, 0x73, 0xc7, 0xce, 0x24, 0x14, 0xb7,
+	0x14, 0x08, 0x96, 0xd9, 0x15, 0x6d, 0x14, 0x8b, 0x44, 0x3b, 0x49, 0x7e, 0xe1, 0x2f, 0xfe, 0x19,
+	0x83, 0xe0, 0x31, 0x28, 0x99, 0x9c, 0xd2, 0x66, 0x39, 0xe3, 0xb3, 0x2f, 0x4c, 0x05, 0x9f, 0x16,
+	0x76, 0x78, 0x81, 0x30, 0x29, 0x5f, 0x4f, 0x29, 0xbb, 0xb8, 0xe1, 0xda, 0x90, 0xdb, 0xb8, 0x14,
+	0xfb, 0xec, 0xd5, 0x77, 0xb3, 0xd7, 0x9e, 0x67, 0xaf, 0xef, 0xb3, 0x87, 0x31, 0xfe, 0x34, 0xd3,
+	0x52, 0x49, 0xc3, 0xb2, 0x43, 0x69, 0x81, 0xfc, 0xc0, 0x1f, 0x95, 0xd7, 0x71, 0xca, 0xfd, 0x36,
+	0x78, 0x67, 0x1d, 0x70, 0x32, 0x74, 0xe3, 0xf5, 0x9b, 0x0b, 0xb9, 0x58, 0xc8, 0xf0, 0xd7, 0xff,
+	0x32, 0x07, 0x2d, 0x98, 0x48, 0x60, 0x0e, 0x36, 0x82, 0x85, 0xcc, 0x59, 0x66, 0x5e, 0x26, 0x5e,
+	0xb2, 0x2c, 0x03, 0x5b, 0x10, 0xd7, 0x5e, 0x27, 0xf6, 0xc1, 0xc9, 0x9f, 0xeb, 0x4d, 0x80, 0xd6,
+	0x9b, 0x00, 0xdd, 0x6f, 0x02, 0x74, 0xb9, 0x0d, 0x2a, 0xeb, 0x6d, 0x50, 0xb9, 0xdd, 0x06, 0x95,
+	0xa3, 0x9e, 0x3f, 0xce, 0xb3, 0xc7, 0xf3, 0xb4, 0x2b, 0x05, 0xe6, 0xb8, 0x59, 0x1e, 0xdb, 0xbf,
+	0x87, 0x01, 0x00, 0xbb, 0xfc, 0x91, 0x3b, 0xbd, 0x02, 0x00, 0x00,
+}
+
+func (m *Participation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Participation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Participation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PenaltyEndEpoch != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.PenaltyEndEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Penalty) > 0 {
+		i -= len(m.Penalty)
+		copy(dAtA[i:], m.Penalty)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Penalty)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MissedActions != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.MissedActions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Vetoes != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Vetoes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Votes != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vetoes != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Vetoes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Votes != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintParticipation(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceSetRemovals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceSetRemovals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceSetRemovals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Wallets) > 0 {
+		for iNdEx := len(m.Wallets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Wallets[iNdEx])
+			copy(dAtA[i:], m.Wallets[iNdEx])
+			i = encodeVarintParticipation(dAtA, i, uint64(len(m.Wallets[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintParticipation(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Participation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovParticipation(uint64(m.Votes))
+	}
+	if m.Vetoes != 0 {
+		n += 1 + sovParticipation(uint64(m.Vetoes))
+	}
+	if m.MissedActions != 0 {
+		n += 1 + sovParticipation(uint64(m.MissedActions))
+	}
+	l = len(m.Penalty)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	if m.PenaltyEndEpoch != 0 {
+		n += 1 + sovParticipation(uint64(m.PenaltyEndEpoch))
+	}
+	return n
+}
+
+func (m *EpochParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovParticipation(uint64(m.Epoch))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovParticipation(uint64(m.Votes))
+	}
+	if m.Vetoes != 0 {
+		n += 1 + sovParticipation(uint64(m.Vetoes))
+	}
+	return n
+}
+
+func (m *ProposalVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovParticipation(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovParticipation(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceSetRemovals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovParticipation(uint64(m.Epoch))
+	}
+	if len(m.Wallets) > 0 {
+		for _, s := range m.Wallets {
+			l = len(s)
+			n += 1 + l + sovParticipation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParticipation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipation(x uint64) (n int) {
+	return sovParticipation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Participation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Participation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Participation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+			}
+			m.Vetoes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vetoes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedActions", wireType)
+			}
+			m.MissedActions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedActions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Penalty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyEndEpoch", wireType)
+			}
+			m.PenaltyEndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyEndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoes", wireType)
+			}
+			m.Vetoes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vetoes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceSetRemovals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceSetRemovals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceSetRemovals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wallets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Wallets = append(m.Wallets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,87 @@
+package types
+
+import (
+	math "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardShare is a governance wallet's share of a reward distribution.
+type RewardShare struct {
+	Address      sdk.AccAddress
+	Contribution math.LegacyDec
+
+	// UncappedShare is the wallet's contribution over the network's.
+	UncappedShare math.LegacyDec
+
+	// Share is the wallet's share after applying the per-address cap.
+	Share math.LegacyDec
+}
+
+// ApplyShareCap sets Share on every entry by water-filling: wallets whose
+// share would exceed maxShare are held at maxShare and the excess is shared
+// out among the other wallets in proportion to their contribution, repeating
+// until no share exceeds the cap. The shares add up to the uncapped shares,
+// unless every contributing wallet is capped, in which case the rest of the
+// pool is left undistributed.
+func ApplyShareCap(shares []RewardShare, maxShare math.LegacyDec) {
+	mass := math.LegacyZeroDec()
+	for i := range shares {
+		shares[i].Share = math.LegacyZeroDec()
+		mass = mass.Add(shares[i].UncappedShare)
+	}
+
+	capped := make([]bool, len(shares))
+	for {
+		remaining := mass
+		contribution := math.LegacyZeroDec()
+		for i, share := range shares {
+			if capped[i] {
+				remaining = remaining.Sub(maxShare)
+			} else {
+				contribution = contribution.Add(share.Contribution)
+			}
+		}
+		if !contribution.IsPositive() || !remaining.IsPositive() {
+			for i := range shares {
+				if !capped[i] {
+					shares[i].Share = math.LegacyZeroDec()
+				}
+			}
+			break
+		}
+
+		newlyCapped := false
+		for i, share := range shares {
+			if capped[i] {
+				continue
+			}
+			shares[i].Share = remaining.Mul(share.Contribution).Quo(contribution)
+			if shares[i].Share.GT(maxShare) {
+				capped[i] = true
+				newlyCapped = true
+			}
+		}
+		if !newlyCapped {
+			break
+		}
+	}
+
+	for i := range shares {
+		if capped[i] {
+			shares[i].Share = maxShare
+		}
+	}
+}
+
+// ShareOfCoins returns share of every denom in coins, truncated. Denoms
+// whose share rounds down to zero are left out.
+func ShareOfCoins(coins sdk.Coins, share math.LegacyDec) sdk.Coins {
+	var out sdk.Coins
+	for _, coin := range coins {
+		amount := share.MulInt(coin.Amount).TruncateInt()
+		if amount.IsPositive() {
+			out = out.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return out
+}
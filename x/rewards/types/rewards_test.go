@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	math "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"zenoda/x/rewards/types"
+)
+
+func TestApplyShareCap(t *testing.T) {
+	tests := []struct {
+		desc          string
+		contributions []int64
+		total         int64
+		maxShare      string
+		expected      []string
+	}{
+		{
+			desc:          "no share above the cap",
+			contributions: []int64{40, 35, 25},
+			total:         100,
+			maxShare:      "0.5",
+			expected:      []string{"0.4", "0.35", "0.25"},
+		},
+		{
+			desc:          "excess shared pro-rata",
+			contributions: []int64{80, 10, 10},
+			total:         100,
+			maxShare:      "0.5",
+			expected:      []string{"0.5", "0.25", "0.25"},
+		},
+		{
+			desc:          "redistribution pushes another wallet over the cap",
+			contributions: []int64{60, 25, 15},
+			total:         100,
+			maxShare:      "0.35",
+			expected:      []string{"0.35", "0.35", "0.3"},
+		},
+		{
+			desc:          "contributions outside the wallet set are not redistributed",
+			contributions: []int64{60, 20},
+			total:         100,
+			maxShare:      "0.5",
+			expected:      []string{"0.5", "0.3"},
+		},
+		{
+			desc:          "every wallet capped",
+			contributions: []int64{50, 50},
+			total:         100,
+			maxShare:      "0.3",
+			expected:      []string{"0.3", "0.3"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			total := math.LegacyNewDec(tc.total)
+			shares := make([]types.RewardShare, len(tc.contributions))
+			for i, c := range tc.contributions {
+				contribution := math.LegacyNewDec(c)
+				shares[i] = types.RewardShare{Contribution: contribution, UncappedShare: contribution.Quo(total)}
+			}
+
+			types.ApplyShareCap(shares, math.LegacyMustNewDecFromStr(tc.maxShare))
+
+			for i, expected := range tc.expected {
+				require.Equal(t, math.LegacyMustNewDecFromStr(expected), shares[i].Share, "wallet %d", i)
+			}
+		})
+	}
+}
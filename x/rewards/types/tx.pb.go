@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,228 +128,4634 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "zenoda.rewards.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "zenoda.rewards.MsgUpdateParamsResponse")
+// MsgVetoParamChange is the Msg/VetoParamChange request type.
+type MsgVetoParamChange struct {
+	// signer is the governance wallet casting the veto.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// change_id is the id of the queued params change.
+	ChangeId uint64 `protobuf:"varint,2,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("zenoda/rewards/tx.proto", fileDescriptor_a99e68559d9d5083) }
+func (m *MsgVetoParamChange) Reset()         { *m = MsgVetoParamChange{} }
+func (m *MsgVetoParamChange) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoParamChange) ProtoMessage()    {}
+func (*MsgVetoParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{2}
+}
+func (m *MsgVetoParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoParamChange.Merge(m, src)
+}
+func (m *MsgVetoParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoParamChange.DiscardUnknown(m)
+}
 
-var fileDescriptor_a99e68559d9d5083 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xaf, 0x4a, 0xcd, 0xcb,
-	0x4f, 0x49, 0xd4, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x2f, 0xa9, 0xd0, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xe8, 0x41, 0x25, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3,
-	0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x78, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0xb1, 0x7e, 0x6e,
-	0x71, 0xba, 0x7e, 0x99, 0x21, 0x88, 0x82, 0x4a, 0x48, 0x42, 0x24, 0xe2, 0xc1, 0x3c, 0x7d, 0x08,
-	0x07, 0x2a, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1, 0xa0, 0xa2, 0xd2, 0x68, 0xae,
-	0x28, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0x6a, 0x51, 0xda, 0xc9, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e,
-	0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x00, 0x96, 0x11, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9,
-	0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45,
-	0x57, 0x04, 0x6a, 0x97, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e,
-	0x7a, 0x10, 0x42, 0xa9, 0x90, 0x25, 0x17, 0x1b, 0xc4, 0x6c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e,
-	0x23, 0x31, 0x3d, 0x54, 0x6f, 0xea, 0x41, 0xcc, 0x77, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5,
-	0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x0d, 0x56, 0xc6, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0x8c, 0xea,
-	0x7a, 0xbe, 0x41, 0x4b, 0x01, 0xea, 0xec, 0x0a, 0xb8, 0xc3, 0xd1, 0xdc, 0xa9, 0x24, 0xc9, 0x25,
-	0x8e, 0x26, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x94, 0xc6, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0x14, 0xc1, 0xc5, 0x83, 0xe2, 0x33, 0x79, 0x74, 0x17, 0xa1, 0xe9, 0x97, 0x52, 0x27,
-	0xa0, 0x00, 0x66, 0x81, 0x14, 0x6b, 0x03, 0xc8, 0xfd, 0x4e, 0x06, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
-	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
-	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x86, 0xe1, 0xfc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0x70, 0xb8, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xac, 0xe1, 0x8d, 0x93, 0x1c, 0x02, 0x00,
-	0x00,
+var xxx_messageInfo_MsgVetoParamChange proto.InternalMessageInfo
+
+func (m *MsgVetoParamChange) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgVetoParamChange) GetChangeId() uint64 {
+	if m != nil {
+		return m.ChangeId
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgVetoParamChangeResponse defines the response structure for executing a
+// MsgVetoParamChange message.
+type MsgVetoParamChangeResponse struct {
+	// cancelled is true when this veto reached the threshold and removed the
+	// change from the queue.
+	Cancelled bool `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgVetoParamChangeResponse) Reset()         { *m = MsgVetoParamChangeResponse{} }
+func (m *MsgVetoParamChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoParamChangeResponse) ProtoMessage()    {}
+func (*MsgVetoParamChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{3}
+}
+func (m *MsgVetoParamChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoParamChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoParamChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoParamChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoParamChangeResponse.Merge(m, src)
+}
+func (m *MsgVetoParamChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoParamChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoParamChangeResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgVetoParamChangeResponse proto.InternalMessageInfo
+
+func (m *MsgVetoParamChangeResponse) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgDelegateVotingPower is the Msg/DelegateVotingPower request type.
+type MsgDelegateVotingPower struct {
+	// delegator is the address delegating its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// delegatee is the address receiving the voting power.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateVotingPower) Reset()         { *m = MsgDelegateVotingPower{} }
+func (m *MsgDelegateVotingPower) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVotingPower) ProtoMessage()    {}
+func (*MsgDelegateVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{4}
+}
+func (m *MsgDelegateVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelegateVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVotingPower.Merge(m, src)
+}
+func (m *MsgDelegateVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVotingPower.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+var xxx_messageInfo_MsgDelegateVotingPower proto.InternalMessageInfo
+
+func (m *MsgDelegateVotingPower) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgDelegateVotingPower) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+// MsgDelegateVotingPowerResponse defines the response structure for
+// executing a MsgDelegateVotingPower message.
+type MsgDelegateVotingPowerResponse struct {
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgDelegateVotingPowerResponse) Reset()         { *m = MsgDelegateVotingPowerResponse{} }
+func (m *MsgDelegateVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateVotingPowerResponse) ProtoMessage()    {}
+func (*MsgDelegateVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{5}
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateVotingPowerResponse.Merge(m, src)
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateVotingPowerResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDelegateVotingPowerResponse proto.InternalMessageInfo
+
+// MsgUndelegateVotingPower is the Msg/UndelegateVotingPower request type.
+type MsgUndelegateVotingPower struct {
+	// delegator is the address taking back its voting power.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *MsgUndelegateVotingPower) Reset()         { *m = MsgUndelegateVotingPower{} }
+func (m *MsgUndelegateVotingPower) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVotingPower) ProtoMessage()    {}
+func (*MsgUndelegateVotingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{6}
+}
+func (m *MsgUndelegateVotingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVotingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVotingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgUndelegateVotingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVotingPower.Merge(m, src)
+}
+func (m *MsgUndelegateVotingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVotingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVotingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVotingPower proto.InternalMessageInfo
+
+func (m *MsgUndelegateVotingPower) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zenoda.rewards.Msg/UpdateParams",
+	return ""
+}
+
+// MsgUndelegateVotingPowerResponse defines the response structure for
+// executing a MsgUndelegateVotingPower message.
+type MsgUndelegateVotingPowerResponse struct {
+}
+
+func (m *MsgUndelegateVotingPowerResponse) Reset()         { *m = MsgUndelegateVotingPowerResponse{} }
+func (m *MsgUndelegateVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateVotingPowerResponse) ProtoMessage()    {}
+func (*MsgUndelegateVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{7}
+}
+func (m *MsgUndelegateVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgUndelegateVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateVotingPowerResponse.Merge(m, src)
+}
+func (m *MsgUndelegateVotingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateVotingPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateVotingPowerResponse proto.InternalMessageInfo
+
+// MsgRegisterCandidate is the Msg/RegisterCandidate request type.
+type MsgRegisterCandidate struct {
+	// candidate is the address standing for election.
+	Candidate string `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+}
+
+func (m *MsgRegisterCandidate) Reset()         { *m = MsgRegisterCandidate{} }
+func (m *MsgRegisterCandidate) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCandidate) ProtoMessage()    {}
+func (*MsgRegisterCandidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{8}
+}
+func (m *MsgRegisterCandidate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCandidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCandidate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRegisterCandidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCandidate.Merge(m, src)
+}
+func (m *MsgRegisterCandidate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCandidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCandidate.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zenoda.rewards.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "zenoda/rewards/tx.proto",
+var xxx_messageInfo_MsgRegisterCandidate proto.InternalMessageInfo
+
+func (m *MsgRegisterCandidate) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgRegisterCandidateResponse defines the response structure for executing
+// a MsgRegisterCandidate message.
+type MsgRegisterCandidateResponse struct {
+}
+
+func (m *MsgRegisterCandidateResponse) Reset()         { *m = MsgRegisterCandidateResponse{} }
+func (m *MsgRegisterCandidateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCandidateResponse) ProtoMessage()    {}
+func (*MsgRegisterCandidateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{9}
+}
+func (m *MsgRegisterCandidateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterCandidateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCandidateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterCandidateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCandidateResponse.Merge(m, src)
+}
+func (m *MsgRegisterCandidateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterCandidateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCandidateResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterCandidateResponse proto.InternalMessageInfo
+
+// MsgWithdrawCandidacy is the Msg/WithdrawCandidacy request type.
+type MsgWithdrawCandidacy struct {
+	// candidate is the address withdrawing from elections.
+	Candidate string `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgWithdrawCandidacy) Reset()         { *m = MsgWithdrawCandidacy{} }
+func (m *MsgWithdrawCandidacy) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCandidacy) ProtoMessage()    {}
+func (*MsgWithdrawCandidacy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{10}
+}
+func (m *MsgWithdrawCandidacy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawCandidacy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCandidacy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawCandidacy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCandidacy.Merge(m, src)
+}
+func (m *MsgWithdrawCandidacy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCandidacy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCandidacy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawCandidacy proto.InternalMessageInfo
+
+func (m *MsgWithdrawCandidacy) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+// MsgWithdrawCandidacyResponse defines the response structure for executing
+// a MsgWithdrawCandidacy message.
+type MsgWithdrawCandidacyResponse struct {
+}
+
+func (m *MsgWithdrawCandidacyResponse) Reset()         { *m = MsgWithdrawCandidacyResponse{} }
+func (m *MsgWithdrawCandidacyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCandidacyResponse) ProtoMessage()    {}
+func (*MsgWithdrawCandidacyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{11}
+}
+func (m *MsgWithdrawCandidacyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawCandidacyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCandidacyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawCandidacyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCandidacyResponse.Merge(m, src)
+}
+func (m *MsgWithdrawCandidacyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCandidacyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCandidacyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawCandidacyResponse proto.InternalMessageInfo
+
+// MsgSetRewardWithdrawAddress is the Msg/SetRewardWithdrawAddress request
+// type.
+type MsgSetRewardWithdrawAddress struct {
+	// address is the account earning the rewards.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// withdraw_address is the account the rewards are paid to. Setting it to
+	// address itself removes the withdraw address.
+	WithdrawAddress string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *MsgSetRewardWithdrawAddress) Reset()         { *m = MsgSetRewardWithdrawAddress{} }
+func (m *MsgSetRewardWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{12}
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.Merge(m, src)
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardWithdrawAddress proto.InternalMessageInfo
+
+func (m *MsgSetRewardWithdrawAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetRewardWithdrawAddress) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+// MsgSetRewardWithdrawAddressResponse defines the response structure for
+// executing a MsgSetRewardWithdrawAddress message.
+type MsgSetRewardWithdrawAddressResponse struct {
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Reset()         { *m = MsgSetRewardWithdrawAddressResponse{} }
+func (m *MsgSetRewardWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{13}
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRewardWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgWithdrawVested is the Msg/WithdrawVested request type.
+type MsgWithdrawVested struct {
+	// address is the account withdrawing its vested rewards.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgWithdrawVested) Reset()         { *m = MsgWithdrawVested{} }
+func (m *MsgWithdrawVested) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVested) ProtoMessage()    {}
+func (*MsgWithdrawVested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{14}
+}
+func (m *MsgWithdrawVested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawVested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawVested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawVested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVested.Merge(m, src)
+}
+func (m *MsgWithdrawVested) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawVested) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawVested proto.InternalMessageInfo
+
+func (m *MsgWithdrawVested) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgWithdrawVestedResponse defines the response structure for executing a
+// MsgWithdrawVested message.
+type MsgWithdrawVestedResponse struct {
+	// amount is the EGV amount paid out.
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgWithdrawVestedResponse) Reset()         { *m = MsgWithdrawVestedResponse{} }
+func (m *MsgWithdrawVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVestedResponse) ProtoMessage()    {}
+func (*MsgWithdrawVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{15}
+}
+func (m *MsgWithdrawVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVestedResponse.Merge(m, src)
+}
+func (m *MsgWithdrawVestedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVestedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawVestedResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawVestedResponse) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// MsgFundRewardsPool is the Msg/FundRewardsPool request type.
+type MsgFundRewardsPool struct {
+	// sender is the account paying the coins.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount is the coins paid in, in any denom.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// campaign, when set, opens a sponsored incentive campaign funded with
+	// amount instead of paying into the reward pool.
+	Campaign *CampaignSpec `protobuf:"bytes,3,opt,name=campaign,proto3" json:"campaign,omitempty"`
+}
+
+func (m *MsgFundRewardsPool) Reset()         { *m = MsgFundRewardsPool{} }
+func (m *MsgFundRewardsPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardsPool) ProtoMessage()    {}
+func (*MsgFundRewardsPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{16}
+}
+func (m *MsgFundRewardsPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardsPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardsPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardsPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardsPool.Merge(m, src)
+}
+func (m *MsgFundRewardsPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardsPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardsPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardsPool proto.InternalMessageInfo
+
+func (m *MsgFundRewardsPool) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFundRewardsPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgFundRewardsPool) GetCampaign() *CampaignSpec {
+	if m != nil {
+		return m.Campaign
+	}
+	return nil
+}
+
+// MsgFundRewardsPoolResponse defines the response structure for executing a
+// MsgFundRewardsPool message.
+type MsgFundRewardsPoolResponse struct {
+	// campaign_id is the id of the opened campaign, zero when the reward pool
+	// was funded.
+	CampaignId uint64 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *MsgFundRewardsPoolResponse) Reset()         { *m = MsgFundRewardsPoolResponse{} }
+func (m *MsgFundRewardsPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardsPoolResponse) ProtoMessage()    {}
+func (*MsgFundRewardsPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{17}
+}
+func (m *MsgFundRewardsPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardsPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardsPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardsPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardsPoolResponse.Merge(m, src)
+}
+func (m *MsgFundRewardsPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardsPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardsPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardsPoolResponse proto.InternalMessageInfo
+
+func (m *MsgFundRewardsPoolResponse) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// MsgRefundCampaign is the Msg/RefundCampaign request type.
+type MsgRefundCampaign struct {
+	// sponsor is the account that funded the campaign.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// campaign_id is the campaign to refund.
+	CampaignId uint64 `protobuf:"varint,2,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+}
+
+func (m *MsgRefundCampaign) Reset()         { *m = MsgRefundCampaign{} }
+func (m *MsgRefundCampaign) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundCampaign) ProtoMessage()    {}
+func (*MsgRefundCampaign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{18}
+}
+func (m *MsgRefundCampaign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundCampaign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundCampaign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundCampaign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundCampaign.Merge(m, src)
+}
+func (m *MsgRefundCampaign) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundCampaign) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundCampaign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundCampaign proto.InternalMessageInfo
+
+func (m *MsgRefundCampaign) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *MsgRefundCampaign) GetCampaignId() uint64 {
+	if m != nil {
+		return m.CampaignId
+	}
+	return 0
+}
+
+// MsgRefundCampaignResponse defines the response structure for executing a
+// MsgRefundCampaign message.
+type MsgRefundCampaignResponse struct {
+	// amount is the unspent amount returned to the sponsor.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgRefundCampaignResponse) Reset()         { *m = MsgRefundCampaignResponse{} }
+func (m *MsgRefundCampaignResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundCampaignResponse) ProtoMessage()    {}
+func (*MsgRefundCampaignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{19}
+}
+func (m *MsgRefundCampaignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundCampaignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundCampaignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundCampaignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundCampaignResponse.Merge(m, src)
+}
+func (m *MsgRefundCampaignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundCampaignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundCampaignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundCampaignResponse proto.InternalMessageInfo
+
+func (m *MsgRefundCampaignResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgLockEGV is the Msg/LockEGV request type.
+type MsgLockEGV struct {
+	// owner is the account locking the EGV.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount is the EGV amount to lock.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgLockEGV) Reset()         { *m = MsgLockEGV{} }
+func (m *MsgLockEGV) String() string { return proto.CompactTextString(m) }
+func (*MsgLockEGV) ProtoMessage()    {}
+func (*MsgLockEGV) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{20}
+}
+func (m *MsgLockEGV) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockEGV) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockEGV.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockEGV) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockEGV.Merge(m, src)
+}
+func (m *MsgLockEGV) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockEGV) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockEGV.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockEGV proto.InternalMessageInfo
+
+func (m *MsgLockEGV) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgLockEGV) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// MsgLockEGVResponse defines the response structure for executing a
+// MsgLockEGV message.
+type MsgLockEGVResponse struct {
+	// locked is the EGV amount the owner has locked in total.
+	Locked string `protobuf:"bytes,1,opt,name=locked,proto3" json:"locked,omitempty"`
+}
+
+func (m *MsgLockEGVResponse) Reset()         { *m = MsgLockEGVResponse{} }
+func (m *MsgLockEGVResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockEGVResponse) ProtoMessage()    {}
+func (*MsgLockEGVResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{21}
+}
+func (m *MsgLockEGVResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockEGVResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockEGVResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockEGVResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockEGVResponse.Merge(m, src)
+}
+func (m *MsgLockEGVResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockEGVResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockEGVResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockEGVResponse proto.InternalMessageInfo
+
+func (m *MsgLockEGVResponse) GetLocked() string {
+	if m != nil {
+		return m.Locked
+	}
+	return ""
+}
+
+// MsgUnlockEGV is the Msg/UnlockEGV request type.
+type MsgUnlockEGV struct {
+	// owner is the account unlocking the EGV.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount is the locked EGV amount to unlock.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgUnlockEGV) Reset()         { *m = MsgUnlockEGV{} }
+func (m *MsgUnlockEGV) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockEGV) ProtoMessage()    {}
+func (*MsgUnlockEGV) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{22}
+}
+func (m *MsgUnlockEGV) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockEGV) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockEGV.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockEGV) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockEGV.Merge(m, src)
+}
+func (m *MsgUnlockEGV) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockEGV) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockEGV.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockEGV proto.InternalMessageInfo
+
+func (m *MsgUnlockEGV) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUnlockEGV) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// MsgUnlockEGVResponse defines the response structure for executing a
+// MsgUnlockEGV message.
+type MsgUnlockEGVResponse struct {
+	// unlock_id is the id of the unlock.
+	UnlockId uint64 `protobuf:"varint,1,opt,name=unlock_id,json=unlockId,proto3" json:"unlock_id,omitempty"`
+	// completion_height is the block height the EGV is returned at.
+	CompletionHeight int64 `protobuf:"varint,2,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *MsgUnlockEGVResponse) Reset()         { *m = MsgUnlockEGVResponse{} }
+func (m *MsgUnlockEGVResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlockEGVResponse) ProtoMessage()    {}
+func (*MsgUnlockEGVResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a99e68559d9d5083, []int{23}
+}
+func (m *MsgUnlockEGVResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlockEGVResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlockEGVResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnlockEGVResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlockEGVResponse.Merge(m, src)
+}
+func (m *MsgUnlockEGVResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlockEGVResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlockEGVResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnlockEGVResponse proto.InternalMessageInfo
+
+func (m *MsgUnlockEGVResponse) GetUnlockId() uint64 {
+	if m != nil {
+		return m.UnlockId
+	}
+	return 0
+}
+
+func (m *MsgUnlockEGVResponse) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "zenoda.rewards.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "zenoda.rewards.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgVetoParamChange)(nil), "zenoda.rewards.MsgVetoParamChange")
+	proto.RegisterType((*MsgVetoParamChangeResponse)(nil), "zenoda.rewards.MsgVetoParamChangeResponse")
+	proto.RegisterType((*MsgDelegateVotingPower)(nil), "zenoda.rewards.MsgDelegateVotingPower")
+	proto.RegisterType((*MsgDelegateVotingPowerResponse)(nil), "zenoda.rewards.MsgDelegateVotingPowerResponse")
+	proto.RegisterType((*MsgUndelegateVotingPower)(nil), "zenoda.rewards.MsgUndelegateVotingPower")
+	proto.RegisterType((*MsgUndelegateVotingPowerResponse)(nil), "zenoda.rewards.MsgUndelegateVotingPowerResponse")
+	proto.RegisterType((*MsgRegisterCandidate)(nil), "zenoda.rewards.MsgRegisterCandidate")
+	proto.RegisterType((*MsgRegisterCandidateResponse)(nil), "zenoda.rewards.MsgRegisterCandidateResponse")
+	proto.RegisterType((*MsgWithdrawCandidacy)(nil), "zenoda.rewards.MsgWithdrawCandidacy")
+	proto.RegisterType((*MsgWithdrawCandidacyResponse)(nil), "zenoda.rewards.MsgWithdrawCandidacyResponse")
+	proto.RegisterType((*MsgSetRewardWithdrawAddress)(nil), "zenoda.rewards.MsgSetRewardWithdrawAddress")
+	proto.RegisterType((*MsgSetRewardWithdrawAddressResponse)(nil), "zenoda.rewards.MsgSetRewardWithdrawAddressResponse")
+	proto.RegisterType((*MsgWithdrawVested)(nil), "zenoda.rewards.MsgWithdrawVested")
+	proto.RegisterType((*MsgWithdrawVestedResponse)(nil), "zenoda.rewards.MsgWithdrawVestedResponse")
+	proto.RegisterType((*MsgFundRewardsPool)(nil), "zenoda.rewards.MsgFundRewardsPool")
+	proto.RegisterType((*MsgFundRewardsPoolResponse)(nil), "zenoda.rewards.MsgFundRewardsPoolResponse")
+	proto.RegisterType((*MsgRefundCampaign)(nil), "zenoda.rewards.MsgRefundCampaign")
+	proto.RegisterType((*MsgRefundCampaignResponse)(nil), "zenoda.rewards.MsgRefundCampaignResponse")
+	proto.RegisterType((*MsgLockEGV)(nil), "zenoda.rewards.MsgLockEGV")
+	proto.RegisterType((*MsgLockEGVResponse)(nil), "zenoda.rewards.MsgLockEGVResponse")
+	proto.RegisterType((*MsgUnlockEGV)(nil), "zenoda.rewards.MsgUnlockEGV")
+	proto.RegisterType((*MsgUnlockEGVResponse)(nil), "zenoda.rewards.MsgUnlockEGVResponse")
+}
+
+func init() { proto.RegisterFile("zenoda/rewards/tx.proto", fileDescriptor_a99e68559d9d5083) }
+
+var fileDescriptor_a99e68559d9d5083 = []byte{
+	// 1226 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0xdf, 0xa6, 0xf1, 0x4b, 0x94, 0x34, 0xfb, 0x0d, 0x89, 0xb3, 0x49, 0x1d, 0x77,
+	0xfb, 0x2b, 0x71, 0x13, 0x3b, 0x3f, 0x04, 0x2a, 0x56, 0x7b, 0x20, 0x86, 0x42, 0x24, 0x2c, 0xa2,
+	0x8d, 0x08, 0x88, 0x03, 0x61, 0xb3, 0x3b, 0xac, 0x57, 0xb1, 0x77, 0xac, 0x9d, 0x75, 0xdd, 0xa0,
+	0x1e, 0x10, 0x12, 0x12, 0xe2, 0xd4, 0x03, 0x07, 0x84, 0xc4, 0x1d, 0x71, 0xca, 0xa1, 0x17, 0x24,
+	0x8e, 0x1c, 0x7a, 0xac, 0x2a, 0x21, 0x71, 0x02, 0x94, 0x1c, 0xf2, 0x6f, 0xa0, 0x9d, 0x9d, 0x1d,
+	0xaf, 0x77, 0xc7, 0x5e, 0x17, 0xd1, 0x4b, 0xbc, 0xf3, 0xde, 0xe7, 0xbd, 0xf7, 0x79, 0x33, 0x6f,
+	0xe6, 0xbd, 0xc0, 0xfc, 0x17, 0xc8, 0xc1, 0xa6, 0x5e, 0x76, 0x51, 0x47, 0x77, 0x4d, 0x52, 0xf6,
+	0x1e, 0x95, 0x5a, 0x2e, 0xf6, 0xb0, 0x3c, 0x15, 0x28, 0x4a, 0x4c, 0xa1, 0xcc, 0xe8, 0x4d, 0xdb,
+	0xc1, 0x65, 0xfa, 0x37, 0x80, 0x28, 0xf3, 0x06, 0x26, 0x4d, 0x4c, 0xca, 0x4d, 0x62, 0x95, 0x1f,
+	0x6e, 0xfa, 0x3f, 0x4c, 0xb1, 0x10, 0x28, 0x0e, 0xe9, 0xaa, 0x1c, 0x2c, 0x98, 0x6a, 0xd6, 0xc2,
+	0x16, 0x0e, 0xe4, 0xfe, 0x17, 0x93, 0x2e, 0xc6, 0x58, 0xb4, 0x74, 0x57, 0x6f, 0x86, 0x26, 0x79,
+	0x16, 0xe6, 0x48, 0x27, 0xa8, 0xfc, 0x70, 0xf3, 0x08, 0x79, 0xfa, 0x66, 0xd9, 0xc0, 0xb6, 0xc3,
+	0xf4, 0x57, 0x63, 0xc6, 0x86, 0xde, 0x6c, 0xe9, 0xb6, 0xc5, 0xd4, 0xea, 0x2f, 0x12, 0x4c, 0xd7,
+	0x88, 0xf5, 0x61, 0xcb, 0xd4, 0x3d, 0xb4, 0x47, 0x1d, 0xcb, 0x6f, 0x40, 0x56, 0x6f, 0x7b, 0x75,
+	0xec, 0xda, 0xde, 0x49, 0x4e, 0x2a, 0x48, 0x2b, 0xd9, 0x9d, 0xdc, 0x8b, 0xa7, 0xeb, 0xb3, 0x8c,
+	0xea, 0x5b, 0xa6, 0xe9, 0x22, 0x42, 0xf6, 0x3d, 0xd7, 0x76, 0x2c, 0xad, 0x0b, 0x95, 0xdf, 0x84,
+	0xb1, 0x80, 0x5a, 0x2e, 0x53, 0x90, 0x56, 0x26, 0xb6, 0xe6, 0x4a, 0xbd, 0xbb, 0x54, 0x0a, 0xfc,
+	0xef, 0x64, 0x9f, 0xfd, 0xb9, 0x3c, 0xf2, 0xd3, 0xc5, 0x69, 0x51, 0xd2, 0x98, 0x41, 0x65, 0xfb,
+	0xab, 0x8b, 0xd3, 0x62, 0xd7, 0xd5, 0xb7, 0x17, 0xa7, 0xc5, 0x02, 0x23, 0xfe, 0x88, 0x53, 0x8f,
+	0xf1, 0x54, 0x17, 0x60, 0x3e, 0x26, 0xd2, 0x10, 0x69, 0x61, 0x87, 0x20, 0xf5, 0x7b, 0x09, 0xe4,
+	0x1a, 0xb1, 0x0e, 0x90, 0x87, 0xa9, 0xa6, 0x5a, 0xd7, 0x1d, 0x0b, 0xc9, 0x1b, 0x30, 0x46, 0x6c,
+	0xcb, 0x41, 0x6e, 0x6a, 0x5a, 0x0c, 0x27, 0x2f, 0x42, 0xd6, 0xa0, 0xb6, 0x87, 0xb6, 0x49, 0xd3,
+	0xfa, 0x9f, 0x36, 0x1e, 0x08, 0x76, 0xcd, 0x80, 0x35, 0x43, 0xfa, 0x94, 0xaf, 0x8b, 0x28, 0xc7,
+	0x38, 0xa8, 0x15, 0x50, 0x92, 0xd2, 0x90, 0xb8, 0xbc, 0x04, 0x59, 0x43, 0x77, 0x0c, 0xd4, 0x68,
+	0x20, 0x93, 0x92, 0x1c, 0xd7, 0xba, 0x02, 0xf5, 0x37, 0x09, 0xe6, 0x6a, 0xc4, 0x7a, 0x1b, 0x35,
+	0x90, 0xa5, 0x7b, 0xe8, 0x00, 0x7b, 0xb6, 0x63, 0xed, 0xe1, 0x0e, 0x72, 0xfd, 0x43, 0x33, 0x03,
+	0x31, 0x4e, 0xcf, 0xae, 0x0b, 0x8d, 0xd8, 0x21, 0x94, 0xcb, 0x0c, 0x69, 0x87, 0x50, 0xa5, 0x42,
+	0x4f, 0x8c, 0xfb, 0xf1, 0xd3, 0xbf, 0x2d, 0x4a, 0x5f, 0xc0, 0x55, 0x2d, 0x40, 0x5e, 0xac, 0xe1,
+	0xe7, 0xf7, 0x44, 0x82, 0x9c, 0x7f, 0xb6, 0x8e, 0xf9, 0xdf, 0xa5, 0x5a, 0xb9, 0x97, 0xa4, 0xbc,
+	0x2a, 0x2c, 0x32, 0x51, 0x54, 0x55, 0x85, 0x42, 0x3f, 0x1d, 0xa7, 0xfd, 0x8d, 0x04, 0xb3, 0x35,
+	0x62, 0x69, 0xc8, 0xb2, 0x89, 0x87, 0xdc, 0xaa, 0xee, 0x98, 0xb6, 0x5f, 0x9d, 0x3e, 0x65, 0x23,
+	0x5c, 0xa4, 0x53, 0xe6, 0xd0, 0xca, 0x5d, 0x4a, 0x99, 0xaf, 0x7d, 0xca, 0x37, 0x45, 0x94, 0x13,
+	0x11, 0xd5, 0x3c, 0x2c, 0x89, 0xe4, 0x71, 0xaa, 0x1f, 0xd9, 0x5e, 0xdd, 0x74, 0xf5, 0x0e, 0x03,
+	0x18, 0x27, 0xaf, 0x9c, 0x6a, 0x22, 0x22, 0xa3, 0x9a, 0x90, 0x73, 0xaa, 0xbf, 0x4b, 0xb0, 0x58,
+	0x23, 0xd6, 0x3e, 0xf2, 0x34, 0xea, 0x26, 0x44, 0x32, 0x2a, 0xf2, 0x16, 0x5c, 0xd6, 0x83, 0xcf,
+	0x54, 0xbe, 0x21, 0x50, 0xae, 0xc2, 0x95, 0x0e, 0x73, 0x73, 0x18, 0x1a, 0xa7, 0x55, 0xff, 0x74,
+	0xa7, 0x37, 0x70, 0xe5, 0xbe, 0x9f, 0x72, 0xe8, 0xd2, 0x4f, 0x78, 0x4d, 0x94, 0x70, 0x3f, 0xde,
+	0xea, 0x4d, 0xb8, 0x3e, 0x40, 0xcd, 0xd3, 0x7f, 0x0c, 0x33, 0x91, 0xed, 0x39, 0x40, 0xc4, 0x43,
+	0xe6, 0xbf, 0xc9, 0x39, 0x78, 0xae, 0xa2, 0x74, 0xd5, 0x41, 0xe7, 0x13, 0x04, 0x52, 0xab, 0xb0,
+	0x90, 0x10, 0xf2, 0xd7, 0xea, 0x16, 0x8c, 0xe9, 0x4d, 0xdc, 0x76, 0x3c, 0x46, 0x62, 0xea, 0xc5,
+	0xd3, 0x75, 0x60, 0x24, 0x76, 0x1d, 0x4f, 0x63, 0x5a, 0xf5, 0x34, 0x43, 0x9f, 0xe3, 0x07, 0x6d,
+	0xc7, 0x0c, 0x72, 0x25, 0x7b, 0x18, 0x37, 0xe8, 0x73, 0x8c, 0x1c, 0x73, 0xa8, 0xe7, 0x98, 0xe2,
+	0xe4, 0x13, 0x1e, 0x30, 0x53, 0x18, 0x5d, 0x99, 0xd8, 0x5a, 0x28, 0x31, 0xb8, 0xdf, 0xfe, 0x4a,
+	0xac, 0xfd, 0x95, 0xaa, 0xd8, 0x76, 0x76, 0x1e, 0xf8, 0x5d, 0xe6, 0xe7, 0xbf, 0x96, 0x57, 0x2c,
+	0xdb, 0xab, 0xb7, 0x8f, 0x4a, 0x06, 0x6e, 0xb2, 0x66, 0xcb, 0x7e, 0xd6, 0x89, 0x79, 0x5c, 0xf6,
+	0x4e, 0x5a, 0x88, 0x50, 0x03, 0xf2, 0xc3, 0xc5, 0x69, 0x71, 0xd2, 0xbf, 0xdb, 0xc6, 0xc9, 0xa1,
+	0xdf, 0x40, 0x09, 0x6b, 0x51, 0x41, 0x40, 0xf9, 0x2e, 0x8c, 0x87, 0xbd, 0x33, 0x37, 0x4a, 0xfb,
+	0xdb, 0x52, 0xbc, 0xbf, 0x55, 0x99, 0x7e, 0xbf, 0x85, 0x0c, 0x8d, 0xa3, 0xc3, 0x36, 0x41, 0x33,
+	0xe8, 0xdb, 0x26, 0x62, 0x7b, 0xa3, 0xde, 0x07, 0x25, 0x29, 0xe5, 0x1b, 0xbf, 0x0c, 0x13, 0xa1,
+	0x7b, 0xbf, 0x31, 0x49, 0xb4, 0x31, 0x41, 0x28, 0xda, 0x35, 0xd5, 0x1f, 0x25, 0x5a, 0x35, 0x1a,
+	0xfa, 0xbc, 0xed, 0x98, 0x21, 0x2f, 0xbf, 0x6a, 0xa8, 0x83, 0x21, 0xde, 0xcd, 0x10, 0x18, 0x0f,
+	0x95, 0x89, 0x87, 0x62, 0x65, 0xc5, 0xe0, 0x7d, 0xcb, 0xaa, 0x97, 0x89, 0xfa, 0xb5, 0x04, 0x0b,
+	0x09, 0x29, 0x4f, 0xaf, 0x1e, 0xa9, 0xab, 0x94, 0x63, 0x7e, 0xfd, 0x65, 0x8f, 0xb9, 0xe7, 0x54,
+	0xfd, 0x46, 0x03, 0x35, 0x62, 0xbd, 0x8f, 0x8d, 0xe3, 0x77, 0xde, 0x3d, 0x90, 0x4b, 0x70, 0x09,
+	0x77, 0x86, 0x99, 0x0f, 0x02, 0x58, 0xe4, 0x02, 0x64, 0x06, 0x5d, 0x80, 0x4a, 0xd1, 0xdf, 0xa3,
+	0xc0, 0xc6, 0xdf, 0xa1, 0x45, 0xd1, 0x0e, 0x31, 0x0e, 0xea, 0x3d, 0x90, 0xbb, 0xab, 0xe8, 0x55,
+	0x6b, 0x60, 0xe3, 0x98, 0x4d, 0x05, 0x82, 0x48, 0x81, 0x56, 0xfd, 0x4e, 0x82, 0x49, 0xda, 0xa7,
+	0x1a, 0xaf, 0x38, 0xa5, 0xb5, 0xde, 0x94, 0xae, 0x8a, 0x3b, 0x29, 0x63, 0xa1, 0x7e, 0x06, 0xb3,
+	0xd1, 0x35, 0x4f, 0x6b, 0x11, 0xb2, 0x6d, 0x2a, 0xec, 0x96, 0xf1, 0x78, 0x20, 0xd8, 0x35, 0xe5,
+	0x3b, 0x30, 0x63, 0xe0, 0x66, 0xab, 0x81, 0x3c, 0x1b, 0x3b, 0x87, 0x75, 0x64, 0x5b, 0xf5, 0x80,
+	0xd5, 0xa8, 0x76, 0xa5, 0xab, 0x78, 0x8f, 0xca, 0xb7, 0x7e, 0xcd, 0xc2, 0x68, 0x8d, 0x58, 0xf2,
+	0xc7, 0x30, 0xd9, 0x33, 0xcd, 0x2e, 0xc7, 0x6f, 0x69, 0x6c, 0x66, 0x54, 0x6e, 0xa7, 0x00, 0x38,
+	0x57, 0x1d, 0xa6, 0xe3, 0x03, 0xa5, 0x2a, 0xb0, 0x8d, 0x61, 0x94, 0x62, 0x3a, 0x86, 0x87, 0x68,
+	0xc2, 0xff, 0x45, 0xc3, 0xdd, 0x2d, 0x81, 0x0b, 0x01, 0x4e, 0x29, 0x0d, 0x87, 0xe3, 0xe1, 0x08,
+	0xbc, 0x26, 0x1e, 0xb1, 0x56, 0x44, 0x7b, 0x22, 0x42, 0x2a, 0x1b, 0xc3, 0x22, 0x79, 0x50, 0x0b,
+	0x66, 0x92, 0x03, 0xd2, 0x0d, 0x81, 0x9b, 0x04, 0x4a, 0x59, 0x1b, 0x06, 0x15, 0x0d, 0x94, 0x1c,
+	0x6f, 0x44, 0x81, 0x12, 0x28, 0x65, 0x6d, 0x18, 0x14, 0x0f, 0xf4, 0x18, 0x72, 0x7d, 0x87, 0x93,
+	0x3b, 0x02, 0x4f, 0xfd, 0xc0, 0xca, 0xf6, 0x4b, 0x80, 0x79, 0xf4, 0x4f, 0x61, 0x2a, 0x36, 0x1c,
+	0x5c, 0x1b, 0xc0, 0x3e, 0x80, 0x28, 0xab, 0xa9, 0x90, 0x68, 0xd9, 0xc7, 0x1b, 0xb7, 0xa8, 0xec,
+	0x63, 0x18, 0xa5, 0x98, 0x8e, 0x89, 0xa6, 0x10, 0xeb, 0x54, 0xd7, 0x84, 0x27, 0x1d, 0x85, 0x28,
+	0xab, 0xa9, 0x10, 0xee, 0x7f, 0x17, 0x2e, 0x87, 0x2f, 0xbc, 0x22, 0xb0, 0x62, 0x3a, 0x45, 0xed,
+	0xaf, 0xe3, 0xae, 0x3e, 0x80, 0x6c, 0xf7, 0x6d, 0x5d, 0x12, 0x16, 0x3f, 0xd3, 0x2a, 0x37, 0x06,
+	0x69, 0x43, 0x87, 0xca, 0xa5, 0x2f, 0xfd, 0x86, 0xb4, 0xb3, 0xf1, 0xec, 0x2c, 0x2f, 0x3d, 0x3f,
+	0xcb, 0x4b, 0x7f, 0x9f, 0xe5, 0xa5, 0x27, 0xe7, 0xf9, 0x91, 0xe7, 0xe7, 0xf9, 0x91, 0x3f, 0xce,
+	0xf3, 0x23, 0x9f, 0xcc, 0x25, 0x5e, 0x56, 0xda, 0xcd, 0x8e, 0xc6, 0xe8, 0x7f, 0xf0, 0xdb, 0xff,
+	0x0c, 0x00, 0x0f, 0x6a, 0xc2, 0x4e, 0xa5, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// VetoParamChange lets a governance wallet vote to cancel a params update
+	// that is still waiting in the timelock queue.
+	VetoParamChange(ctx context.Context, in *MsgVetoParamChange, opts ...grpc.CallOption) (*MsgVetoParamChangeResponse, error)
+	// DelegateVotingPower delegates the signer's contribution-weighted voting
+	// power to another address. Only governance wallets and election candidates
+	// have a transaction count, so only they can delegate.
+	DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error)
+	// UndelegateVotingPower removes the signer's voting power delegation.
+	UndelegateVotingPower(ctx context.Context, in *MsgUndelegateVotingPower, opts ...grpc.CallOption) (*MsgUndelegateVotingPowerResponse, error)
+	// RegisterCandidate registers the signer as a candidate in governance
+	// wallet elections.
+	RegisterCandidate(ctx context.Context, in *MsgRegisterCandidate, opts ...grpc.CallOption) (*MsgRegisterCandidateResponse, error)
+	// WithdrawCandidacy removes the signer from the election candidates.
+	WithdrawCandidacy(ctx context.Context, in *MsgWithdrawCandidacy, opts ...grpc.CallOption) (*MsgWithdrawCandidacyResponse, error)
+	// SetRewardWithdrawAddress sets the address the signer's rewards are paid
+	// to.
+	SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error)
+	// WithdrawVested pays out the vested part of the signer's reward vesting
+	// positions.
+	WithdrawVested(ctx context.Context, in *MsgWithdrawVested, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error)
+	// FundRewardsPool lets any account pay coins into the reward pool or into
+	// a new sponsored incentive campaign.
+	FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error)
+	// RefundCampaign returns the unspent funds of a settled campaign to its
+	// sponsor.
+	RefundCampaign(ctx context.Context, in *MsgRefundCampaign, opts ...grpc.CallOption) (*MsgRefundCampaignResponse, error)
+	// LockEGV locks EGV of the signer in the module, boosting its contribution
+	// weight for rewards and governance.
+	LockEGV(ctx context.Context, in *MsgLockEGV, opts ...grpc.CallOption) (*MsgLockEGVResponse, error)
+	// UnlockEGV starts returning locked EGV to the signer. The EGV stops
+	// boosting at once and is paid out after lock_unbonding_period blocks.
+	UnlockEGV(ctx context.Context, in *MsgUnlockEGV, opts ...grpc.CallOption) (*MsgUnlockEGVResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoParamChange(ctx context.Context, in *MsgVetoParamChange, opts ...grpc.CallOption) (*MsgVetoParamChangeResponse, error) {
+	out := new(MsgVetoParamChangeResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/VetoParamChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateVotingPower(ctx context.Context, in *MsgDelegateVotingPower, opts ...grpc.CallOption) (*MsgDelegateVotingPowerResponse, error) {
+	out := new(MsgDelegateVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/DelegateVotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateVotingPower(ctx context.Context, in *MsgUndelegateVotingPower, opts ...grpc.CallOption) (*MsgUndelegateVotingPowerResponse, error) {
+	out := new(MsgUndelegateVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/UndelegateVotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCandidate(ctx context.Context, in *MsgRegisterCandidate, opts ...grpc.CallOption) (*MsgRegisterCandidateResponse, error) {
+	out := new(MsgRegisterCandidateResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/RegisterCandidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawCandidacy(ctx context.Context, in *MsgWithdrawCandidacy, opts ...grpc.CallOption) (*MsgWithdrawCandidacyResponse, error) {
+	out := new(MsgWithdrawCandidacyResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/WithdrawCandidacy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/SetRewardWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawVested(ctx context.Context, in *MsgWithdrawVested, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error) {
+	out := new(MsgWithdrawVestedResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/WithdrawVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardsPool(ctx context.Context, in *MsgFundRewardsPool, opts ...grpc.CallOption) (*MsgFundRewardsPoolResponse, error) {
+	out := new(MsgFundRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/FundRewardsPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundCampaign(ctx context.Context, in *MsgRefundCampaign, opts ...grpc.CallOption) (*MsgRefundCampaignResponse, error) {
+	out := new(MsgRefundCampaignResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/RefundCampaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LockEGV(ctx context.Context, in *MsgLockEGV, opts ...grpc.CallOption) (*MsgLockEGVResponse, error) {
+	out := new(MsgLockEGVResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/LockEGV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlockEGV(ctx context.Context, in *MsgUnlockEGV, opts ...grpc.CallOption) (*MsgUnlockEGVResponse, error) {
+	out := new(MsgUnlockEGVResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.rewards.Msg/UnlockEGV", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// VetoParamChange lets a governance wallet vote to cancel a params update
+	// that is still waiting in the timelock queue.
+	VetoParamChange(context.Context, *MsgVetoParamChange) (*MsgVetoParamChangeResponse, error)
+	// DelegateVotingPower delegates the signer's contribution-weighted voting
+	// power to another address. Only governance wallets and election candidates
+	// have a transaction count, so only they can delegate.
+	DelegateVotingPower(context.Context, *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error)
+	// UndelegateVotingPower removes the signer's voting power delegation.
+	UndelegateVotingPower(context.Context, *MsgUndelegateVotingPower) (*MsgUndelegateVotingPowerResponse, error)
+	// RegisterCandidate registers the signer as a candidate in governance
+	// wallet elections.
+	RegisterCandidate(context.Context, *MsgRegisterCandidate) (*MsgRegisterCandidateResponse, error)
+	// WithdrawCandidacy removes the signer from the election candidates.
+	WithdrawCandidacy(context.Context, *MsgWithdrawCandidacy) (*MsgWithdrawCandidacyResponse, error)
+	// SetRewardWithdrawAddress sets the address the signer's rewards are paid
+	// to.
+	SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error)
+	// WithdrawVested pays out the vested part of the signer's reward vesting
+	// positions.
+	WithdrawVested(context.Context, *MsgWithdrawVested) (*MsgWithdrawVestedResponse, error)
+	// FundRewardsPool lets any account pay coins into the reward pool or into
+	// a new sponsored incentive campaign.
+	FundRewardsPool(context.Context, *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error)
+	// RefundCampaign returns the unspent funds of a settled campaign to its
+	// sponsor.
+	RefundCampaign(context.Context, *MsgRefundCampaign) (*MsgRefundCampaignResponse, error)
+	// LockEGV locks EGV of the signer in the module, boosting its contribution
+	// weight for rewards and governance.
+	LockEGV(context.Context, *MsgLockEGV) (*MsgLockEGVResponse, error)
+	// UnlockEGV starts returning locked EGV to the signer. The EGV stops
+	// boosting at once and is paid out after lock_unbonding_period blocks.
+	UnlockEGV(context.Context, *MsgUnlockEGV) (*MsgUnlockEGVResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) VetoParamChange(ctx context.Context, req *MsgVetoParamChange) (*MsgVetoParamChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoParamChange not implemented")
+}
+func (*UnimplementedMsgServer) DelegateVotingPower(ctx context.Context, req *MsgDelegateVotingPower) (*MsgDelegateVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateVotingPower not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateVotingPower(ctx context.Context, req *MsgUndelegateVotingPower) (*MsgUndelegateVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateVotingPower not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCandidate(ctx context.Context, req *MsgRegisterCandidate) (*MsgRegisterCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCandidate not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawCandidacy(ctx context.Context, req *MsgWithdrawCandidacy) (*MsgWithdrawCandidacyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCandidacy not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardWithdrawAddress(ctx context.Context, req *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawVested(ctx context.Context, req *MsgWithdrawVested) (*MsgWithdrawVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawVested not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardsPool(ctx context.Context, req *MsgFundRewardsPool) (*MsgFundRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardsPool not implemented")
+}
+func (*UnimplementedMsgServer) RefundCampaign(ctx context.Context, req *MsgRefundCampaign) (*MsgRefundCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundCampaign not implemented")
+}
+func (*UnimplementedMsgServer) LockEGV(ctx context.Context, req *MsgLockEGV) (*MsgLockEGVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockEGV not implemented")
+}
+func (*UnimplementedMsgServer) UnlockEGV(ctx context.Context, req *MsgUnlockEGV) (*MsgUnlockEGVResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockEGV not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoParamChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoParamChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoParamChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/VetoParamChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoParamChange(ctx, req.(*MsgVetoParamChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateVotingPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/DelegateVotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateVotingPower(ctx, req.(*MsgDelegateVotingPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateVotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateVotingPower)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateVotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/UndelegateVotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateVotingPower(ctx, req.(*MsgUndelegateVotingPower))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCandidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/RegisterCandidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCandidate(ctx, req.(*MsgRegisterCandidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawCandidacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCandidacy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCandidacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/WithdrawCandidacy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCandidacy(ctx, req.(*MsgWithdrawCandidacy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/SetRewardWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, req.(*MsgSetRewardWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/WithdrawVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawVested(ctx, req.(*MsgWithdrawVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardsPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/FundRewardsPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardsPool(ctx, req.(*MsgFundRewardsPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/RefundCampaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundCampaign(ctx, req.(*MsgRefundCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LockEGV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLockEGV)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LockEGV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/LockEGV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LockEGV(ctx, req.(*MsgLockEGV))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlockEGV_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockEGV)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlockEGV(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.rewards.Msg/UnlockEGV",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlockEGV(ctx, req.(*MsgUnlockEGV))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zenoda.rewards.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "VetoParamChange",
+			Handler:    _Msg_VetoParamChange_Handler,
+		},
+		{
+			MethodName: "DelegateVotingPower",
+			Handler:    _Msg_DelegateVotingPower_Handler,
+		},
+		{
+			MethodName: "UndelegateVotingPower",
+			Handler:    _Msg_UndelegateVotingPower_Handler,
+		},
+		{
+			MethodName: "RegisterCandidate",
+			Handler:    _Msg_RegisterCandidate_Handler,
+		},
+		{
+			MethodName: "WithdrawCandidacy",
+			Handler:    _Msg_WithdrawCandidacy_Handler,
+		},
+		{
+			MethodName: "SetRewardWithdrawAddress",
+			Handler:    _Msg_SetRewardWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawVested",
+			Handler:    _Msg_WithdrawVested_Handler,
+		},
+		{
+			MethodName: "FundRewardsPool",
+			Handler:    _Msg_FundRewardsPool_Handler,
+		},
+		{
+			MethodName: "RefundCampaign",
+			Handler:    _Msg_RefundCampaign_Handler,
+		},
+		{
+			MethodName: "LockEGV",
+			Handler:    _Msg_LockEGV_Handler,
+		},
+		{
+			MethodName: "UnlockEGV",
+			Handler:    _Msg_UnlockEGV_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "zenoda/rewards/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChangeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChangeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoParamChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoParamChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoParamChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegatee) > 0 {
+		i -= len(m.Delegatee)
+		copy(dAtA[i:], m.Delegatee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegatee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCandidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCandidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCandidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candidate) > 0 {
+		i -= len(m.Candidate)
+		copy(dAtA[i:], m.Candidate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Candidate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCandidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCandidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCandidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCandidacy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCandidacy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCandidacy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Candidate) > 0 {
+		i -= len(m.Candidate)
+		copy(dAtA[i:], m.Candidate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Candidate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawCandidacyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawCandidacyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawCandidacyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardsPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardsPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardsPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Campaign != nil {
+		{
+			size, err := m.Campaign.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundCampaign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundCampaign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundCampaign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CampaignId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundCampaignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundCampaignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundCampaignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockEGV) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockEGV) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockEGV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockEGVResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockEGVResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockEGVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		i -= len(m.Locked)
+		copy(dAtA[i:], m.Locked)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Locked)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockEGV) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockEGV) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockEGV) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlockEGVResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlockEGVResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlockEGVResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UnlockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnlockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVetoParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChangeId != 0 {
+		n += 1 + sovTx(uint64(m.ChangeId))
+	}
+	return n
+}
+
+func (m *MsgVetoParamChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancelled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDelegateVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelegateVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegateVotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCandidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Candidate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterCandidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawCandidacy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Candidate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawCandidacyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawVested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundRewardsPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Campaign != nil {
+		l = m.Campaign.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *MsgRefundCampaign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CampaignId != 0 {
+		n += 1 + sovTx(uint64(m.CampaignId))
+	}
+	return n
+}
+
+func (m *MsgRefundCampaignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockEGV) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockEGVResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Locked)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnlockEGV) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnlockEGVResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnlockId != 0 {
+		n += 1 + sovTx(uint64(m.UnlockId))
+	}
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeId", wireType)
+			}
+			m.ChangeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoParamChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoParamChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoParamChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegatee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVotingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVotingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVotingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCandidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCandidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCandidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterCandidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterCandidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterCandidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCandidacy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCandidacy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCandidacy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candidate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candidate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCandidacyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCandidacyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCandidacyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawVested) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawVested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawVested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawVestedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawVestedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardsPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardsPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardsPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Campaign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Campaign == nil {
+				m.Campaign = &CampaignSpec{}
+			}
+			if err := m.Campaign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardsPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundCampaign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundCampaign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundCampaign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CampaignId", wireType)
+			}
+			m.CampaignId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CampaignId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundCampaignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundCampaignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundCampaignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockEGV) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockEGV: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockEGV: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgLockEGVResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockEGVResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockEGVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockEGV) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -370,15 +4778,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockEGV: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockEGV: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -406,13 +4814,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -422,24 +4830,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -462,7 +4869,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockEGVResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -485,12 +4892,50 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockEGVResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockEGVResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockId", wireType)
+			}
+			m.UnlockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
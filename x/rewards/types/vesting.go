@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	math "cosmossdk.io/math"
+)
+
+// NewVestingPosition creates a new VestingPosition that vests amount to addr
+// linearly from startHeight to startHeight + duration.
+func NewVestingPosition(id uint64, addr string, amount math.Int, startHeight int64, duration uint64) VestingPosition {
+	return VestingPosition{
+		Id:          id,
+		Address:     addr,
+		Amount:      amount.String(),
+		Withdrawn:   math.ZeroInt().String(),
+		StartHeight: startHeight,
+		EndHeight:   startHeight + int64(duration),
+	}
+}
+
+// Validate checks the address, the amounts and the vesting period.
+func (p VestingPosition) Validate() error {
+	if err := validateAccAddress(p.Address); err != nil {
+		return fmt.Errorf("vesting position %d: invalid address: %w", p.Id, err)
+	}
+	amount, withdrawn, err := p.amounts()
+	if err != nil {
+		return err
+	}
+	if !amount.IsPositive() || withdrawn.IsNegative() || withdrawn.GT(amount) {
+		return fmt.Errorf("vesting position %d: withdrawn %s must be between 0 and amount %s", p.Id, withdrawn, amount)
+	}
+	if p.EndHeight <= p.StartHeight {
+		return fmt.Errorf("vesting position %d: end height must be after start height", p.Id)
+	}
+	return nil
+}
+
+// VestedAmount returns the part of the position vested at the given height.
+func (p VestingPosition) VestedAmount(height int64) (math.Int, error) {
+	amount, _, err := p.amounts()
+	if err != nil {
+		return math.Int{}, err
+	}
+	switch {
+	case height <= p.StartHeight:
+		return math.ZeroInt(), nil
+	case height >= p.EndHeight:
+		return amount, nil
+	default:
+		return amount.MulRaw(height - p.StartHeight).QuoRaw(p.EndHeight - p.StartHeight), nil
+	}
+}
+
+// Withdrawable returns the vested part of the position not withdrawn yet.
+func (p VestingPosition) Withdrawable(height int64) (math.Int, error) {
+	vested, err := p.VestedAmount(height)
+	if err != nil {
+		return math.Int{}, err
+	}
+	_, withdrawn, err := p.amounts()
+	if err != nil {
+		return math.Int{}, err
+	}
+	return vested.Sub(withdrawn), nil
+}
+
+// Unvested returns the part of the position not vested yet.
+func (p VestingPosition) Unvested(height int64) (math.Int, error) {
+	vested, err := p.VestedAmount(height)
+	if err != nil {
+		return math.Int{}, err
+	}
+	amount, _, err := p.amounts()
+	if err != nil {
+		return math.Int{}, err
+	}
+	return amount.Sub(vested), nil
+}
+
+// amounts parses the total and withdrawn amounts of the position.
+func (p VestingPosition) amounts() (amount, withdrawn math.Int, err error) {
+	amount, ok := math.NewIntFromString(p.Amount)
+	if !ok {
+		return amount, withdrawn, fmt.Errorf("vesting position %d: invalid amount %q", p.Id, p.Amount)
+	}
+	withdrawn, ok = math.NewIntFromString(p.Withdrawn)
+	if !ok {
+		return amount, withdrawn, fmt.Errorf("vesting position %d: invalid withdrawn amount %q", p.Id, p.Withdrawn)
+	}
+	return amount, withdrawn, nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	math "cosmossdk.io/math"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// NewVotingDelegation creates a new VotingDelegation instance.
+func NewVotingDelegation(delegator, delegatee string) VotingDelegation {
+	return VotingDelegation{
+		Delegator: delegator,
+		Delegatee: delegatee,
+	}
+}
+
+// Validate checks both addresses with the given address codec and rejects
+// self-delegation.
+func (d VotingDelegation) Validate(ac address.Codec) error {
+	delegator, err := ac.StringToBytes(d.Delegator)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+	delegatee, err := ac.StringToBytes(d.Delegatee)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid delegatee address")
+	}
+	if sdk.AccAddress(delegator).Equals(sdk.AccAddress(delegatee)) {
+		return ErrSelfDelegation
+	}
+	return nil
+}
+
+// ValidateStateless checks the delegation where the chain's address codec is
+// not available, such as in ValidateBasic and genesis validation. Both
+// addresses must use the prefix of the delegator address.
+func (d VotingDelegation) ValidateStateless() error {
+	hrp, _, err := bech32.DecodeAndConvert(d.Delegator)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid delegator address")
+	}
+	return d.Validate(addresscodec.NewBech32Codec(hrp))
+}
+
+// VotingWeight turns an address's contribution, its transaction count or
+// contribution score, into voting weight using the given weighting function.
+// total is the contribution of the whole network and bounds the weight under
+// the capped function.
+func VotingWeight(function string, votingWeightCap, contribution, total math.LegacyDec) (math.LegacyDec, error) {
+	weight := contribution
+
+	switch function {
+	case VotingWeightLinear:
+		return weight, nil
+	case VotingWeightQuadratic:
+		return weight.ApproxSqrt()
+	case VotingWeightCapped:
+		return math.LegacyMinDec(weight, votingWeightCap.Mul(total)), nil
+	default:
+		return math.LegacyDec{}, fmt.Errorf("unknown voting weight function %q", function)
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// NewRewardWithdrawAddress creates a new RewardWithdrawAddress instance.
+func NewRewardWithdrawAddress(addr, withdrawAddr string) RewardWithdrawAddress {
+	return RewardWithdrawAddress{
+		Address:         addr,
+		WithdrawAddress: withdrawAddr,
+	}
+}
+
+// Validate checks both addresses.
+func (w RewardWithdrawAddress) Validate() error {
+	if err := validateAccAddress(w.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid address")
+	}
+	if err := validateAccAddress(w.WithdrawAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdraw address")
+	}
+	return nil
+}
@@ -15,7 +15,8 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.SetParams(ctx, req.Params); err != nil {
+	// Governance approved params wait in the timelock queue before they apply.
+	if _, err := k.QueueParamChange(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
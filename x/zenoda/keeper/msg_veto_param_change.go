@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"zenoda/x/zenoda/types"
+)
+
+func (k msgServer) VetoParamChange(goCtx context.Context, msg *types.MsgVetoParamChange) (*types.MsgVetoParamChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := k.rewardsKeeper.AddressCodec().StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	cancelled, err := k.Keeper.VetoParamChange(ctx, msg.ChangeId, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVetoParamChangeResponse{Cancelled: cancelled}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"zenoda/x/zenoda/types"
+)
+
+func (k Keeper) PendingParamChanges(goCtx context.Context, req *types.QueryPendingParamChangesRequest) (*types.QueryPendingParamChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.QueuedParamChangeKeyPrefix))
+
+	var changes []types.QueuedParamChange
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var change types.QueuedParamChange
+		if err := k.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingParamChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
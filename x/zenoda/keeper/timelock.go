@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"zenoda/x/zenoda/types"
+)
+
+// GetQueuedParamChangeCount returns the id the next queued params update will get.
+func (k Keeper) GetQueuedParamChangeCount(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.QueuedParamChangeCountKey))
+	if err != nil || bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetQueuedParamChangeCount sets the id the next queued params update will get.
+func (k Keeper) SetQueuedParamChangeCount(ctx sdk.Context, count uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set([]byte(types.QueuedParamChangeCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// SetQueuedParamChange stores a params update in the timelock queue.
+func (k Keeper) SetQueuedParamChange(ctx sdk.Context, change types.QueuedParamChange) error {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.QueuedParamChangeKeyPrefix))
+	bz, err := k.cdc.Marshal(&change)
+	if err != nil {
+		return err
+	}
+	store.Set(sdk.Uint64ToBigEndian(change.Id), bz)
+	return nil
+}
+
+// GetQueuedParamChange returns a queued params update by id.
+func (k Keeper) GetQueuedParamChange(ctx sdk.Context, id uint64) (change types.QueuedParamChange, err error) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.QueuedParamChangeKeyPrefix))
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return change, errorsmod.Wrapf(types.ErrParamChangeNotFound, "id %d", id)
+	}
+	if err := k.cdc.Unmarshal(bz, &change); err != nil {
+		return change, errorsmod.Wrapf(err, "failed to decode queued params change %d", id)
+	}
+	return change, nil
+}
+
+// RemoveQueuedParamChange removes a params update from the timelock queue.
+func (k Keeper) RemoveQueuedParamChange(ctx sdk.Context, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.QueuedParamChangeKeyPrefix))
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// GetAllQueuedParamChanges returns every params update in the timelock queue.
+func (k Keeper) GetAllQueuedParamChanges(ctx sdk.Context) (list []types.QueuedParamChange, err error) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.QueuedParamChangeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.QueuedParamChange
+		if err := k.cdc.Unmarshal(iterator.Value(), &change); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to decode queued params change")
+		}
+		list = append(list, change)
+	}
+
+	return list, nil
+}
+
+// QueueParamChange places governance supplied params in the timelock queue.
+// The delay is shared with the rewards module, which owns the governance wallets.
+func (k Keeper) QueueParamChange(ctx sdk.Context, params types.Params) (types.QueuedParamChange, error) {
+	if err := params.Validate(); err != nil {
+		return types.QueuedParamChange{}, err
+	}
+
+	delay, err := k.rewardsKeeper.GetParamChangeDelay(ctx)
+	if err != nil {
+		return types.QueuedParamChange{}, err
+	}
+	id := k.GetQueuedParamChangeCount(ctx)
+	change := types.QueuedParamChange{
+		Id:            id,
+		Params:        params,
+		ExecuteHeight: ctx.BlockHeight() + int64(delay),
+	}
+	if err := k.SetQueuedParamChange(ctx, change); err != nil {
+		return types.QueuedParamChange{}, err
+	}
+	if err := k.SetQueuedParamChangeCount(ctx, id+1); err != nil {
+		return types.QueuedParamChange{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangeQueued,
+			sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(change.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyExecuteHeight, strconv.FormatInt(change.ExecuteHeight, 10)),
+		),
+	)
+
+	return change, nil
+}
+
+// VetoParamChange records a governance wallet's veto against a queued params
+// update and cancels the update once the veto threshold is reached.
+func (k Keeper) VetoParamChange(ctx sdk.Context, id uint64, voter sdk.AccAddress) (bool, error) {
+	change, err := k.GetQueuedParamChange(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	wallets, err := k.rewardsKeeper.GetPredefinedAddresses(ctx)
+	if err != nil {
+		return false, err
+	}
+	isGovernanceWallet := false
+	for _, wallet := range wallets {
+		if wallet.Equals(voter) {
+			isGovernanceWallet = true
+			break
+		}
+	}
+	voterStr, err := k.rewardsKeeper.AddressCodec().BytesToString(voter)
+	if err != nil {
+		return false, err
+	}
+	if !isGovernanceWallet {
+		return false, errorsmod.Wrap(types.ErrNotGovernanceWallet, voterStr)
+	}
+	suspended, err := k.rewardsKeeper.IsSuspended(ctx, voter)
+	if err != nil {
+		return false, err
+	}
+	if suspended {
+		return false, errorsmod.Wrap(types.ErrWalletSuspended, voterStr)
+	}
+	for _, veto := range change.Vetoes {
+		if veto == voterStr {
+			return false, errorsmod.Wrap(types.ErrAlreadyVetoed, voterStr)
+		}
+	}
+
+	change.Vetoes = append(change.Vetoes, voterStr)
+	if err := k.rewardsKeeper.RecordGovernanceVeto(ctx, voter); err != nil {
+		return false, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParamChangeVetoed,
+			sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voterStr),
+			sdk.NewAttribute(types.AttributeKeyVetoes, strconv.Itoa(len(change.Vetoes))),
+		),
+	)
+
+	reached, err := k.rewardsKeeper.VetoThresholdReached(ctx, change.Vetoes)
+	if err != nil {
+		return false, err
+	}
+	if reached {
+		k.RemoveQueuedParamChange(ctx, id)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChangeCancelled,
+				sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(id, 10)),
+			),
+		)
+		return true, nil
+	}
+
+	return false, k.SetQueuedParamChange(ctx, change)
+}
+
+// ProcessQueuedParamChanges applies every queued params update whose delay
+// has elapsed. Each update is applied in a cached context: one that no longer
+// passes validation or cannot be stored is dropped without leaving any of its
+// writes behind.
+func (k Keeper) ProcessQueuedParamChanges(ctx sdk.Context) error {
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if ctx.BlockHeight() < change.ExecuteHeight {
+			continue
+		}
+
+		k.RemoveQueuedParamChange(ctx, change.Id)
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.applyParamChange(cacheCtx, change.Params); err != nil {
+			k.Logger().Error("Dropping queued params change", "id", change.Id, "error", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParamChangeFailed,
+					sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(change.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeParamChangeExecuted,
+				sdk.NewAttribute(types.AttributeKeyChangeID, strconv.FormatUint(change.Id, 10)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// applyParamChange validates and stores the params of a queued update.
+func (k Keeper) applyParamChange(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.SetParams(ctx, params)
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "zenoda/testutil/keeper"
+	rewardstypes "zenoda/x/rewards/types"
+	"zenoda/x/zenoda/keeper"
+	"zenoda/x/zenoda/types"
+)
+
+func TestParamChangeTimelock(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.DefaultParams()})
+	require.NoError(t, err)
+
+	response, err := k.PendingParamChanges(ctx, &types.QueryPendingParamChangesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Changes, 1)
+
+	change := response.Changes[0]
+	require.NoError(t, k.ProcessQueuedParamChanges(ctx.WithBlockHeight(change.ExecuteHeight-1)))
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	require.NoError(t, k.ProcessQueuedParamChanges(ctx.WithBlockHeight(change.ExecuteHeight)))
+	changes, err = k.GetAllQueuedParamChanges(ctx)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: k.GetAuthority(), ChangeId: change.Id})
+	require.ErrorIs(t, err, types.ErrParamChangeNotFound)
+}
+
+func TestVetoParamChangeRecordsParticipation(t *testing.T) {
+	k, rewardsKeeper, ctx := keepertest.ZenodaKeeperWithRewards(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.DefaultParams()})
+	require.NoError(t, err)
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	wallets, err := rewardsKeeper.GetPredefinedAddresses(ctx)
+	require.NoError(t, err)
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: wallets[0].String(), ChangeId: changes[0].Id})
+	require.NoError(t, err)
+
+	participation, err := rewardsKeeper.GetParticipation(ctx, wallets[0])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), participation.Vetoes)
+}
+
+func TestSuspendedWalletCannotVeto(t *testing.T) {
+	k, rewardsKeeper, ctx := keepertest.ZenodaKeeperWithRewards(t)
+	ms := keeper.NewMsgServerImpl(k)
+
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.DefaultParams()})
+	require.NoError(t, err)
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	wallets, err := rewardsKeeper.GetPredefinedAddresses(ctx)
+	require.NoError(t, err)
+	wallet, err := rewardsKeeper.AddressCodec().BytesToString(wallets[0])
+	require.NoError(t, err)
+	suspension := rewardstypes.Participation{
+		Address:         wallet,
+		MissedActions:   3,
+		Penalty:         rewardstypes.InactivityPenaltySuspension,
+		PenaltyEndEpoch: 5,
+	}
+	require.NoError(t, rewardsKeeper.SetParticipation(ctx, suspension))
+
+	_, err = ms.VetoParamChange(ctx, &types.MsgVetoParamChange{Signer: wallet, ChangeId: changes[0].Id})
+	require.ErrorIs(t, err, types.ErrWalletSuspended)
+
+	// The rejected veto leaves the wallet's missed actions alone.
+	participation, err := rewardsKeeper.GetParticipation(ctx, wallets[0])
+	require.NoError(t, err)
+	require.Equal(t, suspension, participation)
+}
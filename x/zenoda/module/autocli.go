@@ -17,6 +17,11 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "PendingParamChanges",
+					Use:       "pending-param-changes",
+					Short:     "Lists the params updates waiting in the timelock queue",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +33,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod:      "VetoParamChange",
+					Use:            "veto-param-change [change-id]",
+					Short:          "Veto a params update waiting in the timelock queue",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "change_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -13,12 +13,31 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
 	}
+
+	// Restore the timelock queue
+	var nextChangeID uint64
+	for _, change := range genState.QueuedParamChanges {
+		if err := k.SetQueuedParamChange(ctx, change); err != nil {
+			panic(err)
+		}
+		if change.Id >= nextChangeID {
+			nextChangeID = change.Id + 1
+		}
+	}
+	if err := k.SetQueuedParamChangeCount(ctx, nextChangeID); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	changes, err := k.GetAllQueuedParamChanges(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.QueuedParamChanges = changes
 
 	// this line is used by starport scaffolding # genesis/module/export
 
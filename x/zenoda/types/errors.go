@@ -10,4 +10,9 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrParamChangeNotFound = sdkerrors.Register(ModuleName, 1102, "queued params change not found")
+	ErrNotGovernanceWallet = sdkerrors.Register(ModuleName, 1103, "signer is not a governance wallet")
+	ErrAlreadyVetoed       = sdkerrors.Register(ModuleName, 1104, "governance wallet already vetoed this change")
+	ErrWalletSuspended     = sdkerrors.Register(ModuleName, 1105, "governance wallet is suspended for inactivity")
 )
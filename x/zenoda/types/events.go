@@ -0,0 +1,16 @@
+package types
+
+// zenoda module event types
+const (
+	EventTypeParamChangeQueued    = "param_change_queued"
+	EventTypeParamChangeVetoed    = "param_change_vetoed"
+	EventTypeParamChangeCancelled = "param_change_cancelled"
+	EventTypeParamChangeExecuted  = "param_change_executed"
+	EventTypeParamChangeFailed    = "param_change_failed"
+
+	AttributeKeyChangeID      = "change_id"
+	AttributeKeyExecuteHeight = "execute_height"
+	AttributeKeyVoter         = "voter"
+	AttributeKeyVetoes        = "vetoes"
+	AttributeKeyError         = "error"
+)
@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -18,6 +19,17 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// RewardsKeeper defines the expected interface for the Rewards module, which
+// owns the governance wallets and the timelock settings.
+type RewardsKeeper interface {
+	AddressCodec() address.Codec
+	GetPredefinedAddresses(ctx sdk.Context) ([]sdk.AccAddress, error)
+	IsSuspended(ctx sdk.Context, addr sdk.AccAddress) (bool, error)
+	GetParamChangeDelay(ctx sdk.Context) (uint64, error)
+	VetoThresholdReached(ctx sdk.Context, vetoes []string) (bool, error)
+	RecordGovernanceVeto(ctx sdk.Context, voter sdk.AccAddress) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -18,5 +20,16 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	seen := make(map[uint64]bool)
+	for _, change := range gs.QueuedParamChanges {
+		if seen[change.Id] {
+			return fmt.Errorf("duplicate queued param change id %d", change.Id)
+		}
+		seen[change.Id] = true
+		if err := change.Params.Validate(); err != nil {
+			return fmt.Errorf("queued param change %d: %w", change.Id, err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// queued_param_changes are the params updates waiting in the timelock queue.
+	QueuedParamChanges []QueuedParamChange `protobuf:"bytes,2,rep,name=queued_param_changes,json=queuedParamChanges,proto3" json:"queued_param_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +72,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetQueuedParamChanges() []QueuedParamChange {
+	if m != nil {
+		return m.QueuedParamChanges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zenoda.zenoda.GenesisState")
 }
@@ -77,19 +86,23 @@ func init() {
 func init() { proto.RegisterFile("zenoda/zenoda/genesis.proto", fileDescriptor_6d866a961c158d1a) }
 
 var fileDescriptor_6d866a961c158d1a = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
+	// 242 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xae, 0x4a, 0xcd, 0xcb,
 	0x4f, 0x49, 0xd4, 0x87, 0x52, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9,
 	0x25, 0xf9, 0x42, 0xbc, 0x10, 0x51, 0x3d, 0x08, 0x25, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf,
 	0x0f, 0x26, 0x21, 0x2a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a,
-	0x2a, 0x85, 0x6a, 0x68, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x4c, 0x25, 0x0f, 0x2e, 0x1e, 0x77,
-	0x88, 0x25, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x16, 0x5c, 0x6c, 0x10, 0x79, 0x09, 0x46, 0x05,
-	0x46, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0x14, 0x4b, 0xf5, 0x02, 0xc0, 0x92, 0x4e, 0x9c, 0x27, 0xee,
-	0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0xde, 0x49, 0xff, 0xc4, 0x23, 0x39,
-	0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63,
-	0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x44, 0xa1, 0x16, 0x57, 0xc0, 0x5c, 0x50, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x81, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x72, 0xf0,
-	0xb0, 0xf4, 0x00, 0x00, 0x00,
+	0x2a, 0x85, 0x6a, 0x68, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x4c, 0x29, 0x19, 0x54, 0xb9, 0x92,
+	0xcc, 0xdc, 0xd4, 0x9c, 0xfc, 0xe4, 0x6c, 0x88, 0xac, 0xd2, 0x72, 0x46, 0x2e, 0x1e, 0x77, 0x88,
+	0x1b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x2c, 0xb8, 0xd8, 0x20, 0xda, 0x25, 0x18, 0x15, 0x18,
+	0x35, 0xb8, 0x8d, 0x44, 0xf5, 0x50, 0xdc, 0xa4, 0x17, 0x00, 0x96, 0x74, 0xe2, 0x3c, 0x71, 0x4f,
+	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0xf5, 0x42, 0xb1, 0x5c, 0x22, 0x85, 0xa5,
+	0xa9, 0xa5, 0xa9, 0x29, 0xf1, 0x60, 0x81, 0xf8, 0xe4, 0x8c, 0xc4, 0xbc, 0xf4, 0xd4, 0x62, 0x09,
+	0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x05, 0x34, 0x73, 0x02, 0xc1, 0x4a, 0xc1, 0xa6, 0x39, 0x83,
+	0x15, 0x22, 0x1b, 0x29, 0x54, 0x88, 0x2e, 0x5b, 0xec, 0xa4, 0x7f, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
+	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
+	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xa2, 0x50, 0xaf, 0x55, 0xc0, 0xfd, 0x58, 0x59, 0x90, 0x5a, 0x9c,
+	0xc4, 0x06, 0xf6, 0xa1, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x53, 0x7d, 0xa5, 0xee, 0x72, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +125,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueuedParamChanges) > 0 {
+		for iNdEx := len(m.QueuedParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +171,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.QueuedParamChanges) > 0 {
+		for _, e := range m.QueuedParamChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +248,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedParamChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedParamChanges = append(m.QueuedParamChanges, QueuedParamChange{})
+			if err := m.QueuedParamChanges[len(m.QueuedParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,6 +9,12 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_zenoda"
+
+	// QueuedParamChangeKeyPrefix is the prefix to store params updates waiting in the timelock queue
+	QueuedParamChangeKeyPrefix = "QueuedParamChange/value/"
+
+	// QueuedParamChangeCountKey is the key for the next timelock queue id
+	QueuedParamChangeCountKey = "QueuedParamChange/count/"
 )
 
 var (
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,34 +114,145 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryPendingParamChangesRequest is request type for the
+// Query/PendingParamChanges RPC method.
+type QueryPendingParamChangesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingParamChangesRequest) Reset()         { *m = QueryPendingParamChangesRequest{} }
+func (m *QueryPendingParamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamChangesRequest) ProtoMessage()    {}
+func (*QueryPendingParamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12b70e1243157dc7, []int{2}
+}
+func (m *QueryPendingParamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamChangesRequest.Merge(m, src)
+}
+func (m *QueryPendingParamChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamChangesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingParamChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingParamChangesResponse is response type for the
+// Query/PendingParamChanges RPC method.
+type QueryPendingParamChangesResponse struct {
+	Changes    []QueuedParamChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingParamChangesResponse) Reset()         { *m = QueryPendingParamChangesResponse{} }
+func (m *QueryPendingParamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamChangesResponse) ProtoMessage()    {}
+func (*QueryPendingParamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12b70e1243157dc7, []int{3}
+}
+func (m *QueryPendingParamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingParamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingParamChangesResponse) GetChanges() []QueuedParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryPendingParamChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "zenoda.zenoda.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "zenoda.zenoda.QueryParamsResponse")
+	proto.RegisterType((*QueryPendingParamChangesRequest)(nil), "zenoda.zenoda.QueryPendingParamChangesRequest")
+	proto.RegisterType((*QueryPendingParamChangesResponse)(nil), "zenoda.zenoda.QueryPendingParamChangesResponse")
 }
 
 func init() { proto.RegisterFile("zenoda/zenoda/query.proto", fileDescriptor_12b70e1243157dc7) }
 
 var fileDescriptor_12b70e1243157dc7 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xac, 0x4a, 0xcd, 0xcb,
-	0x4f, 0x49, 0xd4, 0x87, 0x52, 0x85, 0xa5, 0xa9, 0x45, 0x95, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xbc, 0x10, 0x31, 0x3d, 0x08, 0x25, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26,
-	0x21, 0x2a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x93,
-	0x9e, 0x9f, 0x9f, 0x9e, 0x93, 0xaa, 0x9f, 0x58, 0x90, 0xa9, 0x9f, 0x98, 0x97, 0x97, 0x5f, 0x92,
-	0x58, 0x92, 0x99, 0x9f, 0x57, 0x0c, 0x95, 0xd5, 0x4a, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0x4f,
-	0x4a, 0x2c, 0x4e, 0x85, 0x58, 0xa7, 0x5f, 0x66, 0x98, 0x94, 0x5a, 0x92, 0x68, 0xa8, 0x5f, 0x90,
-	0x98, 0x9e, 0x99, 0x07, 0x56, 0x0c, 0x55, 0x2b, 0x85, 0xea, 0xb8, 0x82, 0xc4, 0xa2, 0xc4, 0x5c,
-	0xa8, 0x39, 0x4a, 0x22, 0x5c, 0x42, 0x81, 0x20, 0xdd, 0x01, 0x60, 0xc1, 0xa0, 0xd4, 0xc2, 0xd2,
-	0xd4, 0xe2, 0x12, 0x25, 0x7f, 0x2e, 0x61, 0x14, 0xd1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21,
-	0x0b, 0x2e, 0x36, 0x88, 0x66, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0x14, 0xbf,
-	0xe9, 0x41, 0x94, 0x3b, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20,
-	0xa8, 0x7a, 0xa3, 0x72, 0x2e, 0x56, 0xb0, 0x81, 0x42, 0x79, 0x5c, 0x6c, 0x10, 0x55, 0x42, 0x8a,
-	0x68, 0x9a, 0x31, 0x9d, 0x21, 0xa5, 0x84, 0x4f, 0x09, 0xc4, 0x4d, 0x4a, 0xb2, 0x4d, 0x97, 0x9f,
-	0x4c, 0x66, 0x12, 0x17, 0x12, 0xd5, 0xc7, 0xe6, 0x4b, 0x27, 0xfd, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x85, 0x2a, 0xac, 0x80, 0xe9, 0x28, 0xa9, 0x2c, 0x48, 0x2d,
-	0x4e, 0x62, 0x03, 0x87, 0x8b, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x41, 0x2b, 0x1b, 0x29, 0xd2,
-	0x01, 0x00, 0x00,
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xc1, 0xae, 0x12, 0x31,
+	0x14, 0x9d, 0x62, 0xc4, 0x58, 0xe2, 0xc2, 0x02, 0x11, 0x27, 0x38, 0x8c, 0x13, 0x83, 0x84, 0x98,
+	0x36, 0xe0, 0xc6, 0x35, 0x46, 0x5d, 0x0a, 0x2c, 0xdd, 0x90, 0x02, 0x4d, 0x9d, 0xc8, 0xb4, 0x03,
+	0x9d, 0x31, 0xe2, 0xd2, 0x2f, 0x30, 0x71, 0xef, 0xca, 0x85, 0x4b, 0xe3, 0x57, 0xb0, 0x24, 0x71,
+	0xe3, 0xca, 0x18, 0x30, 0xf1, 0x37, 0x0c, 0x6d, 0xf1, 0xcd, 0xf0, 0xe0, 0xbd, 0xb7, 0x69, 0x9b,
+	0x7b, 0xcf, 0xb9, 0xf7, 0xdc, 0xd3, 0x0b, 0xef, 0xbe, 0x67, 0x42, 0x4e, 0x29, 0xb1, 0xd7, 0x3c,
+	0x65, 0x8b, 0x25, 0x8e, 0x17, 0x32, 0x91, 0xe8, 0x96, 0x89, 0x61, 0x73, 0xb9, 0xb7, 0x69, 0x14,
+	0x0a, 0x49, 0xf4, 0x69, 0x10, 0x6e, 0x85, 0x4b, 0x2e, 0xf5, 0x93, 0xec, 0x5e, 0x36, 0x5a, 0xe7,
+	0x52, 0xf2, 0x19, 0x23, 0x34, 0x0e, 0x09, 0x15, 0x42, 0x26, 0x34, 0x09, 0xa5, 0x50, 0x36, 0xdb,
+	0x9e, 0x48, 0x15, 0x49, 0x45, 0xc6, 0x54, 0x31, 0xd3, 0x8e, 0xbc, 0xed, 0x8c, 0x59, 0x42, 0x3b,
+	0x24, 0xa6, 0x3c, 0x14, 0x1a, 0x6c, 0xb1, 0x6e, 0x5e, 0x5c, 0x4c, 0x17, 0x34, 0xda, 0xd7, 0xa9,
+	0xe7, 0x73, 0x49, 0x18, 0xb1, 0x99, 0x9c, 0xbc, 0x31, 0xd9, 0xa0, 0x02, 0xd1, 0x60, 0x57, 0xbb,
+	0xaf, 0x29, 0x43, 0x36, 0x4f, 0x99, 0x4a, 0x82, 0x97, 0xb0, 0x9c, 0x8b, 0xaa, 0x58, 0x0a, 0xc5,
+	0xd0, 0x13, 0x58, 0x34, 0xa5, 0x6b, 0xc0, 0x07, 0xad, 0x52, 0xb7, 0x8a, 0x73, 0x93, 0x63, 0x03,
+	0xef, 0xdd, 0x5c, 0xfd, 0x6a, 0x38, 0x5f, 0xff, 0x7e, 0x6b, 0x83, 0xa1, 0xc5, 0x07, 0x21, 0x6c,
+	0x98, 0x82, 0x4c, 0x4c, 0x43, 0xc1, 0x35, 0xf0, 0xe9, 0x6b, 0x2a, 0x38, 0xdb, 0xf7, 0x44, 0xcf,
+	0x21, 0x3c, 0x9b, 0xcb, 0x36, 0x68, 0x62, 0x63, 0x02, 0xde, 0x99, 0x80, 0x8d, 0xe7, 0xd6, 0x04,
+	0xdc, 0xa7, 0x9c, 0x59, 0xee, 0x30, 0xc3, 0x0c, 0xbe, 0x03, 0xe8, 0x9f, 0xee, 0x65, 0x27, 0x79,
+	0x06, 0x6f, 0x4c, 0x4c, 0xa8, 0x06, 0xfc, 0x6b, 0xad, 0x52, 0xd7, 0x3f, 0x18, 0x65, 0x90, 0xb2,
+	0x94, 0x4d, 0x33, 0xdc, 0xec, 0x54, 0x7b, 0x2e, 0x7a, 0x91, 0xd3, 0x5c, 0xd0, 0x9a, 0x1f, 0x5e,
+	0xaa, 0xd9, 0x68, 0xc8, 0x8a, 0xee, 0x7e, 0x2e, 0xc0, 0xeb, 0x5a, 0x34, 0x12, 0xb0, 0x68, 0x6c,
+	0x44, 0xf7, 0xcf, 0x4b, 0x3a, 0xf8, 0x27, 0x37, 0xb8, 0x08, 0x62, 0xda, 0x04, 0xf7, 0x3e, 0xfc,
+	0xf8, 0xf3, 0xa9, 0x70, 0x07, 0x55, 0xc9, 0xb1, 0x25, 0x41, 0x5f, 0x00, 0x2c, 0x1f, 0x71, 0x0a,
+	0xe1, 0xa3, 0xa5, 0x4f, 0x7e, 0x9f, 0x4b, 0xae, 0x8c, 0xb7, 0xba, 0x1e, 0x69, 0x5d, 0x4d, 0xf4,
+	0xe0, 0x50, 0x97, 0xe1, 0x8c, 0xb4, 0xbe, 0x91, 0x75, 0xba, 0x47, 0x56, 0x1b, 0x0f, 0xac, 0x37,
+	0x1e, 0xf8, 0xbd, 0xf1, 0xc0, 0xc7, 0xad, 0xe7, 0xac, 0xb7, 0x9e, 0xf3, 0x73, 0xeb, 0x39, 0xaf,
+	0xaa, 0x96, 0xf7, 0xee, 0xff, 0x86, 0x2f, 0x63, 0xa6, 0xc6, 0x45, 0xbd, 0xdf, 0x8f, 0xff, 0x05,
+	0x00, 0x00, 0xff, 0xff, 0x2c, 0x95, 0x99, 0xcd, 0xb8, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +269,9 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PendingParamChanges queries the params updates waiting in the timelock
+	// queue.
+	PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +291,22 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PendingParamChanges(ctx context.Context, in *QueryPendingParamChangesRequest, opts ...grpc.CallOption) (*QueryPendingParamChangesResponse, error) {
+	out := new(QueryPendingParamChangesResponse)
+	err := c.cc.Invoke(ctx, "/zenoda.zenoda.Query/PendingParamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PendingParamChanges queries the params updates waiting in the timelock
+	// queue.
+	PendingParamChanges(context.Context, *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +316,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PendingParamChanges(ctx context.Context, req *QueryPendingParamChangesRequest) (*QueryPendingParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamChanges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +342,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zenoda.zenoda.Query/PendingParamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamChanges(ctx, req.(*QueryPendingParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zenoda.zenoda.Query",
@@ -222,6 +369,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PendingParamChanges",
+			Handler:    _Query_PendingParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zenoda/zenoda/query.proto",
@@ -283,6 +434,90 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingParamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -314,6 +549,38 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingParamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingParamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -453,6 +720,212 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingParamChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, QueuedParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0